@@ -0,0 +1,132 @@
+// Package bitmap draws images compiled to Go tables on any drivers.Displayer.
+//
+// Bitmaps are generated from PNG, JPEG, GIF or BMP files with
+// cmd/convert2bin, already resized and converted to the pixel format of the
+// display, so programs showing static icons or sprites need no image
+// decoder. Their data can be compressed with run-length encoding.
+package bitmap // import "tinygo.org/x/drivers/bitmap"
+
+import (
+	"errors"
+	"io"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
+	"tinygo.org/x/drivers/pixel"
+)
+
+// ErrCorrupt is returned when the compressed data of a bitmap is invalid.
+var ErrCorrupt = errors.New("bitmap: corrupt RLE data")
+
+// Bitmap is an image in a pixel format.
+type Bitmap struct {
+	Width, Height int16
+	Format        pixel.Format
+
+	// RLE reports whether Data is compressed with run-length encoding, see
+	// Compress.
+	RLE bool
+
+	// Data holds the pixels row by row, encoded as Format.Encode does,
+	// without padding at the end of the rows. A string lets TinyGo keep it
+	// in flash.
+	Data string
+}
+
+// Sheet is a set of bitmaps in the same format sharing their data, such as
+// icons or the frames of an animation.
+type Sheet struct {
+	Format  pixel.Format
+	RLE     bool
+	Sprites []Sprite
+	Data    string
+}
+
+// Sprite is a bitmap of a Sheet.
+type Sprite struct {
+	Width, Height int16
+
+	// Offset is the index of the first byte of the sprite in Sheet.Data.
+	Offset uint32
+}
+
+// Bitmap returns sprite i of the sheet.
+func (s *Sheet) Bitmap(i int) Bitmap {
+	sp := s.Sprites[i]
+	end := uint32(len(s.Data))
+	if i+1 < len(s.Sprites) {
+		end = s.Sprites[i+1].Offset
+	}
+	return Bitmap{
+		Width:  sp.Width,
+		Height: sp.Height,
+		Format: s.Format,
+		RLE:    s.RLE,
+		Data:   s.Data[sp.Offset:end],
+	}
+}
+
+// Size returns the number of bytes of the uncompressed pixels.
+func (b *Bitmap) Size() int {
+	return b.Format.BufferSize(int(b.Width) * int(b.Height))
+}
+
+// NewReader returns a reader of the uncompressed pixels of b.
+func (b *Bitmap) NewReader() *Reader {
+	return &Reader{
+		data: b.Data,
+		rle:  b.RLE,
+		unit: unitSize(b.Format),
+		left: b.Size(),
+	}
+}
+
+// Draw draws b with its top left corner at x, y. If d is a display.Drawer
+// in the format of b, and the rows of b start on a byte boundary, the pixels
+// are written with DrawRGBBitmap8 and b must be within the display.
+// Otherwise they are drawn with SetPixel.
+func Draw(d drivers.Displayer, b *Bitmap, x, y int16) error {
+	f := b.Format
+	bits := f.BitsPerPixel()
+	r := b.NewReader()
+	w, n := int(b.Width), int(b.Width)*int(b.Height)
+	if dr, ok := d.(display.Drawer); ok && dr.PixelFormat() == f && w*bits%8 == 0 {
+		var buf [64]byte
+		// Segments of a row are a multiple of 8 pixels, which is a whole
+		// number of bytes in all formats.
+		step := len(buf) * 8 / bits &^ 7
+		for j := 0; j < int(b.Height); j++ {
+			for i := 0; i < w; i += step {
+				k := w - i
+				if k > step {
+					k = step
+				}
+				seg := buf[:f.BufferSize(k)]
+				if _, err := io.ReadFull(r, seg); err != nil {
+					return err
+				}
+				err := dr.DrawRGBBitmap8(x+int16(i), y+int16(j), seg, int16(k), 1)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	// Read a unit at a time, which holds whole pixels.
+	var unit [3]byte
+	us := unitSize(f)
+	perUnit := us * 8 / bits
+	for i := 0; i < n; {
+		m, err := io.ReadFull(r, unit[:us])
+		if err != nil && !(err == io.ErrUnexpectedEOF && m > 0) {
+			return err
+		}
+		for k := 0; k < perUnit && i < n; k++ {
+			d.SetPixel(x+int16(i%w), y+int16(i/w), f.Color(f.Get(unit[:], k)))
+			i++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package bitmap
+
+import (
+	"bytes"
+	"image/color"
+	"io"
+	"math/rand"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/pixel"
+	"tinygo.org/x/drivers/tester"
+)
+
+// drawer is a display with DrawRGBBitmap8.
+type drawer struct {
+	*tester.Screen
+	format pixel.Format
+	calls  int
+}
+
+func (d *drawer) FillRectangle(x, y, width, height int16, c color.RGBA) error { return nil }
+
+func (d *drawer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	return nil
+}
+
+func (d *drawer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	d.calls++
+	for i := 0; i < int(w)*int(h); i++ {
+		d.SetPixel(x+int16(i%int(w)), y+int16(i/int(w)), d.format.Color(d.format.Get(data, i)))
+	}
+	return nil
+}
+
+func (d *drawer) PixelFormat() pixel.Format { return d.format }
+
+var formats = []pixel.Format{pixel.RGB565, pixel.RGB444, pixel.RGB888, pixel.Mono, pixel.Gray2, pixel.TriColor, pixel.Gray8}
+
+// testData returns pixels with runs of various lengths.
+func testData(f pixel.Format, pixels int) []byte {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, f.BufferSize(pixels))
+	colors := []uint32{0, 1, 2, 0x1234, 0xFFFFFF}
+	for i := 0; i < pixels; {
+		v := colors[rnd.Intn(len(colors))] & (1<<f.BitsPerPixel() - 1)
+		for n := rnd.Intn(300); n > 0 && i < pixels; n-- {
+			f.Set(data, i, v)
+			i++
+		}
+	}
+	return data
+}
+
+func TestCompress(t *testing.T) {
+	c := qt.New(t)
+	for _, f := range formats {
+		for _, pixels := range []int{1, 7, 301, 5000} {
+			data := testData(f, pixels)
+			b := Bitmap{Width: int16(pixels), Height: 1, Format: f, RLE: true, Data: string(Compress(data, f))}
+			got, err := io.ReadAll(b.NewReader())
+			c.Assert(err, qt.IsNil)
+			c.Assert(got, qt.DeepEquals, data, qt.Commentf("format %d, %d pixels", f, pixels))
+			if pixels == 5000 {
+				c.Assert(len(b.Data) < len(data)/4, qt.IsTrue, qt.Commentf("format %d: %d bytes", f, len(b.Data)))
+			}
+		}
+	}
+
+	// Literals and repeats are split at their maximum length.
+	data := make([]byte, 300)
+	c.Assert(Compress(data, pixel.Gray8), qt.DeepEquals, []byte{255, 0, 255, 0, 168, 0})
+	for i := range data {
+		data[i] = byte(i)
+	}
+	out := Compress(data, pixel.Gray8)
+	c.Assert(out, qt.HasLen, 303)
+	c.Assert([]byte{out[0], out[129], out[258]}, qt.DeepEquals, []byte{127, 127, 43})
+	c.Assert(Compress([]byte{1, 1, 2, 3, 3, 3}, pixel.Gray8), qt.DeepEquals, []byte{128, 1, 0, 2, 129, 3})
+
+	// Truncated data is an error.
+	b := Bitmap{Width: 300, Height: 1, Format: pixel.Gray8, RLE: true, Data: string(out[:200])}
+	_, err := io.ReadAll(b.NewReader())
+	c.Assert(err, qt.Equals, ErrCorrupt)
+	b.RLE = false
+	_, err = io.ReadAll(b.NewReader())
+	c.Assert(err, qt.Equals, ErrCorrupt)
+}
+
+func TestSheet(t *testing.T) {
+	c := qt.New(t)
+	s := Sheet{
+		Format: pixel.Gray8,
+		Sprites: []Sprite{
+			{Width: 2, Height: 1, Offset: 0},
+			{Width: 1, Height: 3, Offset: 2},
+		},
+		Data: "abcde",
+	}
+	b := s.Bitmap(1)
+	c.Assert(b, qt.Equals, Bitmap{Width: 1, Height: 3, Format: pixel.Gray8, Data: "cde"})
+	c.Assert(s.Bitmap(0).Data, qt.Equals, "ab")
+}
+
+func TestDraw(t *testing.T) {
+	c := qt.New(t)
+	for _, f := range formats {
+		for _, w := range []int16{8, 13, 100} {
+			data := testData(f, int(w)*5)
+			for _, rle := range []bool{false, true} {
+				b := Bitmap{Width: w, Height: 5, Format: f, RLE: rle, Data: string(data)}
+				if rle {
+					b.Data = string(Compress(data, f))
+				}
+				s := tester.NewScreen(120, 10)
+				c.Assert(Draw(s, &b, 3, 2), qt.IsNil)
+				c.Assert(s.Pixels, qt.HasLen, int(w)*5)
+				for i := 0; i < int(w)*5; i++ {
+					want := f.Color(f.Get(data, i))
+					c.Assert(s.Pixels[[2]int16{3 + int16(i)%w, 2 + int16(i)/w}], qt.Equals, want)
+				}
+
+				d := &drawer{Screen: tester.NewScreen(120, 10), format: f}
+				c.Assert(Draw(d, &b, 3, 2), qt.IsNil)
+				c.Assert(d.Pixels, qt.DeepEquals, s.Pixels)
+				aligned := int(w)*f.BitsPerPixel()%8 == 0
+				c.Assert(d.calls > 0, qt.Equals, aligned, qt.Commentf("format %d, width %d", f, w))
+			}
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	c := qt.New(t)
+	data := testData(pixel.RGB565, 1000)
+	b := Bitmap{Width: 1000, Height: 1, Format: pixel.RGB565, RLE: true, Data: string(Compress(data, pixel.RGB565))}
+	r := b.NewReader()
+	var got bytes.Buffer
+	buf := make([]byte, 7)
+	for {
+		n, err := r.Read(buf)
+		got.Write(buf[:n])
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, qt.IsNil)
+	}
+	c.Assert(got.Bytes(), qt.DeepEquals, data)
+}
@@ -0,0 +1,149 @@
+package bitmap
+
+import (
+	"io"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+// The compressed data is a sequence of runs of units, a unit being the
+// smallest number of whole bytes holding whole pixels: 2 bytes in RGB565,
+// 3 bytes in RGB444 and RGB888, and 1 byte in the other formats. A run
+// starts with a byte n. If n < 128, n+1 units follow. Otherwise, the unit
+// that follows is repeated n-126 times.
+const (
+	maxLiteral = 128
+	maxRepeat  = 129
+)
+
+// unitSize returns the number of bytes of a unit of f.
+func unitSize(f pixel.Format) int {
+	switch f.BitsPerPixel() {
+	case 12, 24:
+		return 3
+	case 16:
+		return 2
+	}
+	return 1
+}
+
+// Compress returns data, encoded in the format f, compressed with
+// run-length encoding. The last unit is padded with zeros.
+func Compress(data []byte, f pixel.Format) []byte {
+	us := unitSize(f)
+	if len(data)%us != 0 {
+		data = append(data[:len(data):len(data)], make([]byte, us-len(data)%us)...)
+	}
+	n := len(data) / us
+	unit := func(i int) string { return string(data[i*us : i*us+us]) }
+	repeats := func(i int) int {
+		r := 1
+		for i+r < n && r < maxRepeat && unit(i+r) == unit(i) {
+			r++
+		}
+		return r
+	}
+	var out []byte
+	literal := 0 // start of the pending literal units
+	flush := func(end int) {
+		for literal < end {
+			k := end - literal
+			if k > maxLiteral {
+				k = maxLiteral
+			}
+			out = append(out, byte(k-1))
+			out = append(out, data[literal*us:(literal+k)*us]...)
+			literal += k
+		}
+	}
+	for i := 0; i < n; {
+		r := repeats(i)
+		// A run of two only pays off if it does not split a literal.
+		if r >= 3 || r == 2 && literal == i {
+			flush(i)
+			out = append(out, byte(r+126))
+			out = append(out, data[i*us:i*us+us]...)
+			i += r
+			literal = i
+			continue
+		}
+		i++
+	}
+	flush(n)
+	return out
+}
+
+// Reader reads the uncompressed pixels of a Bitmap.
+type Reader struct {
+	data   string
+	rle    bool
+	unit   int
+	left   int // bytes left to read
+	run    int // units left in the current run
+	repeat bool
+	pos    int // of the repeated unit, or of the next literal byte
+	off    int // byte offset in the repeated unit
+}
+
+// Read implements io.Reader.
+func (r *Reader) Read(p []byte) (int, error) {
+	if r.left == 0 {
+		return 0, io.EOF
+	}
+	if len(p) > r.left {
+		p = p[:r.left]
+	}
+	var n int
+	var err error
+	if r.rle {
+		n, err = r.decode(p)
+	} else {
+		n = copy(p, r.data[r.pos:])
+		r.pos += n
+		if n < len(p) {
+			err = ErrCorrupt
+		}
+	}
+	r.left -= n
+	return n, err
+}
+
+// decode decompresses the next len(p) bytes.
+func (r *Reader) decode(p []byte) (int, error) {
+	for n := range p {
+		if r.run == 0 {
+			if r.pos >= len(r.data) {
+				return n, ErrCorrupt
+			}
+			c := r.data[r.pos]
+			r.pos++
+			r.repeat = c >= maxLiteral
+			size := int(c) + 1
+			if r.repeat {
+				r.run = int(c) - 126
+				size = 1
+			} else {
+				r.run = size
+			}
+			if r.pos+size*r.unit > len(r.data) {
+				return n, ErrCorrupt
+			}
+			r.off = 0
+		}
+		if r.repeat {
+			p[n] = r.data[r.pos+r.off]
+		} else {
+			p[n] = r.data[r.pos]
+			r.pos++
+		}
+		r.off++
+		if r.off == r.unit {
+			r.off = 0
+			r.run--
+			if r.repeat && r.run == 0 {
+				r.pos += r.unit
+			}
+		}
+	}
+	return len(p), nil
+}
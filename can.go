@@ -0,0 +1,83 @@
+package drivers
+
+// CANFrame is a classic CAN frame with up to 8 data bytes.
+type CANFrame struct {
+	// ID is the 11-bit standard or 29-bit extended identifier.
+	ID uint32
+
+	// Extended is set for frames with a 29-bit identifier.
+	Extended bool
+
+	// Remote is set for remote transmission request frames, which carry no
+	// data.
+	Remote bool
+
+	// DLC is the number of data bytes.
+	DLC uint8
+
+	Data [8]byte
+}
+
+// Payload returns the data bytes of the frame. A DLC above 8 means 8 bytes.
+func (f *CANFrame) Payload() []byte {
+	if f.DLC > 8 {
+		return f.Data[:]
+	}
+	return f.Data[:f.DLC]
+}
+
+// SetPayload copies up to 8 bytes of data into the frame and sets DLC.
+func (f *CANFrame) SetPayload(data []byte) {
+	f.DLC = uint8(copy(f.Data[:], data))
+}
+
+// CANFilter accepts frames of which the identifier bits selected by Mask
+// equal those of ID.
+type CANFilter struct {
+	ID   uint32
+	Mask uint32
+
+	// Extended selects whether the filter applies to extended or standard
+	// frames.
+	Extended bool
+}
+
+// Match reports whether the filter accepts the frame.
+func (f CANFilter) Match(frame *CANFrame) bool {
+	return frame.Extended == f.Extended && frame.ID&f.Mask == f.ID&f.Mask
+}
+
+// CANErrorState is the fault confinement state of a CAN node.
+type CANErrorState uint8
+
+const (
+	// CANErrorActive is the normal state of a node.
+	CANErrorActive CANErrorState = iota
+
+	// CANErrorPassive is entered when an error counter exceeds 127. The node
+	// still takes part in communication but may no longer signal errors.
+	CANErrorPassive
+
+	// CANBusOff is entered when the transmit error counter exceeds 255. The
+	// node does not take part in communication anymore.
+	CANBusOff
+)
+
+// CANBus represents a CAN bus controller. It is implemented by the
+// mcp2515.Device type.
+type CANBus interface {
+	// Send queues a frame for transmission.
+	Send(frame *CANFrame) error
+
+	// Receive stores the next received frame accepted by the filters in
+	// frame. It returns false if no frame is waiting.
+	Receive(frame *CANFrame) (ok bool, err error)
+
+	// SetFilters sets the acceptance filters. A frame is received if any
+	// filter matches it. Without filters, all frames are received.
+	SetFilters(filters ...CANFilter) error
+
+	// ErrorState returns the fault confinement state and the transmit and
+	// receive error counters.
+	ErrorState() (state CANErrorState, txErrors, rxErrors uint8, err error)
+}
@@ -1,48 +1,486 @@
+// Command convert2bin compiles images to Go tables for the
+// tinygo.org/x/drivers/bitmap package.
+//
+// Usage:
+//
+//	convert2bin [flags] FILE... > images.go
+//
+// The PNG, JPEG or GIF files are cropped, resized, and converted to the
+// pixel -format of a display, with dithering for the formats with few
+// colors. A single image is written as a bitmap.Bitmap, several images, or
+// the tiles of -tile, as a bitmap.Sheet. With -type const or bytes, plain
+// Go constants or byte slices are written instead, for programs that do not
+// use the bitmap package.
+//
+// Without -format, a single FILE is written as is to a string constant, to
+// be decoded with the image/png or image/jpeg packages. See
+// ../../image/README.md for that usage.
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
-	"io/ioutil"
+	"go/format"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+
+	"tinygo.org/x/drivers/bitmap"
+	"tinygo.org/x/drivers/pixel"
 )
 
-// See ../../image/README.md for the usage.
+var formats = map[string]pixel.Format{
+	"rgb565":   pixel.RGB565,
+	"bgr565":   pixel.BGR565,
+	"rgb444":   pixel.RGB444,
+	"bgr444":   pixel.BGR444,
+	"rgb888":   pixel.RGB888,
+	"bgr888":   pixel.BGR888,
+	"mono":     pixel.Mono,
+	"gray2":    pixel.Gray2,
+	"gray4":    pixel.Gray4,
+	"gray8":    pixel.Gray8,
+	"tricolor": pixel.TriColor,
+}
+
+var dithers = map[string]pixel.Dither{
+	"none":            pixel.NoDither,
+	"ordered":         pixel.OrderedDither,
+	"floyd-steinberg": pixel.DiffusionDither,
+	"atkinson":        pixel.AtkinsonDither,
+}
+
+// sprite is a converted image.
+type sprite struct {
+	name          string // suffix of the Go names, empty for a single image
+	comment       string
+	width, height int
+	data          []byte // encoded, and compressed with -rle
+}
+
+// options are the flags of the conversion.
+type options struct {
+	format       pixel.Format
+	dither       pixel.Dither
+	crop         image.Rectangle
+	resize, tile image.Point
+	bg           color.RGBA
+	rle          bool
+}
 
 func main() {
-	err := run(os.Args)
+	err := run(os.Args[1:], os.Stdout)
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
-func run(args []string) error {
-	if len(args) < 2 {
-		return fmt.Errorf("usage: %s FILE")
+func run(args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("convert2bin", flag.ContinueOnError)
+	formatFlag := flags.String("format", "", "pixel format: rgb565, bgr565, rgb444, bgr444, rgb888, bgr888, mono, gray2, gray4, gray8 or tricolor")
+	ditherFlag := flags.String("dither", "none", "dithering: none, ordered, floyd-steinberg or atkinson")
+	crop := flags.String("crop", "", "part of the images to keep, as WxH+X+Y")
+	resize := flags.String("resize", "", "size of the images after cropping, as WxH; a zero keeps the aspect ratio")
+	tile := flags.String("tile", "", "split the images in sprites of WxH")
+	bg := flags.String("bg", "000000", "RGB color of transparent pixels")
+	rle := flags.Bool("rle", false, "compress with run-length encoding")
+	typ := flags.String("type", "bitmap", "output: bitmap (bitmap package table), const (string constants) or bytes (byte slices)")
+	pkg := flags.String("pkg", "main", "package name")
+	name := flags.String("name", "", "Go name (default from the file name)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	files := flags.Args()
+	if len(files) == 0 {
+		return fmt.Errorf("usage: convert2bin [flags] FILE...")
+	}
+	if *formatFlag == "" {
+		if len(files) != 1 {
+			return fmt.Errorf("usage: convert2bin FILE")
+		}
+		return dump(w, files[0])
+	}
+
+	var o options
+	var ok bool
+	if o.format, ok = formats[*formatFlag]; !ok {
+		return fmt.Errorf("invalid -format %q", *formatFlag)
+	}
+	if o.dither, ok = dithers[*ditherFlag]; !ok {
+		return fmt.Errorf("invalid -dither %q", *ditherFlag)
+	}
+	var err error
+	if *crop != "" {
+		if o.crop, err = parseGeometry(*crop); err != nil {
+			return fmt.Errorf("invalid -crop: %v", err)
+		}
+	}
+	if *resize != "" {
+		if o.resize, err = parseSize(*resize); err != nil {
+			return fmt.Errorf("invalid -resize: %v", err)
+		}
+	}
+	if *tile != "" {
+		if o.tile, err = parseSize(*tile); err != nil || o.tile.X == 0 || o.tile.Y == 0 {
+			return fmt.Errorf("invalid -tile %q", *tile)
+		}
+	}
+	if o.bg, err = parseColor(*bg); err != nil {
+		return fmt.Errorf("invalid -bg: %v", err)
+	}
+	o.rle = *rle
+	if *typ != "bitmap" && *typ != "const" && *typ != "bytes" {
+		return fmt.Errorf("invalid -type %q", *typ)
+	}
+	if *name == "" {
+		*name = varName(files[0], "Image")
+		if len(files) > 1 {
+			*name = "Sprites"
+		}
+	}
+
+	var sprites []sprite
+	for _, file := range files {
+		s, err := convert(file, o)
+		if err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+		if len(files) > 1 {
+			prefix := varName(file, "Sprite")
+			for i := range s {
+				s[i].name = prefix + s[i].name
+			}
+		}
+		sprites = append(sprites, s...)
+	}
+
+	cmdline := make([]string, 0, len(args))
+	cmdline = append(cmdline, args[:len(args)-len(files)]...)
+	for _, file := range files {
+		cmdline = append(cmdline, filepath.Base(file))
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by convert2bin %s; DO NOT EDIT.\n\n", strings.Join(cmdline, " "))
+	fmt.Fprintf(&buf, "package %s\n\n", *pkg)
+	if *typ == "bitmap" {
+		writeBitmap(&buf, *name, o, sprites)
+	} else {
+		writeConst(&buf, *name, o, sprites, *typ == "bytes")
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// convert reads an image and returns its sprites.
+func convert(file string, o options) ([]sprite, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	src, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+
+	r := src.Bounds()
+	if !o.crop.Empty() {
+		r = o.crop.Add(r.Min).Intersect(r)
+		if r.Empty() {
+			return nil, fmt.Errorf("crop outside the image")
+		}
+	}
+	size := o.resize
+	switch {
+	case size.X == 0 && size.Y == 0:
+		size = r.Size()
+	case size.X == 0:
+		size.X = (r.Dx()*size.Y + r.Dy()/2) / r.Dy()
+	case size.Y == 0:
+		size.Y = (r.Dy()*size.X + r.Dx()/2) / r.Dx()
 	}
+	if size.X < 1 || size.Y < 1 || size.X > 0x7FFF || size.Y > 0x7FFF {
+		return nil, fmt.Errorf("invalid size %v", size)
+	}
+	// Transparent pixels are blended with the background.
+	m := image.NewRGBA(image.Rectangle{Max: size})
+	draw.Draw(m, m.Bounds(), image.NewUniform(o.bg), image.Point{}, draw.Src)
+	if size == r.Size() {
+		draw.Draw(m, m.Bounds(), src, r.Min, draw.Over)
+	} else {
+		scale(m, src, r)
+	}
+
+	// Dither the whole image, then cut the tiles.
+	values := make([]uint32, size.X*size.Y)
+	conv := pixel.Converter{Format: o.format, Dither: o.dither}
+	row := make([]color.RGBA, size.X)
+	encoded := make([]byte, o.format.BufferSize(size.X))
+	for y := 0; y < size.Y; y++ {
+		for x := range row {
+			row[x] = m.RGBAAt(x, y)
+		}
+		conv.ConvertRow(encoded, row, 0, y)
+		for x := range row {
+			values[y*size.X+x] = o.format.Get(encoded, x)
+		}
+	}
+	tile := o.tile
+	if tile == (image.Point{}) {
+		tile = size
+	}
+	if size.X%tile.X != 0 || size.Y%tile.Y != 0 {
+		return nil, fmt.Errorf("%dx%d image is not a multiple of the %dx%d tiles", size.X, size.Y, tile.X, tile.Y)
+	}
+	var sprites []sprite
+	for ty := 0; ty < size.Y; ty += tile.Y {
+		for tx := 0; tx < size.X; tx += tile.X {
+			s := sprite{
+				comment: filepath.Base(file),
+				width:   tile.X,
+				height:  tile.Y,
+				data:    make([]byte, o.format.BufferSize(tile.X*tile.Y)),
+			}
+			if tile != size {
+				s.name = strconv.Itoa(len(sprites))
+				s.comment += fmt.Sprintf(" at %d,%d", tx, ty)
+			}
+			for y := 0; y < tile.Y; y++ {
+				for x := 0; x < tile.X; x++ {
+					o.format.Set(s.data, y*tile.X+x, values[(ty+y)*size.X+tx+x])
+				}
+			}
+			if o.rle {
+				s.data = bitmap.Compress(s.data, o.format)
+			}
+			sprites = append(sprites, s)
+		}
+	}
+	return sprites, nil
+}
+
+// scale draws r of src over the whole of dst. Every pixel of dst is the
+// average of the pixels of src under it, or the nearest one when enlarging.
+func scale(dst *image.RGBA, src image.Image, r image.Rectangle) {
+	b := dst.Bounds()
+	for y := 0; y < b.Dy(); y++ {
+		y0, y1 := span(y, b.Dy(), r.Min.Y, r.Dy())
+		for x := 0; x < b.Dx(); x++ {
+			x0, x1 := span(x, b.Dx(), r.Min.X, r.Dx())
+			var sr, sg, sb, sa, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					sr, sg, sb, sa = sr+uint64(cr), sg+uint64(cg), sb+uint64(cb), sa+uint64(ca)
+					n++
+				}
+			}
+			c := color.RGBA64{uint16(sr / n), uint16(sg / n), uint16(sb / n), uint16(sa / n)}
+			draw.Draw(dst, image.Rect(b.Min.X+x, b.Min.Y+y, b.Min.X+x+1, b.Min.Y+y+1), image.NewUniform(c), image.Point{}, draw.Over)
+		}
+	}
+}
+
+// span returns the source pixels [start, end) under the pixel i of n
+// destination pixels, for a source of size pixels from min.
+func span(i, n, min, size int) (start, end int) {
+	start = min + i*size/n
+	end = min + (i+1)*size/n
+	if end <= start {
+		end = start + 1
+	}
+	return start, end
+}
+
+// parseSize parses a size WxH.
+func parseSize(s string) (image.Point, error) {
+	w, h, ok := strings.Cut(s, "x")
+	x, err1 := strconv.Atoi(w)
+	y, err2 := strconv.Atoi(h)
+	if !ok || err1 != nil || err2 != nil || x < 0 || y < 0 {
+		return image.Point{}, fmt.Errorf("%q is not WxH", s)
+	}
+	return image.Pt(x, y), nil
+}
+
+// parseGeometry parses a rectangle WxH+X+Y.
+func parseGeometry(s string) (image.Rectangle, error) {
+	parts := strings.Split(s, "+")
+	if len(parts) != 3 {
+		return image.Rectangle{}, fmt.Errorf("%q is not WxH+X+Y", s)
+	}
+	size, err := parseSize(parts[0])
+	x, err1 := strconv.Atoi(parts[1])
+	y, err2 := strconv.Atoi(parts[2])
+	if err != nil || err1 != nil || err2 != nil || size.X == 0 || size.Y == 0 {
+		return image.Rectangle{}, fmt.Errorf("%q is not WxH+X+Y", s)
+	}
+	return image.Rectangle{Max: size}.Add(image.Pt(x, y)), nil
+}
+
+// parseColor parses an RGB color in hexadecimal.
+func parseColor(s string) (color.RGBA, error) {
+	v, err := strconv.ParseUint(strings.TrimPrefix(s, "#"), 16, 32)
+	if err != nil || len(strings.TrimPrefix(s, "#")) != 6 {
+		return color.RGBA{}, fmt.Errorf("%q is not RRGGBB", s)
+	}
+	return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 255}, nil
+}
+
+// varName returns an exported Go name from a file name, or def.
+func varName(file, def string) string {
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	var b strings.Builder
+	upper := true
+	for _, r := range base {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' && b.Len() > 0:
+			if upper {
+				r = []rune(strings.ToUpper(string(r)))[0]
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	if b.Len() == 0 {
+		return def
+	}
+	return b.String()
+}
+
+// formatName returns the Go name of f.
+func formatName(f pixel.Format) string {
+	return [...]string{
+		pixel.RGB565:   "RGB565",
+		pixel.BGR565:   "BGR565",
+		pixel.RGB444:   "RGB444",
+		pixel.BGR444:   "BGR444",
+		pixel.RGB888:   "RGB888",
+		pixel.BGR888:   "BGR888",
+		pixel.Mono:     "Mono",
+		pixel.Gray2:    "Gray2",
+		pixel.Gray4:    "Gray4",
+		pixel.Gray8:    "Gray8",
+		pixel.TriColor: "TriColor",
+	}[f]
+}
+
+// writeBitmap writes the sprites as a bitmap.Bitmap, or a bitmap.Sheet if
+// there are several.
+func writeBitmap(w io.Writer, name string, o options, sprites []sprite) {
+	fmt.Fprintf(w, "import (\n\t\"tinygo.org/x/drivers/bitmap\"\n\t\"tinygo.org/x/drivers/pixel\"\n)\n\n")
+	if len(sprites) == 1 {
+		s := sprites[0]
+		fmt.Fprintf(w, "// %s is %s.\n", name, s.comment)
+		fmt.Fprintf(w, "var %s = bitmap.Bitmap{\n", name)
+		fmt.Fprintf(w, "Width: %d,\nHeight: %d,\n", s.width, s.height)
+		fmt.Fprintf(w, "Format: pixel.%s,\nRLE: %t,\n", formatName(o.format), o.rle)
+		fmt.Fprintf(w, "Data: ")
+		writeString(w, s.data)
+		fmt.Fprintf(w, ",\n}\n")
+		return
+	}
+	var data []byte
+	fmt.Fprintf(w, "var %s = bitmap.Sheet{\n", name)
+	fmt.Fprintf(w, "Format: pixel.%s,\nRLE: %t,\n", formatName(o.format), o.rle)
+	fmt.Fprintf(w, "Sprites: []bitmap.Sprite{\n")
+	for _, s := range sprites {
+		fmt.Fprintf(w, "{Width: %d, Height: %d, Offset: %d}, // %s\n", s.width, s.height, len(data), s.comment)
+		data = append(data, s.data...)
+	}
+	fmt.Fprintf(w, "},\nData: ")
+	writeString(w, data)
+	fmt.Fprintf(w, ",\n}\n\n")
+	fmt.Fprintf(w, "// Indexes of the sprites of %s.\nconst (\n", name)
+	for i, s := range sprites {
+		fmt.Fprintf(w, "%s%s = %d\n", name, s.name, i)
+	}
+	fmt.Fprintf(w, ")\n")
+}
+
+// writeConst writes the size and the data of the sprites as constants, and
+// the data as byte slices if slices is set.
+func writeConst(w io.Writer, name string, o options, sprites []sprite, slices bool) {
+	for _, s := range sprites {
+		n := name + s.name
+		compressed := ""
+		if o.rle {
+			compressed = ", compressed with bitmap.Compress"
+		}
+		fmt.Fprintf(w, "// %s is %s in the pixel.%s format%s.\n", n, s.comment, formatName(o.format), compressed)
+		fmt.Fprintf(w, "const (\n%sWidth = %d\n%sHeight = %d\n)\n\n", n, s.width, n, s.height)
+		if slices {
+			fmt.Fprintf(w, "var %sData = []byte{", n)
+			for i, b := range s.data {
+				if i%16 == 0 {
+					fmt.Fprintf(w, "\n")
+				}
+				fmt.Fprintf(w, "0x%02x, ", b)
+			}
+			fmt.Fprintf(w, "\n}\n\n")
+		} else {
+			fmt.Fprintf(w, "const %sData = ", n)
+			writeString(w, s.data)
+			fmt.Fprintf(w, "\n\n")
+		}
+	}
+}
+
+// writeString writes data as a string literal, 32 bytes per line.
+func writeString(w io.Writer, data []byte) {
+	fmt.Fprintf(w, "\"\"")
+	for i := 0; i < len(data); i += 32 {
+		end := i + 32
+		if end > len(data) {
+			end = len(data)
+		}
+		fmt.Fprintf(w, " +\n\"")
+		for _, b := range data[i:end] {
+			fmt.Fprintf(w, "\\x%02x", b)
+		}
+		fmt.Fprintf(w, "\"")
+	}
+}
 
-	b, err := ioutil.ReadFile(args[1])
+// dump writes a file as is to a string constant.
+func dump(w io.Writer, file string) error {
+	b, err := os.ReadFile(file)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("const %s = \"\" +\n", strings.Replace(args[1], ".", "_", -1))
+	fmt.Fprintf(w, "const %s = \"\" +\n", strings.Replace(file, ".", "_", -1))
 
 	i := 0
 	max := 32
 	for i = 0; i < len(b); i++ {
 		bb := b[i]
 		if (i % max) == 0 {
-			fmt.Printf("	\"")
+			fmt.Fprintf(w, "	\"")
 		}
-		fmt.Printf("\\x%02X", bb)
+		fmt.Fprintf(w, "\\x%02X", bb)
 		if (i%max) == max-1 && i != len(b)-1 {
-			fmt.Printf("\" + \n")
+			fmt.Fprintf(w, "\" + \n")
 		}
 	}
 	if (i % max) < max-1 {
-		fmt.Printf("\"\n")
+		fmt.Fprintf(w, "\"\n")
 	}
 
 	return nil
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/bitmap"
+	"tinygo.org/x/drivers/pixel"
+)
+
+// writePNG writes a 16x8 image with a white left half, a red 4x4 square at
+// the bottom right and transparent pixels elsewhere.
+func writePNG(c *qt.C, name string) string {
+	m := image.NewNRGBA(image.Rect(0, 0, 16, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 16; x++ {
+			switch {
+			case x < 8:
+				m.SetNRGBA(x, y, color.NRGBA{255, 255, 255, 255})
+			case x >= 12 && y >= 4:
+				m.SetNRGBA(x, y, color.NRGBA{255, 0, 0, 255})
+			}
+		}
+	}
+	file := filepath.Join(c.TempDir(), name)
+	f, err := os.Create(file)
+	c.Assert(err, qt.IsNil)
+	defer f.Close()
+	c.Assert(png.Encode(f, m), qt.IsNil)
+	return file
+}
+
+// pixels returns the uncompressed pixel values of a sprite.
+func pixels(c *qt.C, s sprite, o options) []uint32 {
+	b := bitmap.Bitmap{Width: int16(s.width), Height: int16(s.height), Format: o.format, RLE: o.rle, Data: string(s.data)}
+	data, err := io.ReadAll(b.NewReader())
+	c.Assert(err, qt.IsNil)
+	v := make([]uint32, s.width*s.height)
+	for i := range v {
+		v[i] = o.format.Get(data, i)
+	}
+	return v
+}
+
+func TestConvert(t *testing.T) {
+	c := qt.New(t)
+	file := writePNG(c, "icon.png")
+
+	o := options{format: pixel.TriColor, rle: true, bg: color.RGBA{0, 0, 0, 255}}
+	s, err := convert(file, o)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.HasLen, 1)
+	c.Assert([]int{s[0].width, s[0].height}, qt.DeepEquals, []int{16, 8})
+	v := pixels(c, s[0], o)
+	c.Assert(v[0], qt.Equals, uint32(pixel.TriWhite))
+	c.Assert(v[8], qt.Equals, uint32(pixel.TriBlack))
+	c.Assert(v[7*16+15], qt.Equals, uint32(pixel.TriRed))
+
+	// Crop the right half, scale it down by 2 and cut it in two tiles.
+	o = options{format: pixel.Gray8, bg: color.RGBA{0, 0, 255, 255}, crop: image.Rect(8, 0, 16, 8), resize: image.Pt(4, 0), tile: image.Pt(2, 4)}
+	s, err = convert(file, o)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.HasLen, 2)
+	c.Assert(s[1].name, qt.Equals, "1")
+	c.Assert(s[1].comment, qt.Equals, "icon.png at 2,0")
+	blue, red := pixel.Gray(color.RGBA{0, 0, 255, 255}), pixel.Gray(color.RGBA{255, 0, 0, 255})
+	c.Assert(pixels(c, s[0], o)[:4], qt.DeepEquals, []uint32{uint32(blue), uint32(blue), uint32(blue), uint32(blue)})
+	c.Assert(pixels(c, s[1], o)[7], qt.Equals, uint32(red))
+
+	o.tile = image.Pt(3, 3)
+	_, err = convert(file, o)
+	c.Assert(err, qt.ErrorMatches, "4x4 image is not a multiple of the 3x3 tiles")
+}
+
+func TestRun(t *testing.T) {
+	c := qt.New(t)
+	file := writePNG(c, "my-icon.png")
+
+	var out bytes.Buffer
+	c.Assert(run([]string{"-format", "mono", "-dither", "atkinson", "-rle", "-pkg", "icons", file}, &out), qt.IsNil)
+	src := out.String()
+	c.Assert(src, qt.Contains, "// Code generated by convert2bin -format mono -dither atkinson -rle -pkg icons my-icon.png; DO NOT EDIT.")
+	c.Assert(src, qt.Contains, "package icons")
+	c.Assert(src, qt.Contains, "var MyIcon = bitmap.Bitmap{\n\tWidth:  16,\n\tHeight: 8,\n\tFormat: pixel.Mono,\n\tRLE:    true,\n")
+
+	// Several files are a sheet.
+	other := writePNG(c, "other.png")
+	out.Reset()
+	c.Assert(run([]string{"-format", "rgb565", "-tile", "8x8", file, other}, &out), qt.IsNil)
+	src = out.String()
+	c.Assert(src, qt.Contains, "var Sprites = bitmap.Sheet{")
+	c.Assert(src, qt.Contains, "{Width: 8, Height: 8, Offset: 384}, // other.png at 8,0")
+	c.Assert(src, qt.Contains, "SpritesOther1  = 3")
+
+	out.Reset()
+	c.Assert(run([]string{"-format", "gray4", "-type", "bytes", "-name", "Logo", file}, &out), qt.IsNil)
+	src = out.String()
+	c.Assert(src, qt.Contains, "// Logo is my-icon.png in the pixel.Gray4 format.")
+	c.Assert(src, qt.Contains, "LogoWidth  = 16")
+	c.Assert(src, qt.Contains, "var LogoData = []byte{\n\t0xff, 0xff, 0xff, 0xff, 0x00,")
+
+	out.Reset()
+	c.Assert(run([]string{"-format", "gray4", "-type", "const", "-name", "Logo", file}, &out), qt.IsNil)
+	c.Assert(out.String(), qt.Contains, "const LogoData = \"\" +\n\t\"\\xff\\xff\\xff\\xff\\x00")
+
+	c.Assert(run([]string{"-format", "rgb", file}, &out), qt.ErrorMatches, `invalid -format "rgb"`)
+	c.Assert(run([]string{"-format", "mono", "-crop", "8x8", file}, &out), qt.ErrorMatches, `invalid -crop: "8x8" is not WxH\+X\+Y`)
+}
+
+func TestDump(t *testing.T) {
+	c := qt.New(t)
+	dir := c.TempDir()
+	c.Assert(os.WriteFile(filepath.Join(dir, "a.bin"), []byte{1, 2, 0xFF}, 0o644), qt.IsNil)
+	wd, err := os.Getwd()
+	c.Assert(err, qt.IsNil)
+	c.Assert(os.Chdir(dir), qt.IsNil)
+	defer os.Chdir(wd)
+
+	var out bytes.Buffer
+	c.Assert(run([]string{"a.bin"}, &out), qt.IsNil)
+	c.Assert(out.String(), qt.Equals, "const a_bin = \"\" +\n\t\"\\x01\\x02\\xFF\"\n")
+}
+
+func TestScale(t *testing.T) {
+	c := qt.New(t)
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		src.SetRGBA(x, 0, color.RGBA{uint8(x * 40), 0, 0, 255})
+		src.SetRGBA(x, 1, color.RGBA{0, 0, 200, 255})
+	}
+
+	// Shrinking averages the pixels, also across rows.
+	dst := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	scale(dst, src, src.Bounds())
+	c.Assert(dst.RGBAAt(0, 0), qt.Equals, color.RGBA{10, 0, 100, 255})
+	c.Assert(dst.RGBAAt(1, 0), qt.Equals, color.RGBA{50, 0, 100, 255})
+
+	// Enlarging repeats the nearest pixels.
+	dst = image.NewRGBA(image.Rect(0, 0, 4, 4))
+	scale(dst, src, image.Rect(2, 0, 4, 2))
+	c.Assert(dst.RGBAAt(1, 1), qt.Equals, color.RGBA{80, 0, 0, 255})
+	c.Assert(dst.RGBAAt(3, 3), qt.Equals, color.RGBA{0, 0, 200, 255})
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// parseBDF reads the glyphs of a BDF font that are in ranges.
+func parseBDF(r io.Reader, ranges [][2]rune) (*face, error) {
+	f := &face{}
+	var g glyph
+	var bbox [4]int // width, height, x and y of the font bounding box
+	bitmap := -1    // row of the bitmap being read, or -1
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if bitmap >= 0 && fields[0] != "ENDCHAR" {
+			if bitmap >= g.height {
+				return nil, fmt.Errorf("line %d: too many bitmap rows", line)
+			}
+			row, err := strconv.ParseUint(fields[0], 16, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			// Rows are padded to whole bytes, first pixel in the most
+			// significant bit.
+			n := len(fields[0]) * 4
+			for x := 0; x < g.width && x < n; x++ {
+				if row>>(n-1-x)&1 != 0 {
+					g.alpha[bitmap*g.width+x] = 255
+				}
+			}
+			bitmap++
+			continue
+		}
+		ints := atoi(fields[1:])
+		switch fields[0] {
+		case "FONT":
+			if f.name == "" && len(fields) > 1 {
+				f.name = fields[1]
+			}
+		case "FAMILY_NAME":
+			if len(fields) > 1 {
+				f.name = strings.Trim(strings.Join(fields[1:], " "), `"`)
+			}
+		case "FONTBOUNDINGBOX":
+			if len(ints) < 4 {
+				return nil, fmt.Errorf("line %d: invalid FONTBOUNDINGBOX", line)
+			}
+			copy(bbox[:], ints)
+		case "FONT_ASCENT":
+			if len(ints) > 0 {
+				f.ascent = ints[0]
+			}
+		case "FONT_DESCENT":
+			if len(ints) > 0 {
+				f.descent = ints[0]
+			}
+		case "STARTCHAR":
+			g = glyph{r: -1}
+		case "ENCODING":
+			if len(ints) > 0 {
+				g.r = rune(ints[0])
+			}
+		case "DWIDTH":
+			if len(ints) > 0 {
+				g.advance = ints[0]
+			}
+		case "BBX":
+			if len(ints) < 4 {
+				return nil, fmt.Errorf("line %d: invalid BBX", line)
+			}
+			g.width, g.height = ints[0], ints[1]
+			g.x, g.y = ints[2], -(ints[3] + ints[1])
+		case "BITMAP":
+			g.alpha = make([]uint8, g.width*g.height)
+			bitmap = 0
+		case "ENDCHAR":
+			bitmap = -1
+			if g.r >= 0 && inRanges(g.r, ranges) {
+				f.glyphs = append(f.glyphs, g)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if f.ascent == 0 && f.descent == 0 {
+		f.ascent = bbox[1] + bbox[3]
+		f.descent = -bbox[3]
+	}
+	f.lineHeight = f.ascent + f.descent
+	return f, nil
+}
+
+// atoi parses the integer fields of a line, up to the first one that is not
+// an integer.
+func atoi(fields []string) []int {
+	var ints []int
+	for _, s := range fields {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			break
+		}
+		ints = append(ints, n)
+	}
+	return ints
+}
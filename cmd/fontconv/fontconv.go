@@ -0,0 +1,259 @@
+// Command fontconv converts a BDF or TrueType font to a Go table for the
+// tinygo.org/x/drivers/font package.
+//
+// Usage:
+//
+//	fontconv [flags] FILE > font.go
+//
+// BDF fonts are converted at their own size with 1 bit per pixel. TrueType
+// and OpenType fonts are rendered at -size pixels, anti-aliased with -bpp
+// bits per pixel.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// glyph is a rune converted from the source font, with its coverage from 0
+// to 255 for every pixel.
+type glyph struct {
+	r             rune
+	width, height int
+	x, y          int // top left corner from the pen position
+	advance       int
+	alpha         []uint8
+}
+
+// face is a font converted from the source font.
+type face struct {
+	name                        string
+	ascent, descent, lineHeight int
+	glyphs                      []glyph
+}
+
+func main() {
+	err := run(os.Args[1:], os.Stdout)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("fontconv", flag.ContinueOnError)
+	size := flags.Float64("size", 12, "size in pixels of TrueType fonts")
+	bpp := flags.Int("bpp", 1, "bits per pixel of TrueType fonts: 1, 2 or 4")
+	runes := flags.String("runes", "32-126", "ranges of runes to convert, such as 32-126,0xA0-0xFF")
+	pkg := flags.String("pkg", "fonts", "package name")
+	name := flags.String("name", "", "variable name (default from the file name)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("usage: fontconv [flags] FILE")
+	}
+	file := flags.Arg(0)
+	if *bpp != 1 && *bpp != 2 && *bpp != 4 {
+		return fmt.Errorf("invalid -bpp %d", *bpp)
+	}
+	ranges, err := parseRanges(*runes)
+	if err != nil {
+		return err
+	}
+	if *name == "" {
+		*name = varName(file)
+	}
+
+	var f *face
+	if strings.EqualFold(filepath.Ext(file), ".bdf") {
+		r, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		f, err = parseBDF(r, ranges)
+		if err != nil {
+			return err
+		}
+		*bpp = 1
+	} else {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		f, err = renderTTF(data, *size, ranges)
+		if err != nil {
+			return err
+		}
+	}
+	sort.Slice(f.glyphs, func(i, j int) bool { return f.glyphs[i].r < f.glyphs[j].r })
+	for i := range f.glyphs {
+		f.glyphs[i].crop()
+	}
+	cmdline := append(args[:len(args)-1:len(args)-1], filepath.Base(file))
+	var buf bytes.Buffer
+	if err := f.write(&buf, *pkg, *name, *bpp, strings.Join(cmdline, " ")); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// parseRanges parses a comma separated list of runes and rune ranges.
+func parseRanges(s string) ([][2]rune, error) {
+	var ranges [][2]rune
+	for _, part := range strings.Split(s, ",") {
+		lo, hi, found := strings.Cut(strings.TrimSpace(part), "-")
+		first, err := strconv.ParseInt(lo, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rune range %q", part)
+		}
+		last := first
+		if found {
+			last, err = strconv.ParseInt(hi, 0, 32)
+			if err != nil || last < first {
+				return nil, fmt.Errorf("invalid rune range %q", part)
+			}
+		}
+		ranges = append(ranges, [2]rune{rune(first), rune(last)})
+	}
+	return ranges, nil
+}
+
+func inRanges(r rune, ranges [][2]rune) bool {
+	for _, rg := range ranges {
+		if r >= rg[0] && r <= rg[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// varName returns an exported Go name from a file name.
+func varName(file string) string {
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	var b strings.Builder
+	upper := true
+	for _, r := range base {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' && b.Len() > 0:
+			if upper {
+				r = []rune(strings.ToUpper(string(r)))[0]
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	if b.Len() == 0 {
+		return "Font"
+	}
+	return b.String()
+}
+
+// crop removes the empty rows and columns around the bitmap.
+func (g *glyph) crop() {
+	top, bottom, left, right := g.height, 0, g.width, 0
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			if g.alpha[y*g.width+x] == 0 {
+				continue
+			}
+			if y < top {
+				top = y
+			}
+			if y >= bottom {
+				bottom = y + 1
+			}
+			if x < left {
+				left = x
+			}
+			if x >= right {
+				right = x + 1
+			}
+		}
+	}
+	if top >= bottom {
+		g.width, g.height, g.x, g.y, g.alpha = 0, 0, 0, 0, nil
+		return
+	}
+	alpha := make([]uint8, 0, (right-left)*(bottom-top))
+	for y := top; y < bottom; y++ {
+		alpha = append(alpha, g.alpha[y*g.width+left:y*g.width+right]...)
+	}
+	g.x += left
+	g.y += top
+	g.width, g.height, g.alpha = right-left, bottom-top, alpha
+}
+
+// write writes the Go source of the font table.
+func (f *face) write(w io.Writer, pkg, name string, bpp int, cmdline string) error {
+	var bitmap []byte
+	var bits, pixels int
+	max := 1<<bpp - 1
+	fmt.Fprintf(w, "// Code generated by fontconv %s; DO NOT EDIT.\n\n", cmdline)
+	fmt.Fprintf(w, "package %s\n\n", pkg)
+	fmt.Fprintf(w, "import \"tinygo.org/x/drivers/font\"\n\n")
+	fmt.Fprintf(w, "var %s = font.Font{\n", name)
+	fmt.Fprintf(w, "\tName:         %q,\n", f.name)
+	fmt.Fprintf(w, "\tBitsPerPixel: %d,\n", bpp)
+	fmt.Fprintf(w, "\tAscent:       %d,\n", f.ascent)
+	fmt.Fprintf(w, "\tDescent:      %d,\n", f.descent)
+	fmt.Fprintf(w, "\tLineHeight:   %d,\n", f.lineHeight)
+	fmt.Fprintf(w, "\tGlyphs: []font.Glyph{\n")
+	for _, g := range f.glyphs {
+		if g.width > 255 || g.height > 255 || g.advance > 255 || g.advance < 0 ||
+			g.x < -128 || g.x > 127 || g.y < -128 || g.y > 127 {
+			return fmt.Errorf("glyph %U is too large", g.r)
+		}
+		fmt.Fprintf(w, "\t\t{Rune: %#x, Width: %d, Height: %d, XOffset: %d, YOffset: %d, Advance: %d, Offset: %d}, // %q\n",
+			g.r, g.width, g.height, g.x, g.y, g.advance, pixels, g.r)
+		for _, a := range g.alpha {
+			v := (int(a)*max + 127) / 255
+			if bits%8 == 0 {
+				bitmap = append(bitmap, 0)
+			}
+			bitmap[len(bitmap)-1] |= byte(v << (8 - bpp - bits%8))
+			bits += bpp
+		}
+		pixels += len(g.alpha)
+	}
+	fmt.Fprintf(w, "\t},\n")
+	fmt.Fprintf(w, "\tBitmap: \"\" +\n")
+	for i := 0; i < len(bitmap); i += 32 {
+		end := i + 32
+		if end > len(bitmap) {
+			end = len(bitmap)
+		}
+		fmt.Fprintf(w, "\t\t\"")
+		for _, b := range bitmap[i:end] {
+			fmt.Fprintf(w, "\\x%02x", b)
+		}
+		fmt.Fprintf(w, "\"")
+		if end < len(bitmap) {
+			fmt.Fprintf(w, " +")
+		} else {
+			fmt.Fprintf(w, ",")
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	if len(bitmap) == 0 {
+		fmt.Fprintf(w, "\t\t\"\",\n")
+	}
+	_, err := fmt.Fprintf(w, "}\n")
+	return err
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const testBDF = `STARTFONT 2.1
+FONT -test-fixed
+FONTBOUNDINGBOX 4 6 0 -1
+STARTPROPERTIES 3
+FAMILY_NAME "Test"
+FONT_ASCENT 5
+FONT_DESCENT 1
+ENDPROPERTIES
+CHARS 2
+STARTCHAR A
+ENCODING 65
+DWIDTH 5 0
+BBX 4 6 0 -1
+BITMAP
+00
+60
+90
+F0
+90
+00
+ENDCHAR
+STARTCHAR uni00E9
+ENCODING 233
+DWIDTH 5 0
+BBX 4 6 0 -1
+BITMAP
+20
+60
+F0
+80
+70
+00
+ENDCHAR
+ENDFONT
+`
+
+func TestBDF(t *testing.T) {
+	c := qt.New(t)
+	f, err := parseBDF(strings.NewReader(testBDF), [][2]rune{{32, 126}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(f.name, qt.Equals, "Test")
+	c.Assert(f.ascent, qt.Equals, 5)
+	c.Assert(f.lineHeight, qt.Equals, 6)
+	c.Assert(f.glyphs, qt.HasLen, 1)
+
+	g := f.glyphs[0]
+	c.Assert(g.r, qt.Equals, 'A')
+	c.Assert(g.y, qt.Equals, -5)
+	g.crop()
+	c.Assert(g.width, qt.Equals, 4)
+	c.Assert(g.height, qt.Equals, 4)
+	c.Assert(g.y, qt.Equals, -4)
+	c.Assert(g.advance, qt.Equals, 5)
+}
+
+func TestRun(t *testing.T) {
+	c := qt.New(t)
+	file := filepath.Join(c.TempDir(), "test-font.bdf")
+	c.Assert(os.WriteFile(file, []byte(testBDF), 0o644), qt.IsNil)
+
+	var out bytes.Buffer
+	err := run([]string{"-runes", "0x41,0xE9", file}, &out)
+	c.Assert(err, qt.IsNil)
+	src := out.String()
+	c.Assert(src, qt.Contains, "var TestFont = font.Font{")
+	c.Assert(src, qt.Contains, "{Rune: 0x41, Width: 4, Height: 4, XOffset: 0, YOffset: -4, Advance: 5, Offset: 0},")
+	c.Assert(src, qt.Contains, "{Rune: 0xe9, Width: 4, Height: 5, XOffset: 0, YOffset: -5, Advance: 5, Offset: 16}, // 'é'")
+	// 'A' is .xx. x..x xxxx x..x
+	c.Assert(src, qt.Contains, `"\x69\xf9`)
+}
@@ -0,0 +1,16 @@
+module tinygo.org/x/drivers/cmd/fontconv
+
+go 1.19
+
+require (
+	github.com/frankban/quicktest v1.10.2
+	golang.org/x/image v0.18.0
+)
+
+require (
+	github.com/google/go-cmp v0.5.2 // indirect
+	github.com/kr/pretty v0.2.1 // indirect
+	github.com/kr/text v0.1.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543 // indirect
+)
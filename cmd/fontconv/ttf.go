@@ -0,0 +1,63 @@
+package main
+
+import (
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// renderTTF renders the glyphs of a TrueType or OpenType font that are in
+// ranges, size pixels high.
+func renderTTF(data []byte, size float64, ranges [][2]rune) (*face, error) {
+	otf, err := opentype.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	ff, err := opentype.NewFace(otf, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer ff.Close()
+
+	f := &face{}
+	if name, err := otf.Name(nil, sfnt.NameIDFull); err == nil {
+		f.name = name
+	}
+	m := ff.Metrics()
+	f.ascent, f.descent, f.lineHeight = m.Ascent.Ceil(), m.Descent.Ceil(), m.Height.Ceil()
+
+	var buf sfnt.Buffer
+	for _, rg := range ranges {
+		for r := rg[0]; r <= rg[1]; r++ {
+			if i, err := otf.GlyphIndex(&buf, r); err != nil || i == 0 {
+				continue // not in the font
+			}
+			dr, mask, mp, advance, ok := ff.Glyph(fixed.Point26_6{}, r)
+			if !ok {
+				continue
+			}
+			g := glyph{
+				r:       r,
+				width:   dr.Dx(),
+				height:  dr.Dy(),
+				x:       dr.Min.X,
+				y:       dr.Min.Y,
+				advance: advance.Round(),
+			}
+			g.alpha = make([]uint8, g.width*g.height)
+			for y := 0; y < g.height; y++ {
+				for x := 0; x < g.width; x++ {
+					_, _, _, a := mask.At(mp.X+x, mp.Y+y).RGBA()
+					g.alpha[y*g.width+x] = uint8(a >> 8)
+				}
+			}
+			f.glyphs = append(f.glyphs, g)
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,147 @@
+package display
+
+import (
+	"errors"
+	"image/color"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
+)
+
+// ErrBandBuffer is returned by DrawBands when its buffer cannot hold a
+// single row of the display.
+var ErrBandBuffer = errors.New("band buffer too small for a row of the display")
+
+// BandWriter is implemented by displays that can be sent the image in
+// horizontal bands, without a framebuffer for the whole display, such as
+// e-paper displays configured without buffer.
+type BandWriter interface {
+	drivers.Displayer
+
+	// PixelFormat returns the format of the band data.
+	PixelFormat() pixel.Format
+
+	// BandColor returns c in PixelFormat, converted with the same color rule
+	// as SetPixel, so that an image looks the same drawn in bands.
+	BandColor(c color.RGBA) uint32
+
+	// WriteBand writes the rows [y, y+height) of the display, encoded in
+	// PixelFormat. Bands are written in order from the top, and the display
+	// is updated after the last one.
+	WriteBand(y, height int16, data []byte) error
+}
+
+// Band is a horizontal strip of a display, drawn in a buffer. It implements
+// drivers.Displayer with the size of the whole display, so the same drawing
+// code can draw every band: pixels outside of the band are ignored.
+type Band struct {
+	// Y is the first row of the band, and Height its number of rows.
+	Y, Height int16
+
+	width, height int16
+	format        pixel.Format
+	convert       func(c color.RGBA) uint32 // color in format
+	buf           []byte
+	direct        drivers.Displayer // draw on it rather than in buf
+}
+
+// Size returns the size of the whole display.
+func (b *Band) Size() (x, y int16) {
+	return b.width, b.height
+}
+
+// SetPixel sets a pixel if it is inside the band.
+func (b *Band) SetPixel(x, y int16, c color.RGBA) {
+	if x < 0 || x >= b.width || y < b.Y || y >= b.Y+b.Height {
+		return
+	}
+	if b.direct != nil {
+		b.direct.SetPixel(x, y, c)
+		return
+	}
+	b.format.Set(b.buf, int(y-b.Y)*int(b.width)+int(x), b.convert(c))
+}
+
+// Display does nothing, the band is sent when the draw function returns.
+func (b *Band) Display() error {
+	return nil
+}
+
+// Clear sets the whole band to a single color.
+func (b *Band) Clear(c color.RGBA) {
+	if b.direct != nil {
+		for y := b.Y; y < b.Y+b.Height; y++ {
+			for x := int16(0); x < b.width; x++ {
+				b.direct.SetPixel(x, y, c)
+			}
+		}
+		return
+	}
+	v := b.convert(c)
+	for i := 0; i < int(b.width)*int(b.Height); i++ {
+		b.format.Set(b.buf, i, v)
+	}
+}
+
+// Contains reports whether the rows [y0, y1) overlap the band, so drawing
+// code can skip what is not visible in it.
+func (b *Band) Contains(y0, y1 int16) bool {
+	return y0 < b.Y+b.Height && y1 > b.Y
+}
+
+// DrawBands draws on d in horizontal bands, so full screen images can be
+// drawn without a framebuffer for the whole display. For every band, draw
+// is called to draw the screen, and the band is written to the display. buf
+// holds the band, so its size sets the number of rows of a band.
+//
+// Displays implementing Drawer are written with DrawRGBBitmap8, and
+// those implementing BandWriter with WriteBand, with the colors converted
+// by BandColor. Other displays have their own framebuffer: draw is called
+// once, drawing directly on d.
+func DrawBands(d drivers.Displayer, buf []byte, draw func(b *Band)) error {
+	var format pixel.Format
+	var convert func(c color.RGBA) uint32
+	var write func(b *Band, data []byte) error
+	update := d.Display
+	switch d := d.(type) {
+	case Drawer:
+		format = d.PixelFormat()
+		convert = format.Convert
+		write = func(b *Band, data []byte) error {
+			return d.DrawRGBBitmap8(0, b.Y, data, b.width, b.Height)
+		}
+	case BandWriter:
+		format = d.PixelFormat()
+		convert = d.BandColor
+		write = func(b *Band, data []byte) error {
+			return d.WriteBand(b.Y, b.Height, data)
+		}
+		update = func() error { return nil }
+	default:
+		w, h := d.Size()
+		draw(&Band{Height: h, width: w, height: h, direct: d})
+		return d.Display()
+	}
+
+	b := &Band{format: format, convert: convert}
+	b.width, b.height = d.Size()
+	rows := int16(0)
+	for format.BufferSize(int(b.width)*int(rows+1)) <= len(buf) && rows < b.height {
+		rows++
+	}
+	if rows == 0 {
+		return ErrBandBuffer
+	}
+	for b.Y = 0; b.Y < b.height; b.Y += rows {
+		b.Height = rows
+		if b.Y+rows > b.height {
+			b.Height = b.height - b.Y
+		}
+		b.buf = buf[:format.BufferSize(int(b.width)*int(b.Height))]
+		draw(b)
+		if err := write(b, b.buf); err != nil {
+			return err
+		}
+	}
+	return update()
+}
@@ -0,0 +1,134 @@
+package display
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/pixel"
+	"tinygo.org/x/drivers/tester"
+)
+
+// bandScreen is a monochrome display that is only written in bands.
+type bandScreen struct {
+	W, H  int16
+	Bands []int16 // y and height of every band
+	Data  []byte
+}
+
+func (s *bandScreen) Size() (int16, int16)              { return s.W, s.H }
+func (s *bandScreen) SetPixel(x, y int16, c color.RGBA) {}
+func (s *bandScreen) Display() error                    { return nil }
+func (s *bandScreen) PixelFormat() pixel.Format         { return pixel.Mono }
+
+func (s *bandScreen) BandColor(c color.RGBA) uint32 { return pixel.Mono.Convert(c) }
+
+func (s *bandScreen) WriteBand(y, height int16, data []byte) error {
+	s.Bands = append(s.Bands, y, height)
+	s.Data = append(s.Data, data...)
+	return nil
+}
+
+// inkScreen is a bandScreen with the color rule of the e-paper displays:
+// black is shown as white, the other colors as black ink. SetPixel draws in
+// Buffer.
+type inkScreen struct {
+	bandScreen
+	Buffer []byte
+}
+
+func (s *inkScreen) SetPixel(x, y int16, c color.RGBA) {
+	v := uint32(0)
+	if Ink(c) == 0 {
+		v = 1
+	}
+	pixel.Mono.Set(s.Buffer, int(y)*int(s.W)+int(x), v)
+}
+
+func (s *inkScreen) BandColor(c color.RGBA) uint32 {
+	if Ink(c) == 0 {
+		return 1
+	}
+	return 0
+}
+
+// buffered hides the BandWriter methods of a display.
+type buffered struct {
+	s *inkScreen
+}
+
+func (b buffered) Size() (int16, int16)              { return b.s.Size() }
+func (b buffered) SetPixel(x, y int16, c color.RGBA) { b.s.SetPixel(x, y, c) }
+func (b buffered) Display() error                    { return nil }
+
+// diagonal draws a red diagonal line on a white background.
+func diagonal(b *Band) {
+	b.Clear(white)
+	w, h := b.Size()
+	for i := int16(0); i < w && i < h; i++ {
+		b.SetPixel(i, i, red)
+	}
+}
+
+func TestDrawBands(t *testing.T) {
+	c := qt.New(t)
+
+	// Drawers get every band with DrawRGBBitmap8.
+	s := tester.NewScreen(8, 10)
+	calls := 0
+	err := DrawBands(NewDrawer(s), make([]byte, 3*8*2+5), func(b *Band) {
+		calls++
+		c.Assert(b.Height == 3 || b.Y == 9 && b.Height == 1, qt.IsTrue)
+		diagonal(b)
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(calls, qt.Equals, 4)
+	for y := int16(0); y < 8; y++ {
+		c.Assert(s.At(y, y), qt.Equals, red)
+		c.Assert(s.At((y+1)%8, y), qt.Equals, white)
+	}
+	c.Assert(s.At(0, 9), qt.Equals, white)
+
+	// Band writers get the bands in their format.
+	bs := &bandScreen{W: 16, H: 4}
+	err = DrawBands(bs, make([]byte, 5), func(b *Band) {
+		c.Assert(b.Contains(0, 1), qt.Equals, b.Y == 0)
+		diagonal(b)
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(bs.Bands, qt.DeepEquals, []int16{0, 2, 2, 2})
+	c.Assert(bs.Data, qt.DeepEquals, []byte{
+		0x7F, 0xFF,
+		0xBF, 0xFF,
+		0xDF, 0xFF,
+		0xEF, 0xFF,
+	})
+
+	c.Assert(DrawBands(bs, make([]byte, 1), diagonal), qt.Equals, ErrBandBuffer)
+
+	// Other displays are drawn on directly.
+	s = tester.NewScreen(3, 3)
+	c.Assert(DrawBands(s, nil, diagonal), qt.IsNil)
+	c.Assert(s.At(2, 2), qt.Equals, red)
+	c.Assert(s.At(0, 2), qt.Equals, white)
+}
+
+func TestDrawBandsColorRule(t *testing.T) {
+	c := qt.New(t)
+
+	// Bands look the same as the image drawn with SetPixel.
+	draw := func(b *Band) {
+		b.Clear(black)
+		w, h := b.Size()
+		for i := int16(0); i < w && i < h; i++ {
+			b.SetPixel(i, i, red)
+			b.SetPixel(w-1-i, i, white)
+		}
+	}
+	s := &inkScreen{bandScreen: bandScreen{W: 16, H: 4}, Buffer: make([]byte, 8)}
+	c.Assert(DrawBands(buffered{s}, nil, draw), qt.IsNil)
+	c.Assert(DrawBands(s, make([]byte, 2), draw), qt.IsNil)
+	c.Assert(s.Data, qt.DeepEquals, s.Buffer)
+	c.Assert(s.Data[:2], qt.DeepEquals, []byte{0x7F, 0xFE})
+}
@@ -0,0 +1,103 @@
+package display
+
+// DirtyRows tracks the changed bytes of a framebuffer, so a display driver
+// only needs to send those. The framebuffer is seen as rows of bytes that can
+// be written to the display separately, such as the pages of an OLED display
+// or the lines of an e-paper display. For every row the range of changed
+// bytes is kept.
+type DirtyRows struct {
+	width      int16
+	start, end []int16
+}
+
+// Init sizes the tracker for a framebuffer of rows*width bytes and marks all
+// of it as changed, as the display content is not known yet.
+func (d *DirtyRows) Init(rows int, width int16) {
+	d.width = width
+	if cap(d.start) < rows {
+		d.start = make([]int16, rows)
+		d.end = make([]int16, rows)
+	}
+	d.start = d.start[:rows]
+	d.end = d.end[:rows]
+	d.MarkAll()
+}
+
+// Mark marks a byte as changed.
+func (d *DirtyRows) Mark(row int, col int16) {
+	if d.start[row] >= d.end[row] {
+		d.start[row], d.end[row] = col, col+1
+		return
+	}
+	if col < d.start[row] {
+		d.start[row] = col
+	}
+	if col >= d.end[row] {
+		d.end[row] = col + 1
+	}
+}
+
+// MarkAll marks the whole framebuffer as changed.
+func (d *DirtyRows) MarkAll() {
+	for i := range d.start {
+		d.start[i], d.end[i] = 0, d.width
+	}
+}
+
+// Clear marks the whole framebuffer as unchanged, usually after sending it.
+func (d *DirtyRows) Clear() {
+	for i := range d.start {
+		d.start[i], d.end[i] = 0, 0
+	}
+}
+
+// Span returns the changed bytes of a row as the half-open range
+// [start, end). It is empty if the row did not change.
+func (d *DirtyRows) Span(row int) (start, end int16) {
+	return d.start[row], d.end[row]
+}
+
+// Bounds returns the smallest rectangle containing all changes: the rows
+// [top, bottom) and the bytes [start, end). It is empty (top == bottom) if
+// nothing changed.
+func (d *DirtyRows) Bounds() (top, bottom int, start, end int16) {
+	top = -1
+	for i := range d.start {
+		if d.start[i] >= d.end[i] {
+			continue
+		}
+		if top < 0 {
+			top, start, end = i, d.start[i], d.end[i]
+		}
+		bottom = i + 1
+		if d.start[i] < start {
+			start = d.start[i]
+		}
+		if d.end[i] > end {
+			end = d.end[i]
+		}
+	}
+	if top < 0 {
+		return 0, 0, 0, 0
+	}
+	return top, bottom, start, end
+}
+
+// Full reports whether the whole framebuffer changed.
+func (d *DirtyRows) Full() bool {
+	for i := range d.start {
+		if d.start[i] != 0 || d.end[i] != d.width {
+			return false
+		}
+	}
+	return true
+}
+
+// Stats counts the data sent to a display.
+type Stats struct {
+	// Updates is the number of Display calls that sent data.
+	Updates int
+
+	// Bytes is the number of command and data bytes sent.
+	Bytes int
+}
@@ -0,0 +1,37 @@
+package display
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDirtyRows(t *testing.T) {
+	c := qt.New(t)
+	var d DirtyRows
+	d.Init(4, 16)
+	c.Assert(d.Full(), qt.IsTrue)
+
+	d.Clear()
+	c.Assert(d.Full(), qt.IsFalse)
+	top, bottom, _, _ := d.Bounds()
+	c.Assert(top, qt.Equals, bottom)
+
+	d.Mark(1, 5)
+	d.Mark(1, 2)
+	d.Mark(3, 9)
+	start, end := d.Span(1)
+	c.Assert([]int16{start, end}, qt.DeepEquals, []int16{2, 6})
+	start, end = d.Span(2)
+	c.Assert(start >= end, qt.IsTrue)
+
+	top, bottom, start, end = d.Bounds()
+	c.Assert(top, qt.Equals, 1)
+	c.Assert(bottom, qt.Equals, 4)
+	c.Assert([]int16{start, end}, qt.DeepEquals, []int16{2, 10})
+
+	d.MarkAll()
+	c.Assert(d.Full(), qt.IsTrue)
+	start, end = d.Span(2)
+	c.Assert([]int16{start, end}, qt.DeepEquals, []int16{0, 16})
+}
@@ -0,0 +1,118 @@
+// Package display provides helpers to draw on any drivers.Displayer.
+//
+// Drivers of TFT and OLED displays implement Drawer, which draws
+// whole rectangles at once. NewDrawer gives the same interface for other
+// displays, such as framebuffer-only ones, so graphics code can use it
+// everywhere.
+//
+// DrawBands draws a whole screen in horizontal bands, for displays that are
+// too large for a framebuffer in RAM.
+//
+// Mono draws on monochrome displays by brightness rather than with the rule
+// of their driver, which usually lights a pixel for any color but black.
+package display // import "tinygo.org/x/drivers/display"
+
+import (
+	"errors"
+	"image/color"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
+)
+
+var (
+	ErrOutOfBounds = errors.New("rectangle coordinates outside display area")
+	ErrBufferSize  = errors.New("buffer length does not match with rectangle size")
+)
+
+// Drawer is a drivers.Displayer that can draw rectangles without a SetPixel
+// call per pixel, usually by writing a window of display memory at once. It
+// is implemented by the TFT and OLED drivers. Other displays can be wrapped
+// with NewDrawer.
+type Drawer interface {
+	drivers.Displayer
+
+	// FillRectangle fills a rectangle with a single color.
+	FillRectangle(x, y, width, height int16, c color.RGBA) error
+
+	// FillRectangleWithBuffer draws a bitmap of width*height colors, row by
+	// row.
+	FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error
+
+	// DrawRGBBitmap8 writes a w*h bitmap, already encoded in the format
+	// returned by PixelFormat, to the rectangle.
+	DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error
+
+	// PixelFormat returns the format used by DrawRGBBitmap8.
+	PixelFormat() pixel.Format
+}
+
+// NewDrawer returns d as a Drawer. If d does not implement it, it is
+// wrapped in a software implementation that draws with SetPixel.
+func NewDrawer(d drivers.Displayer) Drawer {
+	if drawer, ok := d.(Drawer); ok {
+		return drawer
+	}
+	return &softDrawer{Displayer: d, format: pixel.RGB565}
+}
+
+// softDrawer implements Drawer with SetPixel.
+type softDrawer struct {
+	drivers.Displayer
+	format pixel.Format
+}
+
+func (s *softDrawer) FillRectangle(x, y, width, height int16, c color.RGBA) error {
+	if !InBounds(s, x, y, width, height) {
+		return ErrOutOfBounds
+	}
+	for j := y; j < y+height; j++ {
+		for i := x; i < x+width; i++ {
+			s.SetPixel(i, j, c)
+		}
+	}
+	return nil
+}
+
+func (s *softDrawer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	if !InBounds(s, x, y, width, height) {
+		return ErrOutOfBounds
+	}
+	if int(width)*int(height) != len(buffer) {
+		return ErrBufferSize
+	}
+	for j := int16(0); j < height; j++ {
+		for i := int16(0); i < width; i++ {
+			s.SetPixel(x+i, y+j, buffer[int(j)*int(width)+int(i)])
+		}
+	}
+	return nil
+}
+
+func (s *softDrawer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if !InBounds(s, x, y, w, h) {
+		return ErrOutOfBounds
+	}
+	if s.format.BufferSize(int(w)*int(h)) != len(data) {
+		return ErrBufferSize
+	}
+	for j := int16(0); j < h; j++ {
+		for i := int16(0); i < w; i++ {
+			v := s.format.Get(data, int(j)*int(w)+int(i))
+			s.SetPixel(x+i, y+j, s.format.Color(v))
+		}
+	}
+	return nil
+}
+
+func (s *softDrawer) PixelFormat() pixel.Format {
+	return s.format
+}
+
+// InBounds reports whether the rectangle is non-empty and lies within the
+// display.
+func InBounds(d drivers.Displayer, x, y, width, height int16) bool {
+	w, h := d.Size()
+	return x >= 0 && y >= 0 && width > 0 && height > 0 &&
+		int(x)+int(width) <= int(w) && int(y)+int(height) <= int(h)
+}
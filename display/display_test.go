@@ -0,0 +1,119 @@
+package display
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
+	"tinygo.org/x/drivers/tester"
+)
+
+var (
+	black = color.RGBA{0, 0, 0, 255}
+	white = color.RGBA{255, 255, 255, 255}
+	red   = color.RGBA{255, 0, 0, 255}
+)
+
+// drawerScreen already implements Drawer.
+type drawerScreen struct {
+	Drawer
+}
+
+func TestNewDrawer(t *testing.T) {
+	c := qt.New(t)
+	s := tester.NewScreen(4, 3)
+	d := NewDrawer(s)
+
+	c.Assert(d.FillRectangle(1, 1, 2, 2, red), qt.IsNil)
+	c.Assert(s.At(0, 0), qt.Equals, color.RGBA{})
+	c.Assert(s.At(1, 1), qt.Equals, red)
+	c.Assert(s.At(2, 2), qt.Equals, red)
+	c.Assert(s.At(3, 2), qt.Equals, color.RGBA{})
+	c.Assert(d.FillRectangle(3, 0, 2, 1, red), qt.Equals, ErrOutOfBounds)
+	c.Assert(d.FillRectangle(0, 0, 0, 1, red), qt.Equals, ErrOutOfBounds)
+
+	c.Assert(d.FillRectangleWithBuffer(0, 0, 2, 1, []color.RGBA{white, black}), qt.IsNil)
+	c.Assert(s.At(0, 0), qt.Equals, white)
+	c.Assert(s.At(1, 0), qt.Equals, black)
+	c.Assert(d.FillRectangleWithBuffer(0, 0, 2, 2, []color.RGBA{white}), qt.Equals, ErrBufferSize)
+
+	c.Assert(d.PixelFormat(), qt.Equals, pixel.RGB565)
+	c.Assert(d.DrawRGBBitmap8(2, 0, []byte{0xF8, 0x00, 0xFF, 0xFF}, 2, 1), qt.IsNil)
+	c.Assert(s.At(2, 0), qt.Equals, red)
+	c.Assert(s.At(3, 0), qt.Equals, white)
+	c.Assert(d.DrawRGBBitmap8(2, 0, []byte{0xF8}, 2, 1), qt.Equals, ErrBufferSize)
+
+	// Drivers that implement Drawer are used as they are.
+	ds := drawerScreen{d}
+	c.Assert(NewDrawer(ds), qt.Equals, Drawer(ds))
+}
+
+func TestRotated(t *testing.T) {
+	c := qt.New(t)
+	s := tester.NewScreen(4, 3)
+	r := NewRotated(s, drivers.Rotation0)
+	var _ drivers.Rotator = r
+
+	for _, tc := range []struct {
+		rotation drivers.Rotation
+		w, h     int16
+		x, y     int16 // physical position of the logical (0, 0) and (1, 0)
+		x1, y1   int16
+	}{
+		{drivers.Rotation0, 4, 3, 0, 0, 1, 0},
+		{drivers.Rotation90, 3, 4, 3, 0, 3, 1},
+		{drivers.Rotation180, 4, 3, 3, 2, 2, 2},
+		{drivers.Rotation270, 3, 4, 0, 2, 0, 1},
+	} {
+		*s = *tester.NewScreen(4, 3)
+		r.SetRotation(tc.rotation)
+		c.Assert(r.GetRotation(), qt.Equals, tc.rotation)
+		w, h := r.Size()
+		c.Assert([]int16{w, h}, qt.DeepEquals, []int16{tc.w, tc.h})
+		r.SetPixel(0, 0, red)
+		r.SetPixel(1, 0, white)
+		c.Assert(s.At(tc.x, tc.y), qt.Equals, red, qt.Commentf("rotation %d", tc.rotation))
+		c.Assert(s.At(tc.x1, tc.y1), qt.Equals, white, qt.Commentf("rotation %d", tc.rotation))
+	}
+
+	// The fallback drawer works with the rotated size.
+	r.SetRotation(drivers.Rotation90)
+	c.Assert(NewDrawer(r).FillRectangle(0, 0, 3, 4, black), qt.IsNil)
+	c.Assert(s.At(3, 2), qt.Equals, black)
+}
+
+func TestMono(t *testing.T) {
+	c := qt.New(t)
+	s := tester.NewScreen(4, 4)
+	m := NewMono(s)
+	m.SetPixel(0, 0, white)
+	m.SetPixel(1, 0, red) // dark
+	m.SetPixel(2, 0, color.RGBA{255, 255, 0, 255})
+	c.Assert(s.At(0, 0), qt.Equals, white)
+	c.Assert(s.At(1, 0), qt.Equals, black)
+	c.Assert(s.At(2, 0), qt.Equals, white)
+
+	// Ink displays are passed black to leave a pixel white.
+	ink := NewMonoInk(s)
+	ink.SetPixel(0, 1, white)
+	ink.SetPixel(1, 1, red)
+	c.Assert(s.At(0, 1), qt.Equals, black)
+	c.Assert(s.At(1, 1), qt.Equals, white)
+
+	// Half gray is dithered to half the pixels.
+	m.Dither = pixel.OrderedDither
+	gray := color.RGBA{128, 128, 128, 255}
+	var lit int
+	for y := int16(0); y < 4; y++ {
+		for x := int16(0); x < 4; x++ {
+			m.SetPixel(x, y, gray)
+			if s.At(x, y) == white {
+				lit++
+			}
+		}
+	}
+	c.Assert(lit, qt.Equals, 8)
+}
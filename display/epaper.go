@@ -0,0 +1,108 @@
+package display
+
+import (
+	"errors"
+	"image/color"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+// ErrRefreshMode is returned by SetRefreshMode for the modes a display does
+// not support.
+var ErrRefreshMode = errors.New("refresh mode not supported by this display")
+
+// RefreshMode is the way an e-paper display updates its panel.
+type RefreshMode uint8
+
+const (
+	// FullRefresh drives every pixel through black and white. It is slow and
+	// flashes, but removes any ghosting.
+	FullRefresh RefreshMode = iota
+
+	// PartialRefresh only drives the pixels that changed, without flashing.
+	// It is fast, but leaves some ghosting behind that builds up over time.
+	PartialRefresh
+
+	// GrayRefresh is a full refresh showing 4 levels of gray.
+	GrayRefresh
+)
+
+// Refresher is implemented by e-paper drivers that support several refresh
+// modes.
+type Refresher interface {
+	// SetRefreshMode selects the refresh done by the next Display calls. It
+	// returns ErrRefreshMode if the display does not support the mode.
+	SetRefreshMode(mode RefreshMode) error
+
+	// ForceFullRefresh makes the next Display call do a full refresh.
+	ForceFullRefresh()
+}
+
+// RefreshPolicy decides which refresh an e-paper driver does on Display.
+// Partial refreshes are turned into full ones every FullEvery updates, to
+// clean up ghosting. The zero value never forces a full refresh.
+type RefreshPolicy struct {
+	// FullEvery is the number of partial refreshes after which a full
+	// refresh is done instead. Zero disables this.
+	FullEvery int
+
+	partials int
+	last     RefreshMode
+	force    bool
+}
+
+// Next returns the refresh to do when the given mode is requested, and
+// records it.
+func (p *RefreshPolicy) Next(mode RefreshMode) RefreshMode {
+	if mode == PartialRefresh {
+		// The gray levels cannot be partially updated, and a refresh can
+		// be forced by the application or the ghosting limit.
+		if !p.force && p.last != GrayRefresh && (p.FullEvery == 0 || p.partials < p.FullEvery) {
+			p.partials++
+			p.last = mode
+			return mode
+		}
+		mode = FullRefresh
+	}
+	p.partials = 0
+	p.force = false
+	p.last = mode
+	return mode
+}
+
+// ForceFull makes the next refresh a full one.
+func (p *RefreshPolicy) ForceFull() {
+	p.force = true
+}
+
+// Partials returns the number of partial refreshes since the last full one.
+func (p *RefreshPolicy) Partials() int {
+	return p.partials
+}
+
+// GrayPlane returns one bit plane of a pixel.Gray2 framebuffer, as sent to
+// e-paper displays with a grayscale waveform: the gray level of a pixel is
+// selected by its bit in the old (plane 1) and new (plane 0) data. The
+// result holds the bits of the pixels 8*i to 8*i+7, first pixel in the most
+// significant bit.
+func GrayPlane(buf []byte, i int, plane uint8) byte {
+	var b byte
+	for _, v := range buf[2*i : 2*i+2] {
+		for shift := 6; shift >= 0; shift -= 2 {
+			b = b<<1 | (v>>(uint(shift)+uint(plane)))&1
+		}
+	}
+	return b
+}
+
+// Ink returns the amount of ink an e-paper driver puts on a pixel of color c,
+// from 0 for white to 3 for black. As in SetPixel of the monochrome drivers,
+// RGBA(0,0,0) leaves the pixel white and any other color inks it, so the same
+// drawing shows in black and white and in gray levels: in 4 gray levels the
+// brighter the color, the darker the pixel.
+func Ink(c color.RGBA) uint8 {
+	if c.R == 0 && c.G == 0 && c.B == 0 {
+		return 0
+	}
+	return 1 + uint8(uint16(pixel.Gray(c))*3/256)
+}
@@ -0,0 +1,66 @@
+package display
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+func TestRefreshPolicy(t *testing.T) {
+	c := qt.New(t)
+	p := RefreshPolicy{FullEvery: 2}
+
+	c.Assert(p.Next(FullRefresh), qt.Equals, FullRefresh)
+	c.Assert(p.Next(PartialRefresh), qt.Equals, PartialRefresh)
+	c.Assert(p.Next(PartialRefresh), qt.Equals, PartialRefresh)
+	c.Assert(p.Partials(), qt.Equals, 2)
+	c.Assert(p.Next(PartialRefresh), qt.Equals, FullRefresh)
+	c.Assert(p.Partials(), qt.Equals, 0)
+	c.Assert(p.Next(PartialRefresh), qt.Equals, PartialRefresh)
+
+	p.ForceFull()
+	c.Assert(p.Next(PartialRefresh), qt.Equals, FullRefresh)
+	c.Assert(p.Next(PartialRefresh), qt.Equals, PartialRefresh)
+
+	// Gray levels are cleaned up with a full refresh first.
+	c.Assert(p.Next(GrayRefresh), qt.Equals, GrayRefresh)
+	c.Assert(p.Next(PartialRefresh), qt.Equals, FullRefresh)
+
+	// The zero value never forces a full refresh.
+	var zero RefreshPolicy
+	for i := 0; i < 100; i++ {
+		c.Assert(zero.Next(PartialRefresh), qt.Equals, PartialRefresh)
+	}
+}
+
+func TestGrayPlane(t *testing.T) {
+	c := qt.New(t)
+	buf := make([]byte, pixel.Gray2.BufferSize(16))
+	for i, v := range []uint32{3, 2, 1, 0, 0, 1, 2, 3, 3, 3, 0, 0, 0, 0, 0, 1} {
+		pixel.Gray2.Set(buf, i, v)
+	}
+	c.Assert(GrayPlane(buf, 0, 1), qt.Equals, byte(0b11000011))
+	c.Assert(GrayPlane(buf, 0, 0), qt.Equals, byte(0b10100101))
+	c.Assert(GrayPlane(buf, 1, 1), qt.Equals, byte(0b11000000))
+	c.Assert(GrayPlane(buf, 1, 0), qt.Equals, byte(0b11000001))
+}
+
+func TestInk(t *testing.T) {
+	c := qt.New(t)
+	for _, tc := range []struct {
+		c   color.RGBA
+		ink uint8
+	}{
+		{color.RGBA{0, 0, 0, 255}, 0},
+		{color.RGBA{0, 0, 0, 0}, 0},
+		{color.RGBA{0, 0, 1, 255}, 1},
+		{color.RGBA{255, 0, 0, 255}, 1},
+		{color.RGBA{128, 128, 128, 255}, 2},
+		{color.RGBA{255, 255, 255, 255}, 3},
+	} {
+		c.Assert(Ink(tc.c), qt.Equals, tc.ink, qt.Commentf("%v", tc.c))
+	}
+}
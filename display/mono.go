@@ -0,0 +1,50 @@
+package display
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
+)
+
+// Mono draws on a monochrome display by brightness: light colors are drawn
+// with Light and dark colors with Dark, optionally with ordered dithering.
+//
+// Drivers of monochrome displays keep their own rule in SetPixel, which is
+// usually that any color but black turns a pixel on, so pure red is as bright
+// as white. Wrap them in a Mono to draw colored graphics or images on them.
+type Mono struct {
+	drivers.Displayer
+
+	// Light and Dark are the colors passed to the display for light and dark
+	// pixels.
+	Light, Dark color.RGBA
+
+	// Dither is NoDither or OrderedDither. Error diffusion needs whole rows,
+	// see pixel.Converter.
+	Dither pixel.Dither
+}
+
+// NewMono returns d drawn by brightness, for displays that light a pixel for
+// any color but black, such as OLED displays and LED matrices.
+func NewMono(d drivers.Displayer) *Mono {
+	return &Mono{Displayer: d, Light: color.RGBA{255, 255, 255, 255}, Dark: color.RGBA{0, 0, 0, 255}}
+}
+
+// NewMonoInk returns d drawn by brightness, for displays that ink a black
+// pixel for any color but black, such as e-paper displays and the PCD8544:
+// light colors are passed as black to leave the pixels white, and dark colors
+// as white to ink them.
+func NewMonoInk(d drivers.Displayer) *Mono {
+	return &Mono{Displayer: d, Light: color.RGBA{0, 0, 0, 255}, Dark: color.RGBA{255, 255, 255, 255}}
+}
+
+// SetPixel draws the pixel with Light if c is light, otherwise with Dark.
+func (m *Mono) SetPixel(x, y int16, c color.RGBA) {
+	cv := pixel.Converter{Format: pixel.Mono, Dither: m.Dither}
+	if cv.ConvertPixel(c, int(x), int(y)) != 0 {
+		m.Displayer.SetPixel(x, y, m.Light)
+	} else {
+		m.Displayer.SetPixel(x, y, m.Dark)
+	}
+}
@@ -0,0 +1,52 @@
+package display
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+// Rotated rotates a display in software, for displays which cannot rotate
+// themselves. It implements drivers.Rotator.
+type Rotated struct {
+	drivers.Displayer
+	rotation drivers.Rotation
+}
+
+// NewRotated returns d rotated clockwise by the given rotation.
+func NewRotated(d drivers.Displayer, rotation drivers.Rotation) *Rotated {
+	return &Rotated{Displayer: d, rotation: rotation % 4}
+}
+
+// Size returns the size of the display after rotation.
+func (r *Rotated) Size() (x, y int16) {
+	w, h := r.Displayer.Size()
+	if r.rotation == drivers.Rotation90 || r.rotation == drivers.Rotation270 {
+		return h, w
+	}
+	return w, h
+}
+
+// SetPixel sets the pixel at the rotated coordinates.
+func (r *Rotated) SetPixel(x, y int16, c color.RGBA) {
+	w, h := r.Displayer.Size()
+	switch r.rotation {
+	case drivers.Rotation90:
+		x, y = w-1-y, x
+	case drivers.Rotation180:
+		x, y = w-1-x, h-1-y
+	case drivers.Rotation270:
+		x, y = y, h-1-x
+	}
+	r.Displayer.SetPixel(x, y, c)
+}
+
+// GetRotation returns the current rotation.
+func (r *Rotated) GetRotation() drivers.Rotation {
+	return r.rotation
+}
+
+// SetRotation changes the rotation. The display content is not redrawn.
+func (r *Rotated) SetRotation(rotation drivers.Rotation) {
+	r.rotation = rotation % 4
+}
@@ -12,3 +12,40 @@ type Displayer interface {
 	// Display sends the buffer (if any) to the screen.
 	Display() error
 }
+
+// PixelReader is a display whose pixels can be read back, for instance to
+// take a screenshot.
+type PixelReader interface {
+	// ReadPixels reads a rectangle of width*height colors into buffer, row
+	// by row.
+	ReadPixels(x, y, width, height int16, buffer []color.RGBA) error
+}
+
+// Scroller is a display with hardware vertical scrolling.
+type Scroller interface {
+	// SetScrollArea sets the number of lines at the top and bottom of the
+	// display that do not scroll.
+	SetScrollArea(topFixedArea, bottomFixedArea int16)
+
+	// SetScroll sets the first line of the scroll area.
+	SetScroll(line int16)
+
+	// StopScroll returns to normal display mode.
+	StopScroll()
+}
+
+// Rotation is the clockwise rotation of a display.
+type Rotation uint8
+
+const (
+	Rotation0 Rotation = iota
+	Rotation90
+	Rotation180
+	Rotation270
+)
+
+// Rotator is a display which can be rotated.
+type Rotator interface {
+	GetRotation() Rotation
+	SetRotation(rotation Rotation)
+}
@@ -0,0 +1,35 @@
+// Code generated by convert2bin -format mono -dither atkinson -resize 0x64 -rle -name gopher slideshow.jpg; DO NOT EDIT.
+
+package main
+
+import (
+	"tinygo.org/x/drivers/bitmap"
+	"tinygo.org/x/drivers/pixel"
+)
+
+// gopher is slideshow.jpg.
+var gopher = bitmap.Bitmap{
+	Width:  85,
+	Height: 64,
+	Format: pixel.Mono,
+	RLE:    true,
+	Data: "" +
+		"\x00\xb4\x82\x00\x06\x09\x24\x92\x49\xb0\x05\x26\x83\xff\x05\xed\xb6\xd2\x60\x2e\x80\x85\x00\x02\x01\x81\x38\x86\x00\x02\x04\x06" +
+		"\x40\x86\x00\x01\x20\x6e\x86\x00\x02\x01\x02\x60\x86\x00\x02\x08\x0d\x80\x84\x00\x03\x24\x00\x60\xcc\x84\x00\x11\x11\x60\x03\x05" +
+		"\xc0\x00\x04\x00\x02\x24\xb0\xe4\x00\x10\x0e\x00\x01\x87\x81\xff\x7f\xe5\xa0\x00\xc1\x90\x00\x00\x1f\xff\x77\xdb\x00\xc0\x02\x0d" +
+		"\x80\x00\x19\x86\x00\x9b\x39\xc0\x00\x10\x3c\x00\x00\x0e\x87\xff\x16\xc9\x00\x00\x82\x60\x00\x00\x30\xf6\xdf\x16\x02\x00\x04\x17" +
+		"\x00\x00\x63\x4c\x7d\x8c\x73\xc0\x00\x20\xc8\x00\x00\x9b\xdc\xd9\xdf\x80\x40\x01\x83\xc0\x00\x00\x6c\xf6\xdc\xe4\x40\x00\x08\x24" +
+		"\x00\x00\xc6\xe7\x9e\xe7\xa7\xc8\x00\x61\xb0\x00\x01\x27\xfe\xcf\xff\x00\x00\x01\x07\x00\x00\x01\xbf\xb7\x7f\xec\x80\x00\x08\x58" +
+		"\x00\x02\x8d\xff\x4d\xff\x4f\x80\x00\x42\xc0\x00\x04\x6f\x7a\x2f\xfa\x7f\x00\x00\x02\x1a\x00\x00\x03\x7f\x82\x3f\xd9\x00\x00\x10" +
+		"\x70\x00\x04\x99\xf3\x76\x79\x9f\x00\x00\x85\x80\x00\x10\xc0\x78\x38\x36\x00\x00\x06\x2c\x00\x00\x06\xff\x6f\xff\xb0\x00\x00\x31" +
+		"\xa0\x00\x0b\x37\xef\xbd\xfd\x9e\x00\x00\x86\x00\x00\x11\xb6\xef\x7f\x6c\x80\x00\x04\x58\x00\x00\x0d\xed\xde\xef\x60\x00\x00\x22" +
+		"\x80\x00\x08\x6f\xff\xff\xfb\x3c\x00\x01\x1c\x00\x01\x63\x67\xff\xef\x59\x00\x00\x08\x60\x00\x00\x1b\xf7\xff\xee\xc0\x00\x00\x45" +
+		"\x00\x00\x04\xde\xff\xfd\xf6\x78\x00\x03\x38\x00\x03\x8e\xdf\xef\x7f\xb2\x1b\xc8\x00\x10\xc0\x00\x00\x37\xff\xff\xfd\x81\x00\x00" +
+		"\xce\x00\x00\x11\xbf\xff\xff\xec\xe8\x00\x02\x50\x00\x06\x9d\x81\xff\x0a\xe7\x40\x00\x13\x00\x00\x04\x2d\xb3\x29\x10\x81\x00\x00" +
+		"\x8c\x87\x00\x01\x04\xc0\x87\x00\x00\x26\x87\x00\x01\x01\x90\x87\x00\x01\x09\x80\x87\x00\x00\x6c\x87\x00\x01\x01\x60\x87\x00\x00" +
+		"\x1b\x88\x00\x00\xd8\x87\x00\x01\x06\xc0\x85\x00\x05\x08\x00\xf7\x80\x12\x6f\x83\xff\x02\xf0\x07\xbf\x86\xff\x01\xc0\xb5\x81\xff" +
+		"\x36\x6d\xb7\xbf\xff\xff\xfe\x27\xed\xfd\xdb\xdf\xff\xef\x6d\xb6\xdb\x7d\xdb\x7d\xca\x5b\xed\xdb\xdf\xff\xff\xfe\xff\xf1\xbf\xdf" +
+		"\xfd\xff\xff\xed\xb6\xdb\x7f\xbb\x07\xdf\xff\xff\xef\xdf\xff\xff\xfe\xde\x60\x3f\xfe\xd9\xa4\xc2\x84\x00\x2d\x80\x00\x11\x00\x04" +
+		"\x20\x00\x00\x40\x40\x06\x49\x00\x98\x89\x08\x24\x80\x00\x48\x00\x19\x21\x08\x85\x02\x10\x10\x5b\x64\x08\x00\x64\x8f\x8c\x04\x80" +
+		"\x00\x0a\x48\x88\x00\x00\x01\x04\x40\x84\x00\x03\x01\x20\x00\x06\x87\x00\x02\x01\x20\x02\x84\x00",
+}
@@ -0,0 +1,41 @@
+// This example draws a picture compiled with cmd/convert2bin on a 128x64
+// SSD1306 display over I2C, such as the one of the Seeeduino XIAO Expansion
+// Board. gopher.go was generated with:
+//
+//	go run ./cmd/convert2bin -format mono -dither atkinson -resize 0x64 -rle -name gopher examples/ili9341/slideshow/slideshow.jpg > examples/bitmap/gopher.go
+package main
+
+import (
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers/bitmap"
+	"tinygo.org/x/drivers/ssd1306"
+)
+
+func main() {
+	machine.I2C0.Configure(machine.I2CConfig{
+		Frequency: machine.TWI_FREQ_400KHZ,
+	})
+
+	display := ssd1306.NewI2C(machine.I2C0)
+	display.Configure(ssd1306.Config{
+		Address: 0x3C,
+		Width:   128,
+		Height:  64,
+	})
+
+	// Slide the picture from side to side.
+	x, dx := int16(0), int16(1)
+	for {
+		display.ClearBuffer()
+		bitmap.Draw(&display, &gopher, x, 0)
+		display.Display()
+
+		x += dx
+		if x == 0 || x == 128-gopher.Width {
+			dx = -dx
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
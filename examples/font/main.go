@@ -0,0 +1,42 @@
+// This example draws text with the Go fonts on a 128x64 SSD1306 display over
+// I2C, such as the one of the Seeeduino XIAO Expansion Board.
+package main
+
+import (
+	"image/color"
+	"machine"
+	"strconv"
+	"time"
+
+	"tinygo.org/x/drivers/font"
+	"tinygo.org/x/drivers/font/fonts"
+	"tinygo.org/x/drivers/ssd1306"
+)
+
+func main() {
+	machine.I2C0.Configure(machine.I2CConfig{
+		Frequency: machine.TWI_FREQ_400KHZ,
+	})
+
+	display := ssd1306.NewI2C(machine.I2C0)
+	display.Configure(ssd1306.Config{
+		Address: 0x3C,
+		Width:   128,
+		Height:  64,
+	})
+
+	white := color.RGBA{255, 255, 255, 255}
+	f := &fonts.GoRegular12
+	for i := 0; ; i++ {
+		display.ClearBuffer()
+		font.Draw(&display, f, 0, f.Ascent, "Hello, TinyGo!\nGrüße", white)
+
+		// Right align the counter.
+		s := strconv.Itoa(i)
+		w, _ := font.Measure(f, s)
+		font.Draw(&display, f, 128-w, 63-f.Descent, s, white)
+
+		display.Display()
+		time.Sleep(time.Second)
+	}
+}
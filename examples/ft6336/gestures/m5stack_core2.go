@@ -0,0 +1,25 @@
+//go:build m5stack_core2
+// +build m5stack_core2
+
+package main
+
+import (
+	"machine"
+
+	"tinygo.org/x/drivers/ft6336"
+	"tinygo.org/x/drivers/i2csoft"
+	"tinygo.org/x/drivers/touch"
+)
+
+// initDevices initializes the touch screen of the board, calibrated for its
+// 320x240 display with a 320x270 touch area.
+func initDevices() (touch.MultiPointer, error) {
+	i2c := i2csoft.New(machine.SCL0_PIN, machine.SDA0_PIN)
+	i2c.Configure(i2csoft.I2CConfig{Frequency: 100e3})
+
+	touchScreen := ft6336.New(i2c, machine.Pin(39))
+	touchScreen.Configure(ft6336.Config{})
+	touchScreen.SetPeriodActive(0x00)
+
+	return touch.NewCalibrated(touchScreen, touch.Calibration{A: 320, E: 270}, 320, 240), nil
+}
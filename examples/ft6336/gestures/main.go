@@ -0,0 +1,42 @@
+package main
+
+import (
+	"time"
+
+	"tinygo.org/x/drivers/touch"
+)
+
+func main() {
+	touchScreen, _ := initDevices()
+
+	var tracker touch.Tracker
+	var gestures touch.GestureRecognizer
+	events := make([]touch.Event, 0, touch.MaxTouches)
+	start := time.Now()
+	for {
+		now := time.Since(start)
+		events = tracker.Poll(touchScreen, now, events[:0])
+		for _, e := range events {
+			if g, ok := gestures.Event(e); ok {
+				printGesture(g)
+			}
+		}
+		if g, ok := gestures.Tick(now); ok {
+			printGesture(g)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func printGesture(g touch.Gesture) {
+	switch g.Type {
+	case touch.Tap:
+		println("tap", g.X, g.Y)
+	case touch.LongPress:
+		println("long press", g.X, g.Y)
+	case touch.Swipe:
+		println("swipe", g.DX, g.DY, "direction", g.Direction)
+	case touch.Pinch:
+		println("pinch", g.Scale, "%")
+	}
+}
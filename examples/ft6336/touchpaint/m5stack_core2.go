@@ -45,7 +45,7 @@ func initDevices() (touchPaintDisplay, touch.Pointer, error) {
 	})
 	display.FillScreen(color.RGBA{255, 255, 255, 255})
 
-	display.SetRotation(ili9341.Rotation0Mirror)
+	display.SetMirror(true)
 
 	resistiveTouch := ft6336.New(i2c, machine.Pin(39))
 	resistiveTouch.Configure(ft6336.Config{})
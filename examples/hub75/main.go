@@ -19,12 +19,18 @@ func main() {
 	)
 
 	display = hub75.New(machine.SPI0, 11, 12, 6, 10, 18, 20)
-	display.Configure(hub75.Config{
+	err := display.Configure(hub75.Config{
 		Width:      64,
 		Height:     32,
 		RowPattern: 16,
 		ColorDepth: 6,
 	})
+	if err != nil {
+		for {
+			println(err.Error())
+			time.Sleep(time.Second)
+		}
+	}
 
 	colors := []color.RGBA{
 		{255, 0, 0, 255},
@@ -37,7 +37,7 @@ func InitDisplay() *ili9341.Device {
 
 	backlight.High()
 
-	display.SetRotation(ili9341.Rotation0Mirror)
+	display.SetMirror(true)
 
 	return display
 }
@@ -43,7 +43,7 @@ func InitDisplay() *ili9341.Device {
 	})
 	display.FillScreen(color.RGBA{255, 255, 255, 255})
 
-	display.SetRotation(ili9341.Rotation0Mirror)
+	display.SetMirror(true)
 
 	return display
 }
@@ -19,9 +19,9 @@ func main() {
 		return
 	}
 
-	// Create driver for Adafruit 15x7 CharliePlex LED Matrix FeatherWing
+	// Create display for Adafruit 15x7 CharliePlex LED Matrix FeatherWing
 	// (CharlieWing): https://www.adafruit.com/product/3163
-	ledMatrix := is31fl3731.NewAdafruitCharlieWing15x7(bus, I2CAddress)
+	ledMatrix := is31fl3731.NewDisplay(bus, I2CAddress, is31fl3731.LayoutCharlieWing15x7)
 
 	err = ledMatrix.Configure()
 	if err != nil {
@@ -29,23 +29,42 @@ func main() {
 		return
 	}
 
-	// Fill the whole matrix on the frame #0 (visible by default)
-	ledMatrix.Fill(is31fl3731.FRAME_0, uint8(3))
+	// Draw a bar moving over a gradient on each of the 8 frames
+	width, height := ledMatrix.Size()
+	for frame := is31fl3731.FRAME_0; frame <= is31fl3731.FRAME_7; frame++ {
+		ledMatrix.ClearBuffer()
+		for x := int16(0); x < width; x++ {
+			for y := int16(0); y < height; y++ {
+				ledMatrix.SetLevel(x, y, uint8(x*4))
+			}
+		}
+		for y := int16(0); y < height; y++ {
+			ledMatrix.SetLevel(int16(frame)*2, y, 255)
+		}
 
-	// Draw couple pixels on the frame #1 (not visible yet)
-	ledMatrix.DrawPixelXY(is31fl3731.FRAME_1, uint8(0), uint8(0), uint8(10))
-	ledMatrix.DrawPixelXY(is31fl3731.FRAME_1, uint8(14), uint8(6), uint8(10))
+		ledMatrix.SetFrame(frame)
+		err = ledMatrix.Display()
+		if err != nil {
+			println("could not draw frame:", err)
+			return
+		}
+	}
 
-	// There are 8 frames available, it's a good idea to draw on an invisible
-	// frame and then switch to that frame to reduce flickering. Switch between
-	// frame #0 and #1 in a loop to show animation:
+	// Let the chip play the frames by itself, fading between them
+	ledMatrix.SetBreath(100*time.Millisecond, 100*time.Millisecond, 10*time.Millisecond)
 	for {
+		println("autoplay 3 times...")
+		ledMatrix.Autoplay(is31fl3731.FRAME_0, 8, 3, 300*time.Millisecond)
+		for {
+			_, done, _ := ledMatrix.FrameState()
+			if done {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+
 		println("show frame #0...")
 		ledMatrix.SetActiveFrame(is31fl3731.FRAME_0)
 		time.Sleep(time.Second * 3)
-
-		println("show frame #1...")
-		ledMatrix.SetActiveFrame(is31fl3731.FRAME_1)
-		time.Sleep(time.Second * 3)
 	}
 }
@@ -0,0 +1,38 @@
+// This example shows effects on the 10 WS2812 LEDs of a Circuit Playground
+// Express, dimmed to stay within the current the USB port can give.
+package main
+
+import (
+	"image/color"
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers/ledstrip"
+	"tinygo.org/x/drivers/pixel"
+	"tinygo.org/x/drivers/ws2812"
+)
+
+func main() {
+	neo := machine.NEOPIXELS
+	neo.Configure(machine.PinConfig{Mode: machine.PinOutput})
+
+	strip := ledstrip.New(ws2812.New(neo), 10, ledstrip.Config{
+		Gamma:        pixel.NewGamma(2.6),
+		Brightness:   128,
+		MaxMilliamps: 200,
+	})
+	animator := ledstrip.NewAnimator(strip, 50)
+
+	effects := []ledstrip.Effect{
+		&ledstrip.Rainbow{Period: 2 * time.Second},
+		&ledstrip.Comet{Color: color.RGBA{0, 128, 255, 255}, Length: 4, Period: time.Second},
+		ledstrip.NewFire(),
+		&ledstrip.Fade{From: color.RGBA{255, 0, 0, 255}, To: color.RGBA{0, 0, 255, 255}, Duration: 5 * time.Second},
+	}
+	for {
+		for _, e := range effects {
+			animator.Play(e)
+			animator.Run(5 * time.Second)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// This example scrolls text on a 4-in-1 8x8 LED matrix module, made of four
+// chained MAX7219. pixel5x7.go was generated from pixel5x7.bdf with
+// cmd/fontconv, which is a separate module, from the root of the repository:
+//
+//	cd cmd/fontconv
+//	go run . -pkg main -name pixel5x7 ../../examples/max72xx/marquee/pixel5x7.bdf > ../../examples/max72xx/marquee/pixel5x7.go
+package main
+
+import (
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers/max72xx"
+)
+
+func main() {
+	// Pins for Arduino Nano 33 IOT
+	err := machine.SPI0.Configure(machine.SPIConfig{
+		SDO:       machine.D11, // default SDO pin
+		SCK:       machine.D13, // default sck pin
+		Frequency: 10000000,
+	})
+	if err != nil {
+		println(err.Error())
+	}
+
+	chain := max72xx.NewChain(machine.SPI0, machine.D6, 4)
+	chain.Configure()
+	chain.SetIntensity(2)
+
+	matrix := max72xx.NewMatrix(chain, max72xx.MatrixConfig{})
+	matrix.Configure()
+
+	marquee := max72xx.NewMarquee(matrix, &pixel5x7, "Hello, TinyGo!")
+	for {
+		marquee.Step()
+		time.Sleep(40 * time.Millisecond)
+	}
+}
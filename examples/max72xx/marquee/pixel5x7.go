@@ -0,0 +1,121 @@
+// Code generated by fontconv -pkg main -name pixel5x7 pixel5x7.bdf; DO NOT EDIT.
+
+package main
+
+import "tinygo.org/x/drivers/font"
+
+var pixel5x7 = font.Font{
+	Name:         "-misc-pixel-medium-r-normal--7-70-75-75-c-60-iso10646-1",
+	BitsPerPixel: 1,
+	Ascent:       7,
+	Descent:      0,
+	LineHeight:   7,
+	Glyphs: []font.Glyph{
+		{Rune: 0x20, Width: 0, Height: 0, XOffset: 0, YOffset: 0, Advance: 6, Offset: 0},     // ' '
+		{Rune: 0x21, Width: 1, Height: 7, XOffset: 2, YOffset: -7, Advance: 6, Offset: 0},    // '!'
+		{Rune: 0x22, Width: 3, Height: 3, XOffset: 1, YOffset: -7, Advance: 6, Offset: 7},    // '"'
+		{Rune: 0x23, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 16},   // '#'
+		{Rune: 0x24, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 51},   // '$'
+		{Rune: 0x25, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 86},   // '%'
+		{Rune: 0x26, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 121},  // '&'
+		{Rune: 0x27, Width: 2, Height: 3, XOffset: 1, YOffset: -7, Advance: 6, Offset: 156},  // '\''
+		{Rune: 0x28, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 162},  // '('
+		{Rune: 0x29, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 183},  // ')'
+		{Rune: 0x2a, Width: 5, Height: 5, XOffset: 0, YOffset: -6, Advance: 6, Offset: 204},  // '*'
+		{Rune: 0x2b, Width: 5, Height: 5, XOffset: 0, YOffset: -6, Advance: 6, Offset: 229},  // '+'
+		{Rune: 0x2c, Width: 2, Height: 3, XOffset: 1, YOffset: -3, Advance: 6, Offset: 254},  // ','
+		{Rune: 0x2d, Width: 5, Height: 1, XOffset: 0, YOffset: -4, Advance: 6, Offset: 260},  // '-'
+		{Rune: 0x2e, Width: 2, Height: 2, XOffset: 1, YOffset: -2, Advance: 6, Offset: 265},  // '.'
+		{Rune: 0x2f, Width: 5, Height: 5, XOffset: 0, YOffset: -6, Advance: 6, Offset: 269},  // '/'
+		{Rune: 0x30, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 294},  // '0'
+		{Rune: 0x31, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 329},  // '1'
+		{Rune: 0x32, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 350},  // '2'
+		{Rune: 0x33, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 385},  // '3'
+		{Rune: 0x34, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 420},  // '4'
+		{Rune: 0x35, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 455},  // '5'
+		{Rune: 0x36, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 490},  // '6'
+		{Rune: 0x37, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 525},  // '7'
+		{Rune: 0x38, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 560},  // '8'
+		{Rune: 0x39, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 595},  // '9'
+		{Rune: 0x3a, Width: 2, Height: 5, XOffset: 1, YOffset: -6, Advance: 6, Offset: 630},  // ':'
+		{Rune: 0x3b, Width: 2, Height: 6, XOffset: 1, YOffset: -6, Advance: 6, Offset: 640},  // ';'
+		{Rune: 0x3c, Width: 4, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 652},  // '<'
+		{Rune: 0x3d, Width: 5, Height: 3, XOffset: 0, YOffset: -5, Advance: 6, Offset: 680},  // '='
+		{Rune: 0x3e, Width: 4, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 695},  // '>'
+		{Rune: 0x3f, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 723},  // '?'
+		{Rune: 0x40, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 758},  // '@'
+		{Rune: 0x41, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 793},  // 'A'
+		{Rune: 0x42, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 828},  // 'B'
+		{Rune: 0x43, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 863},  // 'C'
+		{Rune: 0x44, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 898},  // 'D'
+		{Rune: 0x45, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 933},  // 'E'
+		{Rune: 0x46, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 968},  // 'F'
+		{Rune: 0x47, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1003}, // 'G'
+		{Rune: 0x48, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1038}, // 'H'
+		{Rune: 0x49, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 1073}, // 'I'
+		{Rune: 0x4a, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1094}, // 'J'
+		{Rune: 0x4b, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1129}, // 'K'
+		{Rune: 0x4c, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1164}, // 'L'
+		{Rune: 0x4d, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1199}, // 'M'
+		{Rune: 0x4e, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1234}, // 'N'
+		{Rune: 0x4f, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1269}, // 'O'
+		{Rune: 0x50, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1304}, // 'P'
+		{Rune: 0x51, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1339}, // 'Q'
+		{Rune: 0x52, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1374}, // 'R'
+		{Rune: 0x53, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1409}, // 'S'
+		{Rune: 0x54, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1444}, // 'T'
+		{Rune: 0x55, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1479}, // 'U'
+		{Rune: 0x56, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1514}, // 'V'
+		{Rune: 0x57, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1549}, // 'W'
+		{Rune: 0x58, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1584}, // 'X'
+		{Rune: 0x59, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1619}, // 'Y'
+		{Rune: 0x5a, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1654}, // 'Z'
+		{Rune: 0x5b, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 1689}, // '['
+		{Rune: 0x5c, Width: 5, Height: 5, XOffset: 0, YOffset: -6, Advance: 6, Offset: 1710}, // '\\'
+		{Rune: 0x5d, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 1735}, // ']'
+		{Rune: 0x5e, Width: 5, Height: 3, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1756}, // '^'
+		{Rune: 0x5f, Width: 5, Height: 1, XOffset: 0, YOffset: -1, Advance: 6, Offset: 1771}, // '_'
+		{Rune: 0x60, Width: 3, Height: 3, XOffset: 1, YOffset: -7, Advance: 6, Offset: 1776}, // '`'
+		{Rune: 0x61, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 1785}, // 'a'
+		{Rune: 0x62, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1810}, // 'b'
+		{Rune: 0x63, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 1845}, // 'c'
+		{Rune: 0x64, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1870}, // 'd'
+		{Rune: 0x65, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 1905}, // 'e'
+		{Rune: 0x66, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1930}, // 'f'
+		{Rune: 0x67, Width: 5, Height: 6, XOffset: 0, YOffset: -6, Advance: 6, Offset: 1965}, // 'g'
+		{Rune: 0x68, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 1995}, // 'h'
+		{Rune: 0x69, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 2030}, // 'i'
+		{Rune: 0x6a, Width: 4, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 2051}, // 'j'
+		{Rune: 0x6b, Width: 4, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 2079}, // 'k'
+		{Rune: 0x6c, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 2107}, // 'l'
+		{Rune: 0x6d, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2128}, // 'm'
+		{Rune: 0x6e, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2153}, // 'n'
+		{Rune: 0x6f, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2178}, // 'o'
+		{Rune: 0x70, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2203}, // 'p'
+		{Rune: 0x71, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2228}, // 'q'
+		{Rune: 0x72, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2253}, // 'r'
+		{Rune: 0x73, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2278}, // 's'
+		{Rune: 0x74, Width: 5, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 2303}, // 't'
+		{Rune: 0x75, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2338}, // 'u'
+		{Rune: 0x76, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2363}, // 'v'
+		{Rune: 0x77, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2388}, // 'w'
+		{Rune: 0x78, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2413}, // 'x'
+		{Rune: 0x79, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2438}, // 'y'
+		{Rune: 0x7a, Width: 5, Height: 5, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2463}, // 'z'
+		{Rune: 0x7b, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 2488}, // '{'
+		{Rune: 0x7c, Width: 1, Height: 7, XOffset: 2, YOffset: -7, Advance: 6, Offset: 2509}, // '|'
+		{Rune: 0x7d, Width: 3, Height: 7, XOffset: 1, YOffset: -7, Advance: 6, Offset: 2516}, // '}'
+		{Rune: 0x7e, Width: 5, Height: 3, XOffset: 0, YOffset: -5, Advance: 6, Offset: 2537}, // '~'
+	},
+	Bitmap: "" +
+		"\xfb\x6d\x52\xbe\xaf\xa9\x44\x7d\x1c\x5f\x13\x19\x11\x11\x31\xb2\x54\x45\x64\xd5\x8a\x92\x23\x11\x25\x42\x55\xd5\x21\x09\xf2\x13" +
+		"\x6f\xf8\x44\x44\x41\xd1\x9d\x73\x17\x2c\x92\x5d\xd1\x08\x88\x8f\xfc\x44\x10\x62\xe1\x19\x52\xf8\x85\xf8\x78\x21\x8b\x8c\x88\x7a" +
+		"\x31\x77\xc2\x22\x21\x08\x74\x62\xe8\xc5\xce\x8c\x5e\x11\x33\xcf\xf3\x61\x24\x84\x21\xf8\x3f\x08\x42\x49\x0e\x88\x44\x40\x11\xd1" +
+		"\x0b\x6b\x57\x3a\x31\xfc\x63\x1f\x46\x3e\x8c\x7c\xe8\xc2\x10\x8b\xb9\x28\xc6\x32\xe7\xe1\x0f\x42\x1f\xfc\x21\xe8\x42\x0e\x8c\x2f" +
+		"\x18\xbe\x31\x8f\xe3\x18\xf4\x92\x5c\xe2\x10\x85\x26\x46\x54\xc5\x25\x18\x42\x10\x84\x3f\x1d\xd6\xb1\x8c\x63\x1c\xd6\x71\x8b\xa3" +
+		"\x18\xc6\x2e\xf4\x63\xe8\x42\x0e\x8c\x63\x59\x37\xd1\x8f\xa9\x28\xbe\x10\x70\x43\xef\x90\x84\x21\x09\x18\xc6\x31\x8b\xa3\x18\xc6" +
+		"\x2a\x24\x63\x1a\xd6\xaa\x8c\x54\x45\x46\x31\x8c\x54\x42\x13\xe1\x11\x11\x0f\xf9\x24\x9e\x08\x20\x83\xc9\x24\xf2\x2a\x3f\x88\xb8" +
+		"\x2f\x8b\xe1\x0b\x66\x31\xf3\xa1\x08\xb8\x21\x6c\xe3\x17\xba\x3f\x83\x8c\x94\x71\x08\x43\xe3\x17\x85\xd0\x85\xb3\x18\xc5\x0c\x92" +
+		"\xe2\x06\x23\x2d\x11\x35\x95\x39\x24\x97\xd5\x6b\x18\xdb\x31\x8c\x5d\x18\xc5\xde\x8f\xa1\x06\xcd\xe1\x0d\xb3\x08\x41\xd0\x70\x7c" +
+		"\x84\x71\x08\x49\xa3\x18\xcd\xb1\x8c\x54\x48\xc6\xb5\x54\x54\x45\x46\x31\x78\x5d\xf1\x11\x1f\x29\x44\x8f\xf8\x91\x4a\x22\xa2",
+}
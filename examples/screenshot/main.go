@@ -0,0 +1,72 @@
+// This example draws on a 128x64 SSD1306 display over I2C, such as the one of
+// the Seeeduino XIAO Expansion Board, and prints a screenshot every 10
+// seconds on the serial port, as a base64 encoded PNG image. Copy the lines
+// between the markers and decode them to see it:
+//
+//	base64 -d > screen.png
+package main
+
+import (
+	"encoding/base64"
+	"image/color"
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers/screenshot"
+	"tinygo.org/x/drivers/ssd1306"
+)
+
+func main() {
+	machine.I2C0.Configure(machine.I2CConfig{
+		Frequency: machine.TWI_FREQ_400KHZ,
+	})
+
+	display := ssd1306.NewI2C(machine.I2C0)
+	display.Configure(ssd1306.Config{
+		Address: 0x3C,
+		Width:   128,
+		Height:  64,
+	})
+
+	white := color.RGBA{255, 255, 255, 255}
+	last := time.Now()
+	for i := int16(0); ; i++ {
+		// A line bouncing between the corners.
+		display.ClearBuffer()
+		x := i % 128
+		for y := int16(0); y < 64; y++ {
+			display.SetPixel((x+y)%128, y, white)
+		}
+		display.Display()
+
+		if time.Since(last) > 10*time.Second {
+			last = time.Now()
+			println("-----BEGIN SCREENSHOT-----")
+			enc := base64.NewEncoder(base64.StdEncoding, &lineWriter{})
+			if err := screenshot.WritePNG(enc, &display); err != nil {
+				println(err.Error())
+			}
+			enc.Close()
+			println()
+			println("-----END SCREENSHOT-----")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// lineWriter writes to the serial port, in lines of 76 characters.
+type lineWriter struct {
+	n int
+}
+
+func (w *lineWriter) Write(b []byte) (int, error) {
+	for _, c := range b {
+		if w.n == 76 {
+			machine.Serial.WriteByte('\n')
+			w.n = 0
+		}
+		machine.Serial.WriteByte(c)
+		w.n++
+	}
+	return len(b), nil
+}
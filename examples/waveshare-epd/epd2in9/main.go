@@ -0,0 +1,65 @@
+package main
+
+import (
+	"machine"
+
+	"image/color"
+
+	"time"
+
+	"tinygo.org/x/drivers/display"
+	"tinygo.org/x/drivers/waveshare-epd/epd2in9"
+)
+
+var epd epd2in9.Device
+
+func main() {
+	machine.SPI0.Configure(machine.SPIConfig{
+		Frequency: 8000000,
+		Mode:      0,
+	})
+
+	epd = epd2in9.New(machine.SPI0, machine.P6, machine.P7, machine.P8, machine.P9)
+	epd.Configure(epd2in9.Config{FullRefreshEvery: 10})
+
+	black := color.RGBA{1, 1, 1, 255}
+	white := color.RGBA{0, 0, 0, 255}
+
+	println("Clear the display")
+	epd.ClearDisplay()
+
+	// Show a checkered board
+	for i := int16(0); i < 16; i++ {
+		for j := int16(0); j < 29; j++ {
+			if (i+j)%2 == 0 {
+				showRect(i*8, j*10, 8, 10, black)
+			}
+		}
+	}
+	println("Show checkered board")
+	epd.Display()
+	println("Waiting for 2 seconds")
+	time.Sleep(2 * time.Second)
+
+	// Blink a square with fast partial refreshes
+	epd.SetRefreshMode(display.PartialRefresh)
+	for i := 0; i < 20; i++ {
+		c := white
+		if i%2 == 0 {
+			c = black
+		}
+		showRect(32, 32, 32, 32, c)
+		epd.Display()
+	}
+
+	epd.DeepSleep()
+	println("You could remove power now")
+}
+
+func showRect(x int16, y int16, w int16, h int16, c color.RGBA) {
+	for i := x; i < x+w; i++ {
+		for j := y; j < y+h; j++ {
+			epd.SetPixel(i, j, c)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"machine"
+
+	"time"
+
+	"tinygo.org/x/drivers/waveshare-epd/epd7in5"
+)
+
+var epd epd7in5.Device
+
+// stripes generates an image of horizontal stripes, one byte at a time, so
+// it does not need to be in RAM.
+type stripes struct {
+	n int
+}
+
+func (s *stripes) Read(buf []byte) (int, error) {
+	for i := range buf {
+		y := s.n / (epd7in5.EPD_WIDTH / 8)
+		if (y/40)%2 == 0 {
+			buf[i] = 0x00 // black
+		} else {
+			buf[i] = 0xFF // white
+		}
+		s.n++
+	}
+	return len(buf), nil
+}
+
+func main() {
+	machine.SPI0.Configure(machine.SPIConfig{
+		Frequency: 8000000,
+		Mode:      0,
+	})
+
+	epd = epd7in5.New(machine.SPI0, machine.P6, machine.P7, machine.P8, machine.P9)
+	epd.Configure(epd7in5.Config{Unbuffered: true})
+
+	println("Clear the display")
+	epd.ClearDisplay()
+
+	println("Show stripes")
+	epd.DisplayFrom(&stripes{})
+	println("Waiting for 2 seconds")
+	time.Sleep(2 * time.Second)
+
+	epd.DeepSleep()
+	println("You could remove power now")
+}
@@ -0,0 +1,38 @@
+// This example shows a control panel made of widgets on a touch screen.
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"tinygo.org/x/drivers/widget"
+)
+
+func main() {
+	display, pointer, err := initDevices()
+	if err != nil {
+		panic(err)
+	}
+
+	screen := widget.NewScreen(display, widget.DefaultTheme)
+	title := widget.NewLabel(widget.Rect{X: 10, Y: 0, W: 300, H: 30}, "Control panel", widget.AlignCenter)
+	level := widget.NewLabel(widget.Rect{X: 250, Y: 40, W: 60, H: 30}, "50", widget.AlignRight)
+	slider := widget.NewSlider(widget.Rect{X: 10, Y: 40, W: 230, H: 30}, 0, 100, 50, func(v int) {
+		level.SetText(strconv.Itoa(v))
+	})
+	mode := widget.NewLabel(widget.Rect{X: 170, Y: 90, W: 140, H: 30}, "Mode: -", widget.AlignLeft)
+	modes := []string{"Off", "Eco", "Normal", "Boost", "Manual", "Schedule"}
+	list := widget.NewList(widget.Rect{X: 10, Y: 90, W: 150, H: 100}, modes, func(i int) {
+		mode.SetText("Mode: " + modes[i])
+	})
+	reset := widget.NewButton(widget.Rect{X: 170, Y: 150, W: 140, H: 40}, "Reset", func() {
+		slider.SetValue(50)
+		level.SetText("50")
+	})
+	screen.Add(title, slider, level, list, mode, reset)
+
+	for {
+		screen.Poll(pointer)
+		time.Sleep(20 * time.Millisecond)
+	}
+}
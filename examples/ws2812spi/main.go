@@ -0,0 +1,32 @@
+// This example shows a rainbow on a strip of 30 WS2812 LEDs whose data line
+// is connected to the SDO pin of the SPI bus of a Seeeduino XIAO.
+package main
+
+import (
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers/ledstrip"
+	"tinygo.org/x/drivers/ws2812spi"
+)
+
+func main() {
+	// 4MHz is exact on the SAMD21: 48MHz/12.
+	const frequency = 4000000
+	machine.SPI0.Configure(machine.SPIConfig{
+		Frequency: frequency,
+	})
+
+	leds, err := ws2812spi.New(machine.SPI0, ws2812spi.Config{Frequency: frequency})
+	if err != nil {
+		println(err.Error())
+		return
+	}
+
+	strip := ledstrip.New(leds, 30, ledstrip.Config{Brightness: 64})
+	animator := ledstrip.NewAnimator(strip, 50)
+	animator.Play(&ledstrip.Rainbow{Period: 3 * time.Second})
+	for {
+		animator.Run(time.Hour)
+	}
+}
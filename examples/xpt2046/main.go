@@ -4,6 +4,7 @@ import (
 	"machine"
 	"time"
 
+	"tinygo.org/x/drivers/touch"
 	"tinygo.org/x/drivers/xpt2046"
 )
 
@@ -21,24 +22,26 @@ func main() {
 		Precision: 10, //Maximum number of samples for a single ReadTouchPoint to improve accuracy.
 	})
 
-	for {
-
-		//Wait for a touch
-		for !touchScreen.Touched() {
-			time.Sleep(50 * time.Millisecond)
-		}
+	//X and Y are 16 bit with 12 bit resolution, Z is typically > 2000 for a touch.
+	//The filter ignores light touches and glitches.
+	filter := &touch.Filter{
+		Pointer:     &touchScreen,
+		MinPressure: 2000,
+		Median:      3,
+		Debounce:    2,
+	}
 
-		touch := touchScreen.ReadTouchPoint()
-		//X and Y are 16 bit with 12 bit resolution and need to be scaled for the display size
-		//Z is 24 bit and is typically > 2000 for a touch
-		println("touch:", touch.X, touch.Y, touch.Z)
-		//Example of scaling for a 240x320 display
-		println("screen:", (touch.X*240)>>16, (touch.Y*320)>>16)
+	//Scale X and Y for a 240x320 display. touch.CalibrateDisplay computes a
+	//precise calibration by touching targets drawn on the display, which can be
+	//saved with MarshalBinary.
+	calibration := touch.Calibration{A: 240, E: 320}
+	pointer := touch.NewCalibrated(filter, calibration, 240, 320)
 
-		//Wait for touch to end
-		for touchScreen.Touched() {
-			time.Sleep(50 * time.Millisecond)
+	for {
+		p := pointer.ReadTouchPoint()
+		if p.Z > 0 {
+			println("screen:", p.X, p.Y, p.Z)
 		}
-
+		time.Sleep(50 * time.Millisecond)
 	}
 }
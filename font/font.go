@@ -0,0 +1,181 @@
+// Package font draws text with proportional bitmap fonts on any
+// drivers.Displayer.
+//
+// Glyphs have 1 bit per pixel, or 2 or 4 bits per pixel for anti-aliased
+// fonts. Fonts are Go tables, usually generated from BDF or TrueType fonts
+// with cmd/fontconv. Text is UTF-8: runes missing in a font are looked up in
+// its Fallback fonts.
+//
+// tinygo.org/x/tinyfont also draws bitmap fonts, with 1 bit per pixel. This
+// package exists for anti-aliased text: its glyphs keep the coverage of every
+// pixel, which tinyfont's format cannot store, so it does not build on it.
+// Use tinyfont for its large set of existing fonts.
+package font // import "tinygo.org/x/drivers/font"
+
+import (
+	"image/color"
+	"unicode/utf8"
+
+	"tinygo.org/x/drivers"
+)
+
+// Font is a bitmap font.
+type Font struct {
+	Name string
+
+	// BitsPerPixel of the glyph bitmaps: 1, or 2 or 4 for anti-aliased
+	// fonts.
+	BitsPerPixel uint8
+
+	// Ascent and Descent are the distances from the baseline to the top and
+	// the bottom of a line, and LineHeight the distance between the
+	// baselines of two lines.
+	Ascent, Descent, LineHeight int16
+
+	// Glyphs sorted by rune.
+	Glyphs []Glyph
+
+	// Bitmap holds the pixels of all glyphs, packed from the most
+	// significant bit. A string lets TinyGo keep it in flash.
+	Bitmap string
+
+	// Fallback is used for the runes missing in this font.
+	Fallback *Font
+}
+
+// Glyph is the bitmap and the metrics of a rune.
+type Glyph struct {
+	Rune rune
+
+	// Width and Height of the bitmap.
+	Width, Height uint8
+
+	// XOffset and YOffset are the position of the top left corner of the
+	// bitmap from the pen position on the baseline.
+	XOffset, YOffset int8
+
+	// Advance moves the pen position to the next glyph.
+	Advance uint8
+
+	// Offset is the index of the first pixel of the bitmap in Font.Bitmap.
+	Offset uint32
+}
+
+// Lookup returns the glyph of r and the font it was found in, searching the
+// fallback fonts. If no font has the rune, the replacement character U+FFFD
+// or '?' is used instead. It returns nil if none of them is found either.
+func (f *Font) Lookup(r rune) (*Font, *Glyph) {
+	for _, r := range [...]rune{r, utf8.RuneError, '?'} {
+		for font := f; font != nil; font = font.Fallback {
+			if g := font.find(r); g != nil {
+				return font, g
+			}
+		}
+	}
+	return nil, nil
+}
+
+// find returns the glyph of r in f, or nil.
+func (f *Font) find(r rune) *Glyph {
+	lo, hi := 0, len(f.Glyphs)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if f.Glyphs[mid].Rune < r {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(f.Glyphs) && f.Glyphs[lo].Rune == r {
+		return &f.Glyphs[lo]
+	}
+	return nil
+}
+
+// alpha returns the coverage of the pixel i of the bitmap, from 0 to max.
+func (f *Font) alpha(i uint32) (a, max uint8) {
+	bits := uint32(f.BitsPerPixel)
+	max = 1<<bits - 1
+	pos := i * bits
+	shift := 8 - bits - pos%8
+	return f.Bitmap[pos/8] >> shift & max, max
+}
+
+// Draw draws text in the color c, with the baseline of the first line at y,
+// and returns the pen position after it. A newline starts a new line at x.
+// The pixels of anti-aliased glyphs are drawn if they are at least half
+// covered, see DrawBlended for smooth edges.
+func Draw(d drivers.Displayer, f *Font, x, y int16, text string, c color.RGBA) (int16, int16) {
+	return draw(d, f, x, y, text, c, c, false)
+}
+
+// DrawBlended draws text like Draw, but blends the edges of anti-aliased
+// glyphs with the background color bg.
+func DrawBlended(d drivers.Displayer, f *Font, x, y int16, text string, c, bg color.RGBA) (int16, int16) {
+	return draw(d, f, x, y, text, c, bg, true)
+}
+
+func draw(d drivers.Displayer, f *Font, x, y int16, text string, c, bg color.RGBA, blend bool) (int16, int16) {
+	x0 := x
+	for _, r := range text {
+		if r == '\n' {
+			x = x0
+			y += f.LineHeight
+			continue
+		}
+		font, g := f.Lookup(r)
+		if g == nil {
+			continue
+		}
+		i := g.Offset
+		for j := int16(0); j < int16(g.Height); j++ {
+			for k := int16(0); k < int16(g.Width); k++ {
+				a, max := font.alpha(i)
+				i++
+				if a == 0 {
+					continue
+				}
+				px, py := x+int16(g.XOffset)+k, y+int16(g.YOffset)+j
+				switch {
+				case a == max:
+					d.SetPixel(px, py, c)
+				case blend:
+					d.SetPixel(px, py, mix(bg, c, a, max))
+				case 2*a >= max:
+					d.SetPixel(px, py, c)
+				}
+			}
+		}
+		x += int16(g.Advance)
+	}
+	return x, y
+}
+
+// mix returns the color between bg (a = 0) and fg (a = max).
+func mix(bg, fg color.RGBA, a, max uint8) color.RGBA {
+	m := func(b, f uint8) uint8 {
+		return uint8((uint16(b)*uint16(max-a) + uint16(f)*uint16(a) + uint16(max)/2) / uint16(max))
+	}
+	return color.RGBA{m(bg.R, fg.R), m(bg.G, fg.G), m(bg.B, fg.B), 255}
+}
+
+// Measure returns the width of the longest line of text, and the number of
+// lines.
+func Measure(f *Font, text string) (width int16, lines int16) {
+	lines = 1
+	x := int16(0)
+	for _, r := range text {
+		if r == '\n' {
+			x = 0
+			lines++
+			continue
+		}
+		if _, g := f.Lookup(r); g != nil {
+			x += int16(g.Advance)
+		}
+		if x > width {
+			width = x
+		}
+	}
+	return width, lines
+}
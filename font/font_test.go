@@ -0,0 +1,94 @@
+package font
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/tester"
+)
+
+var (
+	white = color.RGBA{255, 255, 255, 255}
+	black = color.RGBA{0, 0, 0, 255}
+)
+
+// mono has a 2x2 square for 'a' and a vertical bar for 'b'.
+var mono = Font{
+	BitsPerPixel: 1,
+	LineHeight:   4,
+	Glyphs: []Glyph{
+		{Rune: 'a', Width: 2, Height: 2, YOffset: -2, Advance: 3, Offset: 0},
+		{Rune: 'b', Width: 1, Height: 3, XOffset: 1, YOffset: -3, Advance: 2, Offset: 4},
+	},
+	Bitmap: "\xfe",
+}
+
+// gray has a 2 bits per pixel '?' with the levels 3, 2, 1 and 0.
+var gray = Font{
+	BitsPerPixel: 2,
+	LineHeight:   2,
+	Glyphs: []Glyph{
+		{Rune: '?', Width: 4, Height: 1, YOffset: -1, Advance: 5},
+	},
+	Bitmap: "\xe4",
+}
+
+func TestLookup(t *testing.T) {
+	c := qt.New(t)
+	f, g := mono.Lookup('b')
+	c.Assert(f, qt.Equals, &mono)
+	c.Assert(g, qt.Equals, &mono.Glyphs[1])
+
+	_, g = mono.Lookup('c')
+	c.Assert(g, qt.IsNil)
+
+	// Missing runes are looked up in the fallback fonts, and replaced by
+	// '?' if none has them.
+	withFallback := mono
+	withFallback.Fallback = &gray
+	f, g = withFallback.Lookup('é')
+	c.Assert(f, qt.Equals, &gray)
+	c.Assert(g.Rune, qt.Equals, '?')
+}
+
+func TestDraw(t *testing.T) {
+	c := qt.New(t)
+	s := tester.NewScreen(16, 16)
+	x, y := Draw(s, &mono, 1, 5, "ab\na", black)
+	c.Assert(x, qt.Equals, int16(4))
+	c.Assert(y, qt.Equals, int16(9))
+	c.Assert(s.Pixels, qt.DeepEquals, map[[2]int16]color.RGBA{
+		// 'a'
+		{1, 3}: black, {2, 3}: black, {1, 4}: black, {2, 4}: black,
+		// 'b'
+		{5, 2}: black, {5, 3}: black, {5, 4}: black,
+		// 'a' on the second line
+		{1, 7}: black, {2, 7}: black, {1, 8}: black, {2, 8}: black,
+	})
+}
+
+func TestDrawAntiAliased(t *testing.T) {
+	c := qt.New(t)
+	s := tester.NewScreen(8, 8)
+	Draw(s, &gray, 0, 1, "?", black)
+	c.Assert(s.Pixels, qt.DeepEquals, map[[2]int16]color.RGBA{
+		{0, 0}: black, {1, 0}: black,
+	})
+
+	s = tester.NewScreen(8, 8)
+	DrawBlended(s, &gray, 0, 1, "?", black, white)
+	c.Assert(s.Pixels, qt.DeepEquals, map[[2]int16]color.RGBA{
+		{0, 0}: black,
+		{1, 0}: {85, 85, 85, 255},
+		{2, 0}: {170, 170, 170, 255},
+	})
+}
+
+func TestMeasure(t *testing.T) {
+	c := qt.New(t)
+	w, lines := Measure(&mono, "ab\naaa")
+	c.Assert(w, qt.Equals, int16(9))
+	c.Assert(lines, qt.Equals, int16(2))
+}
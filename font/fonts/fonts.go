@@ -0,0 +1,6 @@
+// Package fonts holds fonts for the font package, generated from the Go
+// fonts with cmd/fontconv.
+//
+// The Go fonts are copyright Bigelow & Holmes Inc. and distributed under the
+// license in the LICENSE file.
+package fonts // import "tinygo.org/x/drivers/font/fonts"
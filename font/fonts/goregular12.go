@@ -0,0 +1,252 @@
+// Code generated by fontconv -size 12 -runes 32-126,0xA0-0xFF -name GoRegular12 Go-Regular.ttf; DO NOT EDIT.
+
+package fonts
+
+import "tinygo.org/x/drivers/font"
+
+var GoRegular12 = font.Font{
+	Name:         "Go Regular",
+	BitsPerPixel: 1,
+	Ascent:       12,
+	Descent:      3,
+	LineHeight:   14,
+	Glyphs: []font.Glyph{
+		{Rune: 0x20, Width: 0, Height: 0, XOffset: 0, YOffset: 0, Advance: 3, Offset: 0},         // ' '
+		{Rune: 0x21, Width: 2, Height: 9, XOffset: 1, YOffset: -9, Advance: 3, Offset: 0},        // '!'
+		{Rune: 0x22, Width: 4, Height: 4, XOffset: 0, YOffset: -10, Advance: 4, Offset: 18},      // '"'
+		{Rune: 0x23, Width: 7, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 34},       // '#'
+		{Rune: 0x24, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 97},     // '$'
+		{Rune: 0x25, Width: 11, Height: 9, XOffset: 0, YOffset: -9, Advance: 11, Offset: 163},    // '%'
+		{Rune: 0x26, Width: 8, Height: 10, XOffset: 0, YOffset: -9, Advance: 8, Offset: 262},     // '&'
+		{Rune: 0x27, Width: 2, Height: 4, XOffset: 0, YOffset: -10, Advance: 2, Offset: 342},     // '\''
+		{Rune: 0x28, Width: 4, Height: 12, XOffset: 0, YOffset: -10, Advance: 4, Offset: 350},    // '('
+		{Rune: 0x29, Width: 4, Height: 12, XOffset: 0, YOffset: -10, Advance: 4, Offset: 398},    // ')'
+		{Rune: 0x2a, Width: 7, Height: 6, XOffset: 0, YOffset: -7, Advance: 7, Offset: 446},      // '*'
+		{Rune: 0x2b, Width: 7, Height: 7, XOffset: 0, YOffset: -7, Advance: 7, Offset: 488},      // '+'
+		{Rune: 0x2c, Width: 2, Height: 4, XOffset: 1, YOffset: -2, Advance: 4, Offset: 537},      // ','
+		{Rune: 0x2d, Width: 7, Height: 1, XOffset: 0, YOffset: -4, Advance: 7, Offset: 545},      // '-'
+		{Rune: 0x2e, Width: 2, Height: 2, XOffset: 1, YOffset: -2, Advance: 4, Offset: 552},      // '.'
+		{Rune: 0x2f, Width: 4, Height: 10, XOffset: 0, YOffset: -9, Advance: 3, Offset: 556},     // '/'
+		{Rune: 0x30, Width: 7, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 596},     // '0'
+		{Rune: 0x31, Width: 6, Height: 9, XOffset: 1, YOffset: -9, Advance: 7, Offset: 666},      // '1'
+		{Rune: 0x32, Width: 6, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 720},      // '2'
+		{Rune: 0x33, Width: 6, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 774},     // '3'
+		{Rune: 0x34, Width: 7, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 834},      // '4'
+		{Rune: 0x35, Width: 6, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 897},     // '5'
+		{Rune: 0x36, Width: 6, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 957},     // '6'
+		{Rune: 0x37, Width: 7, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 1017},     // '7'
+		{Rune: 0x38, Width: 7, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 1080},    // '8'
+		{Rune: 0x39, Width: 6, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 1150},    // '9'
+		{Rune: 0x3a, Width: 2, Height: 7, XOffset: 1, YOffset: -7, Advance: 4, Offset: 1210},     // ':'
+		{Rune: 0x3b, Width: 2, Height: 9, XOffset: 1, YOffset: -7, Advance: 4, Offset: 1224},     // ';'
+		{Rune: 0x3c, Width: 7, Height: 7, XOffset: 0, YOffset: -7, Advance: 7, Offset: 1242},     // '<'
+		{Rune: 0x3d, Width: 7, Height: 5, XOffset: 0, YOffset: -6, Advance: 7, Offset: 1291},     // '='
+		{Rune: 0x3e, Width: 7, Height: 7, XOffset: 0, YOffset: -7, Advance: 7, Offset: 1326},     // '>'
+		{Rune: 0x3f, Width: 5, Height: 9, XOffset: 1, YOffset: -9, Advance: 7, Offset: 1375},     // '?'
+		{Rune: 0x40, Width: 10, Height: 10, XOffset: 1, YOffset: -9, Advance: 12, Offset: 1420},  // '@'
+		{Rune: 0x41, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 1520},     // 'A'
+		{Rune: 0x42, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 1592},     // 'B'
+		{Rune: 0x43, Width: 8, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 1664},    // 'C'
+		{Rune: 0x44, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 1744},     // 'D'
+		{Rune: 0x45, Width: 7, Height: 9, XOffset: 1, YOffset: -9, Advance: 8, Offset: 1825},     // 'E'
+		{Rune: 0x46, Width: 7, Height: 9, XOffset: 1, YOffset: -9, Advance: 7, Offset: 1888},     // 'F'
+		{Rune: 0x47, Width: 9, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 1951},    // 'G'
+		{Rune: 0x48, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 2041},     // 'H'
+		{Rune: 0x49, Width: 5, Height: 9, XOffset: 0, YOffset: -9, Advance: 5, Offset: 2113},     // 'I'
+		{Rune: 0x4a, Width: 5, Height: 11, XOffset: 0, YOffset: -9, Advance: 6, Offset: 2158},    // 'J'
+		{Rune: 0x4b, Width: 7, Height: 9, XOffset: 1, YOffset: -9, Advance: 8, Offset: 2213},     // 'K'
+		{Rune: 0x4c, Width: 7, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 2276},     // 'L'
+		{Rune: 0x4d, Width: 10, Height: 9, XOffset: 0, YOffset: -9, Advance: 10, Offset: 2339},   // 'M'
+		{Rune: 0x4e, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 2429},     // 'N'
+		{Rune: 0x4f, Width: 9, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 2501},    // 'O'
+		{Rune: 0x50, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 2591},     // 'P'
+		{Rune: 0x51, Width: 10, Height: 11, XOffset: 0, YOffset: -9, Advance: 9, Offset: 2663},   // 'Q'
+		{Rune: 0x52, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 2773},     // 'R'
+		{Rune: 0x53, Width: 8, Height: 10, XOffset: 0, YOffset: -9, Advance: 8, Offset: 2854},    // 'S'
+		{Rune: 0x54, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 2934},     // 'T'
+		{Rune: 0x55, Width: 8, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 3006},    // 'U'
+		{Rune: 0x56, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 3086},     // 'V'
+		{Rune: 0x57, Width: 12, Height: 9, XOffset: 0, YOffset: -9, Advance: 11, Offset: 3158},   // 'W'
+		{Rune: 0x58, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 3266},     // 'X'
+		{Rune: 0x59, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 3338},     // 'Y'
+		{Rune: 0x5a, Width: 7, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 3410},     // 'Z'
+		{Rune: 0x5b, Width: 3, Height: 12, XOffset: 0, YOffset: -10, Advance: 3, Offset: 3473},   // '['
+		{Rune: 0x5c, Width: 4, Height: 10, XOffset: 0, YOffset: -9, Advance: 3, Offset: 3509},    // '\\'
+		{Rune: 0x5d, Width: 3, Height: 12, XOffset: 0, YOffset: -10, Advance: 3, Offset: 3549},   // ']'
+		{Rune: 0x5e, Width: 6, Height: 5, XOffset: 0, YOffset: -9, Advance: 6, Offset: 3585},     // '^'
+		{Rune: 0x5f, Width: 7, Height: 1, XOffset: 0, YOffset: 0, Advance: 7, Offset: 3615},      // '_'
+		{Rune: 0x60, Width: 4, Height: 3, XOffset: 0, YOffset: -10, Advance: 4, Offset: 3622},    // '`'
+		{Rune: 0x61, Width: 7, Height: 8, XOffset: 0, YOffset: -7, Advance: 7, Offset: 3634},     // 'a'
+		{Rune: 0x62, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 3690},   // 'b'
+		{Rune: 0x63, Width: 6, Height: 8, XOffset: 0, YOffset: -7, Advance: 6, Offset: 3767},     // 'c'
+		{Rune: 0x64, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 3815},   // 'd'
+		{Rune: 0x65, Width: 6, Height: 8, XOffset: 0, YOffset: -7, Advance: 7, Offset: 3881},     // 'e'
+		{Rune: 0x66, Width: 4, Height: 10, XOffset: 0, YOffset: -10, Advance: 3, Offset: 3929},   // 'f'
+		{Rune: 0x67, Width: 6, Height: 10, XOffset: 0, YOffset: -7, Advance: 7, Offset: 3969},    // 'g'
+		{Rune: 0x68, Width: 6, Height: 10, XOffset: 0, YOffset: -10, Advance: 7, Offset: 4029},   // 'h'
+		{Rune: 0x69, Width: 3, Height: 9, XOffset: 0, YOffset: -9, Advance: 3, Offset: 4089},     // 'i'
+		{Rune: 0x6a, Width: 4, Height: 12, XOffset: -1, YOffset: -9, Advance: 3, Offset: 4116},   // 'j'
+		{Rune: 0x6b, Width: 6, Height: 10, XOffset: 0, YOffset: -10, Advance: 6, Offset: 4164},   // 'k'
+		{Rune: 0x6c, Width: 4, Height: 11, XOffset: 0, YOffset: -10, Advance: 3, Offset: 4224},   // 'l'
+		{Rune: 0x6d, Width: 10, Height: 7, XOffset: 0, YOffset: -7, Advance: 10, Offset: 4268},   // 'm'
+		{Rune: 0x6e, Width: 6, Height: 7, XOffset: 0, YOffset: -7, Advance: 7, Offset: 4338},     // 'n'
+		{Rune: 0x6f, Width: 7, Height: 8, XOffset: 0, YOffset: -7, Advance: 7, Offset: 4380},     // 'o'
+		{Rune: 0x70, Width: 7, Height: 10, XOffset: 0, YOffset: -7, Advance: 7, Offset: 4436},    // 'p'
+		{Rune: 0x71, Width: 6, Height: 10, XOffset: 0, YOffset: -7, Advance: 7, Offset: 4506},    // 'q'
+		{Rune: 0x72, Width: 4, Height: 7, XOffset: 0, YOffset: -7, Advance: 4, Offset: 4566},     // 'r'
+		{Rune: 0x73, Width: 6, Height: 8, XOffset: 0, YOffset: -7, Advance: 6, Offset: 4594},     // 's'
+		{Rune: 0x74, Width: 4, Height: 9, XOffset: 0, YOffset: -8, Advance: 3, Offset: 4642},     // 't'
+		{Rune: 0x75, Width: 6, Height: 8, XOffset: 0, YOffset: -7, Advance: 7, Offset: 4678},     // 'u'
+		{Rune: 0x76, Width: 6, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 4726},     // 'v'
+		{Rune: 0x77, Width: 9, Height: 7, XOffset: 0, YOffset: -7, Advance: 9, Offset: 4768},     // 'w'
+		{Rune: 0x78, Width: 6, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 4831},     // 'x'
+		{Rune: 0x79, Width: 6, Height: 10, XOffset: 0, YOffset: -7, Advance: 6, Offset: 4873},    // 'y'
+		{Rune: 0x7a, Width: 6, Height: 7, XOffset: 0, YOffset: -7, Advance: 6, Offset: 4933},     // 'z'
+		{Rune: 0x7b, Width: 4, Height: 12, XOffset: 0, YOffset: -10, Advance: 4, Offset: 4975},   // '{'
+		{Rune: 0x7c, Width: 2, Height: 12, XOffset: 1, YOffset: -10, Advance: 3, Offset: 5023},   // '|'
+		{Rune: 0x7d, Width: 4, Height: 12, XOffset: 0, YOffset: -10, Advance: 4, Offset: 5047},   // '}'
+		{Rune: 0x7e, Width: 7, Height: 3, XOffset: 0, YOffset: -5, Advance: 7, Offset: 5095},     // '~'
+		{Rune: 0xa0, Width: 0, Height: 0, XOffset: 0, YOffset: 0, Advance: 3, Offset: 5116},      // '\u00a0'
+		{Rune: 0xa1, Width: 2, Height: 10, XOffset: 1, YOffset: -7, Advance: 4, Offset: 5116},    // '¡'
+		{Rune: 0xa2, Width: 5, Height: 9, XOffset: 1, YOffset: -9, Advance: 7, Offset: 5136},     // '¢'
+		{Rune: 0xa3, Width: 6, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 5181},     // '£'
+		{Rune: 0xa4, Width: 6, Height: 6, XOffset: 0, YOffset: -7, Advance: 7, Offset: 5235},     // '¤'
+		{Rune: 0xa5, Width: 7, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 5271},     // '¥'
+		{Rune: 0xa6, Width: 1, Height: 12, XOffset: 1, YOffset: -10, Advance: 3, Offset: 5334},   // '¦'
+		{Rune: 0xa7, Width: 6, Height: 11, XOffset: 0, YOffset: -9, Advance: 7, Offset: 5346},    // '§'
+		{Rune: 0xa8, Width: 4, Height: 2, XOffset: 0, YOffset: -9, Advance: 4, Offset: 5412},     // '¨'
+		{Rune: 0xa9, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 5420},     // '©'
+		{Rune: 0xaa, Width: 5, Height: 5, XOffset: 0, YOffset: -9, Advance: 4, Offset: 5501},     // 'ª'
+		{Rune: 0xab, Width: 6, Height: 6, XOffset: 0, YOffset: -6, Advance: 7, Offset: 5526},     // '«'
+		{Rune: 0xac, Width: 7, Height: 5, XOffset: 0, YOffset: -6, Advance: 7, Offset: 5562},     // '¬'
+		{Rune: 0xad, Width: 4, Height: 1, XOffset: 0, YOffset: -4, Advance: 4, Offset: 5597},     // '\u00ad'
+		{Rune: 0xae, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 5601},     // '®'
+		{Rune: 0xaf, Width: 7, Height: 2, XOffset: 0, YOffset: -10, Advance: 7, Offset: 5682},    // '¯'
+		{Rune: 0xb0, Width: 5, Height: 4, XOffset: 0, YOffset: -9, Advance: 5, Offset: 5696},     // '°'
+		{Rune: 0xb1, Width: 7, Height: 7, XOffset: 0, YOffset: -7, Advance: 7, Offset: 5716},     // '±'
+		{Rune: 0xb2, Width: 5, Height: 6, XOffset: 0, YOffset: -10, Advance: 5, Offset: 5765},    // '²'
+		{Rune: 0xb3, Width: 5, Height: 7, XOffset: 0, YOffset: -10, Advance: 5, Offset: 5795},    // '³'
+		{Rune: 0xb4, Width: 4, Height: 3, XOffset: 0, YOffset: -10, Advance: 4, Offset: 5830},    // '´'
+		{Rune: 0xb5, Width: 6, Height: 10, XOffset: 0, YOffset: -7, Advance: 7, Offset: 5842},    // 'µ'
+		{Rune: 0xb6, Width: 6, Height: 11, XOffset: 0, YOffset: -9, Advance: 6, Offset: 5902},    // '¶'
+		{Rune: 0xb7, Width: 3, Height: 3, XOffset: 0, YOffset: -7, Advance: 3, Offset: 5968},     // '·'
+		{Rune: 0xb8, Width: 3, Height: 3, XOffset: 0, YOffset: 0, Advance: 4, Offset: 5977},      // '¸'
+		{Rune: 0xb9, Width: 5, Height: 6, XOffset: 0, YOffset: -10, Advance: 5, Offset: 5986},    // '¹'
+		{Rune: 0xba, Width: 4, Height: 5, XOffset: 0, YOffset: -9, Advance: 4, Offset: 6016},     // 'º'
+		{Rune: 0xbb, Width: 6, Height: 6, XOffset: 0, YOffset: -6, Advance: 7, Offset: 6036},     // '»'
+		{Rune: 0xbc, Width: 10, Height: 10, XOffset: 0, YOffset: -9, Advance: 10, Offset: 6072},  // '¼'
+		{Rune: 0xbd, Width: 10, Height: 10, XOffset: 0, YOffset: -9, Advance: 10, Offset: 6172},  // '½'
+		{Rune: 0xbe, Width: 10, Height: 10, XOffset: 0, YOffset: -9, Advance: 10, Offset: 6272},  // '¾'
+		{Rune: 0xbf, Width: 6, Height: 10, XOffset: 1, YOffset: -7, Advance: 7, Offset: 6372},    // '¿'
+		{Rune: 0xc0, Width: 8, Height: 12, XOffset: 0, YOffset: -12, Advance: 8, Offset: 6432},   // 'À'
+		{Rune: 0xc1, Width: 8, Height: 12, XOffset: 0, YOffset: -12, Advance: 8, Offset: 6528},   // 'Á'
+		{Rune: 0xc2, Width: 8, Height: 12, XOffset: 0, YOffset: -12, Advance: 8, Offset: 6624},   // 'Â'
+		{Rune: 0xc3, Width: 8, Height: 11, XOffset: 0, YOffset: -11, Advance: 8, Offset: 6720},   // 'Ã'
+		{Rune: 0xc4, Width: 8, Height: 11, XOffset: 0, YOffset: -11, Advance: 8, Offset: 6808},   // 'Ä'
+		{Rune: 0xc5, Width: 8, Height: 12, XOffset: 0, YOffset: -12, Advance: 8, Offset: 6896},   // 'Å'
+		{Rune: 0xc6, Width: 12, Height: 9, XOffset: 0, YOffset: -9, Advance: 12, Offset: 6992},   // 'Æ'
+		{Rune: 0xc7, Width: 8, Height: 12, XOffset: 0, YOffset: -9, Advance: 9, Offset: 7100},    // 'Ç'
+		{Rune: 0xc8, Width: 7, Height: 12, XOffset: 1, YOffset: -12, Advance: 8, Offset: 7196},   // 'È'
+		{Rune: 0xc9, Width: 7, Height: 12, XOffset: 1, YOffset: -12, Advance: 8, Offset: 7280},   // 'É'
+		{Rune: 0xca, Width: 7, Height: 12, XOffset: 1, YOffset: -12, Advance: 8, Offset: 7364},   // 'Ê'
+		{Rune: 0xcb, Width: 7, Height: 11, XOffset: 1, YOffset: -11, Advance: 8, Offset: 7448},   // 'Ë'
+		{Rune: 0xcc, Width: 5, Height: 12, XOffset: 0, YOffset: -12, Advance: 5, Offset: 7525},   // 'Ì'
+		{Rune: 0xcd, Width: 5, Height: 12, XOffset: 0, YOffset: -12, Advance: 5, Offset: 7585},   // 'Í'
+		{Rune: 0xce, Width: 5, Height: 12, XOffset: 0, YOffset: -12, Advance: 5, Offset: 7645},   // 'Î'
+		{Rune: 0xcf, Width: 5, Height: 11, XOffset: 0, YOffset: -11, Advance: 5, Offset: 7705},   // 'Ï'
+		{Rune: 0xd0, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 7760},     // 'Ð'
+		{Rune: 0xd1, Width: 8, Height: 11, XOffset: 0, YOffset: -11, Advance: 9, Offset: 7841},   // 'Ñ'
+		{Rune: 0xd2, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 7929},   // 'Ò'
+		{Rune: 0xd3, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 8046},   // 'Ó'
+		{Rune: 0xd4, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 8163},   // 'Ô'
+		{Rune: 0xd5, Width: 9, Height: 12, XOffset: 0, YOffset: -11, Advance: 9, Offset: 8280},   // 'Õ'
+		{Rune: 0xd6, Width: 9, Height: 12, XOffset: 0, YOffset: -11, Advance: 9, Offset: 8388},   // 'Ö'
+		{Rune: 0xd7, Width: 7, Height: 7, XOffset: 0, YOffset: -7, Advance: 7, Offset: 8496},     // '×'
+		{Rune: 0xd8, Width: 9, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 8545},    // 'Ø'
+		{Rune: 0xd9, Width: 8, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 8635},   // 'Ù'
+		{Rune: 0xda, Width: 8, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 8739},   // 'Ú'
+		{Rune: 0xdb, Width: 8, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 8843},   // 'Û'
+		{Rune: 0xdc, Width: 8, Height: 12, XOffset: 0, YOffset: -11, Advance: 9, Offset: 8947},   // 'Ü'
+		{Rune: 0xdd, Width: 8, Height: 12, XOffset: 0, YOffset: -12, Advance: 8, Offset: 9043},   // 'Ý'
+		{Rune: 0xde, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 9139},     // 'Þ'
+		{Rune: 0xdf, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 9211},   // 'ß'
+		{Rune: 0xe0, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 9288},   // 'à'
+		{Rune: 0xe1, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 9365},   // 'á'
+		{Rune: 0xe2, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 9442},   // 'â'
+		{Rune: 0xe3, Width: 7, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 9519},    // 'ã'
+		{Rune: 0xe4, Width: 7, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 9589},    // 'ä'
+		{Rune: 0xe5, Width: 7, Height: 12, XOffset: 0, YOffset: -11, Advance: 7, Offset: 9659},   // 'å'
+		{Rune: 0xe6, Width: 10, Height: 8, XOffset: 0, YOffset: -7, Advance: 11, Offset: 9743},   // 'æ'
+		{Rune: 0xe7, Width: 6, Height: 10, XOffset: 0, YOffset: -7, Advance: 6, Offset: 9823},    // 'ç'
+		{Rune: 0xe8, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 9883},   // 'è'
+		{Rune: 0xe9, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 9949},   // 'é'
+		{Rune: 0xea, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 10015},  // 'ê'
+		{Rune: 0xeb, Width: 6, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 10081},   // 'ë'
+		{Rune: 0xec, Width: 4, Height: 10, XOffset: -1, YOffset: -10, Advance: 3, Offset: 10141}, // 'ì'
+		{Rune: 0xed, Width: 4, Height: 10, XOffset: 0, YOffset: -10, Advance: 3, Offset: 10181},  // 'í'
+		{Rune: 0xee, Width: 5, Height: 10, XOffset: -1, YOffset: -10, Advance: 3, Offset: 10221}, // 'î'
+		{Rune: 0xef, Width: 5, Height: 9, XOffset: -1, YOffset: -9, Advance: 3, Offset: 10271},   // 'ï'
+		{Rune: 0xf0, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 10316},  // 'ð'
+		{Rune: 0xf1, Width: 6, Height: 9, XOffset: 0, YOffset: -9, Advance: 7, Offset: 10393},    // 'ñ'
+		{Rune: 0xf2, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 10447},  // 'ò'
+		{Rune: 0xf3, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 10524},  // 'ó'
+		{Rune: 0xf4, Width: 7, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 10601},  // 'ô'
+		{Rune: 0xf5, Width: 7, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 10678},   // 'õ'
+		{Rune: 0xf6, Width: 7, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 10748},   // 'ö'
+		{Rune: 0xf7, Width: 7, Height: 7, XOffset: 0, YOffset: -7, Advance: 7, Offset: 10818},    // '÷'
+		{Rune: 0xf8, Width: 7, Height: 8, XOffset: 0, YOffset: -7, Advance: 7, Offset: 10867},    // 'ø'
+		{Rune: 0xf9, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 10923},  // 'ù'
+		{Rune: 0xfa, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 10989},  // 'ú'
+		{Rune: 0xfb, Width: 6, Height: 11, XOffset: 0, YOffset: -10, Advance: 7, Offset: 11055},  // 'û'
+		{Rune: 0xfc, Width: 6, Height: 10, XOffset: 0, YOffset: -9, Advance: 7, Offset: 11121},   // 'ü'
+		{Rune: 0xfd, Width: 6, Height: 13, XOffset: 0, YOffset: -10, Advance: 6, Offset: 11181},  // 'ý'
+		{Rune: 0xfe, Width: 7, Height: 13, XOffset: 0, YOffset: -10, Advance: 7, Offset: 11259},  // 'þ'
+		{Rune: 0xff, Width: 6, Height: 12, XOffset: 0, YOffset: -9, Advance: 6, Offset: 11350},   // 'ÿ'
+	},
+	Bitmap: "" +
+		"\xaa\xa0\x81\x54\x01\x02\x20\xf8\xa3\x45\x8a\x14\x00\x73\x8a\x38\x60\xc2\x8e\xf0\x04\x11\x44\x24\x05\x20\x48\x02\xd0\x92\x12\x44" +
+		"\x30\x60\xb0\xb0\xe1\xc1\x6b\x29\x19\xf8\x00\xd4\x00\x91\x11\x11\x08\x80\x01\x08\x88\x88\x91\x00\x40\x86\xc0\x0a\x00\x00\x20\x43" +
+		"\xe1\x02\x00\x1b\x3e\x30\x22\x24\x44\x48\x83\x89\x91\x26\xd4\xc9\x12\x63\x80\x08\x20\x82\x08\x20\x82\x3e\x70\x20\x82\x18\xc2\x10" +
+		"\x79\xc0\x82\x08\xc0\x83\x09\xe0\x02\x0c\x38\x51\x26\x47\xc1\x02\x3c\x82\x08\x3c\x10\x61\x3c\x00\xcc\x20\x83\x68\xa2\x89\xc0\x3e" +
+		"\x04\x08\x20\x81\x04\x08\x10\x38\x89\x11\x43\x89\x91\x22\x78\x00\xc4\x91\x45\x33\x41\x09\xc0\x0c\x03\x30\x0d\x80\x02\x18\xc0\x40" +
+		"\x20\x00\x3f\x80\xfe\x00\x02\x03\x01\x84\x20\x01\xe1\x84\x44\x60\x00\x80\xf0\x42\x06\x50\x91\x24\x58\x1a\x90\x03\x40\x00\x08\x18" +
+		"\x18\x2c\x24\x66\x7e\x42\x83\x78\x46\x42\x44\x78\x46\x42\x42\x7c\x1f\x20\x60\x40\x40\x40\x40\x60\x3f\x00\x78\x23\x10\xc8\x24\x12" +
+		"\x09\x0c\x84\x7c\x7e\xc1\x02\x07\xe8\x10\x20\x7f\xfd\x82\x04\x0f\x90\x20\x40\x80\x3e\x20\x20\x10\x08\x04\x32\x09\x84\x7e\x00\x20" +
+		"\x21\xa1\xa1\xbf\xa1\xa1\xa1\xa1\xb8\x84\x21\x08\x42\x38\xe3\x08\x42\x10\x84\x72\x64\x28\x92\x28\x70\xb1\x22\x24\x24\x08\x10\x20" +
+		"\x40\x81\x02\x07\xcc\x13\x0c\xc3\x29\x4a\x52\xd4\x99\x26\x48\x12\x03\x0b\x8a\x8a\xca\x6a\x3a\x3a\x18\xe0\x8c\x82\x41\xa0\xd0\x68" +
+		"\x26\x31\xf0\x00\xf8\x8c\x84\x84\x8c\xf0\x80\x80\x80\x38\x11\x88\x22\x0c\x83\x20\xc8\x23\x18\x7c\x01\xc0\x13\xe1\x18\x84\x42\x3e" +
+		"\x13\x08\xc4\x22\x08\xf1\x01\x01\x80\xf0\x18\x08\x09\xf0\x03\xf8\x40\x40\x40\x40\x40\x40\x40\x41\x01\x05\x05\x05\x05\x05\x05\x88" +
+		"\xf8\x00\x05\x0d\x09\x88\x90\x90\x50\x60\x62\x10\xb1\x89\x38\x92\x91\x2d\x1a\x50\xc6\x0c\x60\xc6\x10\x18\x89\x06\x06\x06\x09\x11" +
+		"\x90\x90\x10\x89\x0d\x06\x06\x06\x06\x06\x1f\x03\x0c\x10\x41\x82\x08\x1f\x86\x92\x49\x24\x98\x44\x22\x20\x11\x10\x64\x92\x49\x25" +
+		"\x80\x61\x89\x25\xfc\x08\x00\x34\x08\x79\x32\x67\x40\x00\x20\x40\x81\xf2\x24\x48\x91\x3c\x00\x03\x48\x20\x82\x07\x00\x00\x20\x86" +
+		"\xda\x28\xa2\x9b\xe0\x00\xd2\x2f\xa0\x81\xe0\x03\x22\x32\x22\x22\x00\xda\x28\xa2\x99\xa1\xac\x00\x08\x20\x83\xe8\xa2\x8a\x28\xa0" +
+		"\x12\x49\x22\x00\x22\x22\x22\x26\x00\x10\x41\x04\x94\x61\x45\x92\x04\x44\x44\x44\x46\x00\x01\xfe\x4c\x93\x24\xc9\x32\x4c\x80\x7d" +
+		"\x14\x51\x45\x10\x0d\x11\x22\x44\x88\xe0\x00\x0f\x91\x22\x44\x89\xe2\x04\x00\x00\x6d\x14\x51\x4d\xf0\x41\x00\x1d\x11\x11\x00\x61" +
+		"\x07\x06\x09\xe0\x11\x19\x11\x11\x80\x04\x51\x45\x14\xdd\x00\x0c\x52\x49\xc3\x0c\x00\x44\x96\x4a\xa5\x73\x31\x98\x02\x4f\x18\x62" +
+		"\xd9\x01\x8a\x49\x38\x61\x84\x10\x00\x0f\x0c\x21\x08\x7c\x04\x88\x89\x88\x88\x84\x55\x55\x54\x08\x44\x44\x64\x44\x48\x01\x50\x00" +
+		"\x03\xfc\x01\x9f\x08\x41\x0f\x00\xc4\x10\x43\x84\x10\x83\xe8\xb4\x82\x4f\x80\x09\x13\x43\x06\x0c\x3c\x10\x21\xe7\xce\x41\x07\x16" +
+		"\x45\x91\x81\x05\xe0\x00\x81\x10\x35\x41\xa0\xd0\x47\x08\x21\xc3\x04\xa7\x00\x02\x94\x48\xa0\x00\x3e\x04\x08\x03\x04\x08\x83\x29" +
+		"\x4c\xc6\x42\x28\x41\x0e\x00\x00\x22\x94\x01\x02\x1f\x08\x10\x01\xf0\x04\x22\x21\xc0\x10\x8c\x27\x00\x10\x00\x45\x14\x51\x4d\xd4" +
+		"\x10\x00\xe7\x1c\x70\xc1\x04\x10\x41\x00\x08\x22\x00\x42\x10\x8e\x61\x96\x04\x1a\x24\xa5\x80\x61\x08\x02\x20\x90\x29\x82\xa1\x28" +
+		"\x8e\x00\x80\x06\x10\x88\x20\x09\x02\x88\x42\x10\x08\x04\x38\x00\x60\x04\x42\x20\x40\x15\x92\x20\x28\x4e\x20\x80\x00\x04\x00\x43" +
+		"\x08\xc2\x0c\x80\x00\x10\x00\x08\x18\x18\x2c\x24\x66\x7e\x42\x83\x00\x08\x00\x08\x18\x18\x2c\x24\x66\x7e\x42\x83\x00\x18\x00\x08" +
+		"\x18\x18\x2c\x24\x66\x7e\x42\x83\x34\x00\x08\x18\x18\x2c\x24\x66\x7e\x42\x83\x00\x00\x08\x18\x18\x2c\x24\x66\x7e\x42\x83\x00\x10" +
+		"\x10\x18\x18\x18\x2c\x24\x66\x7e\x42\x83\x03\xe0\x60\x0e\x00\xa0\x13\xe3\x20\x3e\x04\x20\xc3\xf1\xf2\x06\x04\x04\x04\x04\x06\x03" +
+		"\xf0\x80\x40\x00\x04\x00\x7e\xc1\x02\x07\xe8\x10\x20\x7f\x00\x20\x07\xec\x10\x20\x7e\x81\x02\x07\xf0\x07\x00\x7e\xc1\x02\x07\xe8" +
+		"\x10\x20\x7f\x08\x03\xf6\x08\x10\x3f\x40\x81\x03\xf8\x10\x07\x10\x84\x21\x08\x47\x00\x80\x71\x08\x42\x10\x84\x70\x1c\x07\x10\x84" +
+		"\x21\x08\x47\x08\x0e\x21\x08\x42\x10\x8e\x78\x23\x10\xc8\x2f\x12\x09\x0c\x84\x7c\x0e\x00\x20\x30\xb8\xa8\xac\xa6\xa3\xa3\xa1\x80" +
+		"\x02\x00\x01\xc1\x19\x04\x83\x41\xa0\xd0\x4c\x63\xe0\x00\x00\x08\x00\x0e\x08\xc8\x24\x1a\x0d\x06\x82\x63\x1f\x00\x00\x01\xc0\x00" +
+		"\x70\x46\x41\x20\xd0\x68\x34\x13\x18\xf8\x00\x18\x00\x07\x04\x64\x12\x0d\x06\x83\x41\x31\x8f\x80\x01\x00\x00\x70\x46\x41\x20\xd0" +
+		"\x68\x34\x13\x18\xf8\x00\x00\x88\xa0\x82\x88\x80\x0e\x88\xc8\x64\x5a\x4d\x26\xa2\x61\x3f\x00\x00\x02\x00\x08\x08\x28\x28\x28\x28" +
+		"\x28\x2c\x47\xc0\x00\x01\x00\x08\x08\x28\x28\x28\x28\x28\x2c\x47\xc0\x00\x03\x80\x08\x08\x28\x28\x28\x28\x28\x2c\x47\xc0\x00\x80" +
+		"\x08\x08\x28\x28\x28\x28\x28\x2c\x47\xc0\x00\x01\x00\x08\x08\x44\x86\x83\x03\x03\x03\x03\x08\x08\x0f\xc8\x68\x68\x4f\xc8\x08\x00" +
+		"\x1e\x24\x48\xa1\x62\x64\x68\x57\x00\x00\x60\x00\x06\x81\x0f\x26\x4c\xe8\x00\x01\x00\x00\x34\x08\x79\x32\x67\x40\x00\x1c\x00\x01" +
+		"\xa0\x43\xc9\x93\x3a\x00\x70\x00\x06\x81\x0f\x26\x4c\xe8\x00\x40\x00\x1a\x04\x3c\x99\x33\xa0\x00\x00\x10\x00\x01\xa0\x43\xc9\x93" +
+		"\x3a\x00\x00\x37\x41\x89\xfe\x98\x26\x0e\x78\x00\x03\x48\x20\x82\x07\x08\x00\x00\x10\x00\x0d\x22\xfa\x08\x1e\x00\x02\x00\x03\x48" +
+		"\xbe\x82\x07\x80\x01\xc0\x00\xd2\x2f\xa0\x81\xe0\x04\x00\x0d\x22\xfa\x08\x1e\x00\x10\x01\x11\x11\x10\x20\x02\x22\x22\x20\x1c\x00" +
+		"\x10\x84\x21\x08\x20\x00\x84\x21\x08\x40\x0c\x06\x04\x7c\x89\x12\x24\x47\x00\x1c\x00\x0f\xa2\x8a\x28\xa2\x00\x80\x00\x0d\x11\x22" +
+		"\x44\x88\xe0\x00\x02\x00\x00\x68\x89\x12\x24\x47\x00\x00\x38\x00\x03\x44\x48\x91\x22\x38\x00\xe0\x00\x0d\x11\x22\x44\x88\xe0\x00" +
+		"\x80\x00\x34\x44\x89\x12\x23\x80\x04\x08\x00\xf8\x00\x01\x00\x0b\x26\x54\xa9\x93\xc0\x00\x18\x00\x08\xa2\x8a\x29\xba\x00\x02\x00" +
+		"\x02\x28\xa2\x8a\x6e\x80\x01\xc0\x00\x8a\x28\xa2\x9b\xa0\x04\x00\x08\xa2\x8a\x29\xba\x00\x02\x00\x06\x29\x24\xe1\x86\x10\x40\x00" +
+		"\x10\x20\x40\xf9\x12\x24\x48\x9e\x20\x40\x00\x00\x00\xc5\x24\x9c\x30\xc2\x08\x00",
+}
@@ -0,0 +1,513 @@
+// Code generated by fontconv -size 16 -bpp 4 -runes 32-126,0xA0-0xFF,0xFFFD -name GoRegular16AA Go-Regular.ttf; DO NOT EDIT.
+
+package fonts
+
+import "tinygo.org/x/drivers/font"
+
+var GoRegular16AA = font.Font{
+	Name:         "Go Regular",
+	BitsPerPixel: 4,
+	Ascent:       16,
+	Descent:      4,
+	LineHeight:   19,
+	Glyphs: []font.Glyph{
+		{Rune: 0x20, Width: 0, Height: 0, XOffset: 0, YOffset: 0, Advance: 4, Offset: 0},            // ' '
+		{Rune: 0x21, Width: 3, Height: 12, XOffset: 1, YOffset: -12, Advance: 4, Offset: 0},         // '!'
+		{Rune: 0x22, Width: 5, Height: 5, XOffset: 0, YOffset: -13, Advance: 6, Offset: 36},         // '"'
+		{Rune: 0x23, Width: 9, Height: 12, XOffset: 0, YOffset: -12, Advance: 9, Offset: 61},        // '#'
+		{Rune: 0x24, Width: 8, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 169},       // '$'
+		{Rune: 0x25, Width: 14, Height: 12, XOffset: 0, YOffset: -12, Advance: 14, Offset: 281},     // '%'
+		{Rune: 0x26, Width: 11, Height: 13, XOffset: 0, YOffset: -12, Advance: 11, Offset: 449},     // '&'
+		{Rune: 0x27, Width: 3, Height: 5, XOffset: 0, YOffset: -13, Advance: 3, Offset: 592},        // '\''
+		{Rune: 0x28, Width: 4, Height: 16, XOffset: 1, YOffset: -13, Advance: 5, Offset: 607},       // '('
+		{Rune: 0x29, Width: 5, Height: 16, XOffset: 0, YOffset: -13, Advance: 5, Offset: 671},       // ')'
+		{Rune: 0x2a, Width: 8, Height: 7, XOffset: 1, YOffset: -9, Advance: 9, Offset: 751},         // '*'
+		{Rune: 0x2b, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 807},         // '+'
+		{Rune: 0x2c, Width: 3, Height: 5, XOffset: 1, YOffset: -2, Advance: 5, Offset: 888},         // ','
+		{Rune: 0x2d, Width: 9, Height: 2, XOffset: 0, YOffset: -6, Advance: 9, Offset: 903},         // '-'
+		{Rune: 0x2e, Width: 3, Height: 3, XOffset: 1, YOffset: -3, Advance: 5, Offset: 921},         // '.'
+		{Rune: 0x2f, Width: 5, Height: 14, XOffset: 0, YOffset: -12, Advance: 4, Offset: 930},       // '/'
+		{Rune: 0x30, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 1000},      // '0'
+		{Rune: 0x31, Width: 8, Height: 12, XOffset: 1, YOffset: -12, Advance: 9, Offset: 1117},      // '1'
+		{Rune: 0x32, Width: 8, Height: 12, XOffset: 0, YOffset: -12, Advance: 9, Offset: 1213},      // '2'
+		{Rune: 0x33, Width: 7, Height: 13, XOffset: 1, YOffset: -12, Advance: 9, Offset: 1309},      // '3'
+		{Rune: 0x34, Width: 9, Height: 12, XOffset: 0, YOffset: -12, Advance: 9, Offset: 1400},      // '4'
+		{Rune: 0x35, Width: 7, Height: 13, XOffset: 1, YOffset: -12, Advance: 9, Offset: 1508},      // '5'
+		{Rune: 0x36, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 1599},      // '6'
+		{Rune: 0x37, Width: 8, Height: 12, XOffset: 1, YOffset: -12, Advance: 9, Offset: 1716},      // '7'
+		{Rune: 0x38, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 1812},      // '8'
+		{Rune: 0x39, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 1929},      // '9'
+		{Rune: 0x3a, Width: 3, Height: 9, XOffset: 1, YOffset: -9, Advance: 5, Offset: 2046},        // ':'
+		{Rune: 0x3b, Width: 3, Height: 12, XOffset: 1, YOffset: -9, Advance: 5, Offset: 2073},       // ';'
+		{Rune: 0x3c, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 2109},        // '<'
+		{Rune: 0x3d, Width: 10, Height: 5, XOffset: 0, YOffset: -7, Advance: 9, Offset: 2190},       // '='
+		{Rune: 0x3e, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 2240},        // '>'
+		{Rune: 0x3f, Width: 7, Height: 12, XOffset: 1, YOffset: -12, Advance: 9, Offset: 2321},      // '?'
+		{Rune: 0x40, Width: 13, Height: 13, XOffset: 2, YOffset: -12, Advance: 16, Offset: 2405},    // '@'
+		{Rune: 0x41, Width: 11, Height: 12, XOffset: 0, YOffset: -12, Advance: 11, Offset: 2574},    // 'A'
+		{Rune: 0x42, Width: 9, Height: 12, XOffset: 1, YOffset: -12, Advance: 11, Offset: 2706},     // 'B'
+		{Rune: 0x43, Width: 11, Height: 13, XOffset: 0, YOffset: -12, Advance: 12, Offset: 2814},    // 'C'
+		{Rune: 0x44, Width: 10, Height: 12, XOffset: 1, YOffset: -12, Advance: 12, Offset: 2957},    // 'D'
+		{Rune: 0x45, Width: 10, Height: 12, XOffset: 1, YOffset: -12, Advance: 11, Offset: 3077},    // 'E'
+		{Rune: 0x46, Width: 9, Height: 12, XOffset: 1, YOffset: -12, Advance: 10, Offset: 3197},     // 'F'
+		{Rune: 0x47, Width: 11, Height: 13, XOffset: 0, YOffset: -12, Advance: 12, Offset: 3305},    // 'G'
+		{Rune: 0x48, Width: 10, Height: 12, XOffset: 1, YOffset: -12, Advance: 12, Offset: 3448},    // 'H'
+		{Rune: 0x49, Width: 6, Height: 12, XOffset: 0, YOffset: -12, Advance: 6, Offset: 3568},      // 'I'
+		{Rune: 0x4a, Width: 7, Height: 15, XOffset: 0, YOffset: -12, Advance: 8, Offset: 3640},      // 'J'
+		{Rune: 0x4b, Width: 10, Height: 12, XOffset: 1, YOffset: -12, Advance: 11, Offset: 3745},    // 'K'
+		{Rune: 0x4c, Width: 8, Height: 12, XOffset: 1, YOffset: -12, Advance: 9, Offset: 3865},      // 'L'
+		{Rune: 0x4d, Width: 12, Height: 12, XOffset: 1, YOffset: -12, Advance: 13, Offset: 3961},    // 'M'
+		{Rune: 0x4e, Width: 10, Height: 12, XOffset: 1, YOffset: -12, Advance: 12, Offset: 4105},    // 'N'
+		{Rune: 0x4f, Width: 12, Height: 13, XOffset: 0, YOffset: -12, Advance: 12, Offset: 4225},    // 'O'
+		{Rune: 0x50, Width: 9, Height: 12, XOffset: 1, YOffset: -12, Advance: 11, Offset: 4381},     // 'P'
+		{Rune: 0x51, Width: 13, Height: 15, XOffset: 0, YOffset: -12, Advance: 12, Offset: 4489},    // 'Q'
+		{Rune: 0x52, Width: 11, Height: 12, XOffset: 1, YOffset: -12, Advance: 12, Offset: 4684},    // 'R'
+		{Rune: 0x53, Width: 10, Height: 13, XOffset: 0, YOffset: -12, Advance: 11, Offset: 4816},    // 'S'
+		{Rune: 0x54, Width: 10, Height: 12, XOffset: 0, YOffset: -12, Advance: 10, Offset: 4946},    // 'T'
+		{Rune: 0x55, Width: 10, Height: 13, XOffset: 1, YOffset: -12, Advance: 12, Offset: 5066},    // 'U'
+		{Rune: 0x56, Width: 11, Height: 12, XOffset: 0, YOffset: -12, Advance: 11, Offset: 5196},    // 'V'
+		{Rune: 0x57, Width: 15, Height: 12, XOffset: 0, YOffset: -12, Advance: 15, Offset: 5328},    // 'W'
+		{Rune: 0x58, Width: 11, Height: 12, XOffset: 0, YOffset: -12, Advance: 11, Offset: 5508},    // 'X'
+		{Rune: 0x59, Width: 11, Height: 12, XOffset: 0, YOffset: -12, Advance: 11, Offset: 5640},    // 'Y'
+		{Rune: 0x5a, Width: 9, Height: 12, XOffset: 0, YOffset: -12, Advance: 10, Offset: 5772},     // 'Z'
+		{Rune: 0x5b, Width: 4, Height: 16, XOffset: 0, YOffset: -13, Advance: 4, Offset: 5880},      // '['
+		{Rune: 0x5c, Width: 5, Height: 14, XOffset: 0, YOffset: -12, Advance: 4, Offset: 5944},      // '\\'
+		{Rune: 0x5d, Width: 4, Height: 16, XOffset: 0, YOffset: -13, Advance: 4, Offset: 6014},      // ']'
+		{Rune: 0x5e, Width: 7, Height: 7, XOffset: 0, YOffset: -12, Advance: 8, Offset: 6078},       // '^'
+		{Rune: 0x5f, Width: 9, Height: 2, XOffset: 0, YOffset: 0, Advance: 9, Offset: 6127},         // '_'
+		{Rune: 0x60, Width: 5, Height: 3, XOffset: 0, YOffset: -13, Advance: 5, Offset: 6145},       // '`'
+		{Rune: 0x61, Width: 9, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 6160},       // 'a'
+		{Rune: 0x62, Width: 8, Height: 14, XOffset: 1, YOffset: -13, Advance: 9, Offset: 6250},      // 'b'
+		{Rune: 0x63, Width: 8, Height: 10, XOffset: 0, YOffset: -9, Advance: 8, Offset: 6362},       // 'c'
+		{Rune: 0x64, Width: 8, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 6442},      // 'd'
+		{Rune: 0x65, Width: 8, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 6554},       // 'e'
+		{Rune: 0x66, Width: 5, Height: 13, XOffset: 0, YOffset: -13, Advance: 4, Offset: 6634},      // 'f'
+		{Rune: 0x67, Width: 8, Height: 13, XOffset: 0, YOffset: -9, Advance: 9, Offset: 6699},       // 'g'
+		{Rune: 0x68, Width: 7, Height: 13, XOffset: 1, YOffset: -13, Advance: 9, Offset: 6803},      // 'h'
+		{Rune: 0x69, Width: 2, Height: 12, XOffset: 1, YOffset: -12, Advance: 4, Offset: 6894},      // 'i'
+		{Rune: 0x6a, Width: 5, Height: 16, XOffset: -1, YOffset: -12, Advance: 4, Offset: 6918},     // 'j'
+		{Rune: 0x6b, Width: 7, Height: 13, XOffset: 1, YOffset: -13, Advance: 8, Offset: 6998},      // 'k'
+		{Rune: 0x6c, Width: 4, Height: 14, XOffset: 1, YOffset: -13, Advance: 4, Offset: 7089},      // 'l'
+		{Rune: 0x6d, Width: 12, Height: 9, XOffset: 1, YOffset: -9, Advance: 13, Offset: 7145},      // 'm'
+		{Rune: 0x6e, Width: 7, Height: 9, XOffset: 1, YOffset: -9, Advance: 9, Offset: 7253},        // 'n'
+		{Rune: 0x6f, Width: 9, Height: 10, XOffset: 0, YOffset: -9, Advance: 9, Offset: 7316},       // 'o'
+		{Rune: 0x70, Width: 8, Height: 13, XOffset: 1, YOffset: -9, Advance: 9, Offset: 7406},       // 'p'
+		{Rune: 0x71, Width: 8, Height: 13, XOffset: 0, YOffset: -9, Advance: 9, Offset: 7510},       // 'q'
+		{Rune: 0x72, Width: 5, Height: 9, XOffset: 1, YOffset: -9, Advance: 5, Offset: 7614},        // 'r'
+		{Rune: 0x73, Width: 8, Height: 10, XOffset: 0, YOffset: -9, Advance: 8, Offset: 7659},       // 's'
+		{Rune: 0x74, Width: 5, Height: 12, XOffset: 0, YOffset: -11, Advance: 5, Offset: 7739},      // 't'
+		{Rune: 0x75, Width: 7, Height: 10, XOffset: 1, YOffset: -9, Advance: 9, Offset: 7799},       // 'u'
+		{Rune: 0x76, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 7869},        // 'v'
+		{Rune: 0x77, Width: 12, Height: 9, XOffset: 0, YOffset: -9, Advance: 12, Offset: 7941},      // 'w'
+		{Rune: 0x78, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 8049},        // 'x'
+		{Rune: 0x79, Width: 8, Height: 13, XOffset: 0, YOffset: -9, Advance: 8, Offset: 8121},       // 'y'
+		{Rune: 0x7a, Width: 8, Height: 9, XOffset: 0, YOffset: -9, Advance: 8, Offset: 8225},        // 'z'
+		{Rune: 0x7b, Width: 5, Height: 16, XOffset: 0, YOffset: -13, Advance: 5, Offset: 8297},      // '{'
+		{Rune: 0x7c, Width: 2, Height: 16, XOffset: 1, YOffset: -13, Advance: 4, Offset: 8377},      // '|'
+		{Rune: 0x7d, Width: 6, Height: 16, XOffset: 0, YOffset: -13, Advance: 5, Offset: 8409},      // '}'
+		{Rune: 0x7e, Width: 9, Height: 3, XOffset: 0, YOffset: -6, Advance: 9, Offset: 8505},        // '~'
+		{Rune: 0xa0, Width: 0, Height: 0, XOffset: 0, YOffset: 0, Advance: 4, Offset: 8532},         // '\u00a0'
+		{Rune: 0xa1, Width: 3, Height: 13, XOffset: 1, YOffset: -9, Advance: 5, Offset: 8532},       // '¡'
+		{Rune: 0xa2, Width: 7, Height: 12, XOffset: 1, YOffset: -12, Advance: 9, Offset: 8571},      // '¢'
+		{Rune: 0xa3, Width: 8, Height: 12, XOffset: 0, YOffset: -12, Advance: 9, Offset: 8655},      // '£'
+		{Rune: 0xa4, Width: 7, Height: 8, XOffset: 1, YOffset: -10, Advance: 9, Offset: 8751},       // '¤'
+		{Rune: 0xa5, Width: 9, Height: 12, XOffset: 0, YOffset: -12, Advance: 9, Offset: 8807},      // '¥'
+		{Rune: 0xa6, Width: 2, Height: 16, XOffset: 1, YOffset: -13, Advance: 4, Offset: 8915},      // '¦'
+		{Rune: 0xa7, Width: 7, Height: 15, XOffset: 1, YOffset: -12, Advance: 9, Offset: 8947},      // '§'
+		{Rune: 0xa8, Width: 5, Height: 2, XOffset: 0, YOffset: -12, Advance: 5, Offset: 9052},       // '¨'
+		{Rune: 0xa9, Width: 12, Height: 12, XOffset: 0, YOffset: -12, Advance: 12, Offset: 9062},    // '©'
+		{Rune: 0xaa, Width: 6, Height: 6, XOffset: 0, YOffset: -12, Advance: 6, Offset: 9206},       // 'ª'
+		{Rune: 0xab, Width: 8, Height: 8, XOffset: 0, YOffset: -8, Advance: 9, Offset: 9242},        // '«'
+		{Rune: 0xac, Width: 9, Height: 5, XOffset: 0, YOffset: -7, Advance: 9, Offset: 9306},        // '¬'
+		{Rune: 0xad, Width: 5, Height: 2, XOffset: 0, YOffset: -6, Advance: 5, Offset: 9351},        // '\u00ad'
+		{Rune: 0xae, Width: 12, Height: 12, XOffset: 0, YOffset: -12, Advance: 12, Offset: 9361},    // '®'
+		{Rune: 0xaf, Width: 9, Height: 2, XOffset: 0, YOffset: -13, Advance: 9, Offset: 9505},       // '¯'
+		{Rune: 0xb0, Width: 6, Height: 5, XOffset: 0, YOffset: -12, Advance: 6, Offset: 9523},       // '°'
+		{Rune: 0xb1, Width: 9, Height: 10, XOffset: 0, YOffset: -10, Advance: 9, Offset: 9553},      // '±'
+		{Rune: 0xb2, Width: 6, Height: 8, XOffset: 0, YOffset: -13, Advance: 7, Offset: 9643},       // '²'
+		{Rune: 0xb3, Width: 6, Height: 8, XOffset: 0, YOffset: -13, Advance: 7, Offset: 9691},       // '³'
+		{Rune: 0xb4, Width: 5, Height: 3, XOffset: 0, YOffset: -13, Advance: 5, Offset: 9739},       // '´'
+		{Rune: 0xb5, Width: 7, Height: 13, XOffset: 1, YOffset: -9, Advance: 9, Offset: 9754},       // 'µ'
+		{Rune: 0xb6, Width: 7, Height: 15, XOffset: 0, YOffset: -12, Advance: 9, Offset: 9845},      // '¶'
+		{Rune: 0xb7, Width: 3, Height: 3, XOffset: 1, YOffset: -9, Advance: 4, Offset: 9950},        // '·'
+		{Rune: 0xb8, Width: 3, Height: 4, XOffset: 1, YOffset: 0, Advance: 5, Offset: 9959},         // '¸'
+		{Rune: 0xb9, Width: 6, Height: 8, XOffset: 1, YOffset: -13, Advance: 7, Offset: 9971},       // '¹'
+		{Rune: 0xba, Width: 6, Height: 6, XOffset: 0, YOffset: -12, Advance: 6, Offset: 10019},      // 'º'
+		{Rune: 0xbb, Width: 7, Height: 8, XOffset: 1, YOffset: -8, Advance: 9, Offset: 10055},       // '»'
+		{Rune: 0xbc, Width: 13, Height: 13, XOffset: 0, YOffset: -12, Advance: 13, Offset: 10111},   // '¼'
+		{Rune: 0xbd, Width: 13, Height: 13, XOffset: 0, YOffset: -12, Advance: 13, Offset: 10280},   // '½'
+		{Rune: 0xbe, Width: 13, Height: 13, XOffset: 0, YOffset: -12, Advance: 13, Offset: 10449},   // '¾'
+		{Rune: 0xbf, Width: 8, Height: 13, XOffset: 1, YOffset: -9, Advance: 10, Offset: 10618},     // '¿'
+		{Rune: 0xc0, Width: 11, Height: 16, XOffset: 0, YOffset: -16, Advance: 11, Offset: 10722},   // 'À'
+		{Rune: 0xc1, Width: 11, Height: 16, XOffset: 0, YOffset: -16, Advance: 11, Offset: 10898},   // 'Á'
+		{Rune: 0xc2, Width: 11, Height: 16, XOffset: 0, YOffset: -16, Advance: 11, Offset: 11074},   // 'Â'
+		{Rune: 0xc3, Width: 11, Height: 15, XOffset: 0, YOffset: -15, Advance: 11, Offset: 11250},   // 'Ã'
+		{Rune: 0xc4, Width: 11, Height: 15, XOffset: 0, YOffset: -15, Advance: 11, Offset: 11415},   // 'Ä'
+		{Rune: 0xc5, Width: 11, Height: 16, XOffset: 0, YOffset: -16, Advance: 11, Offset: 11580},   // 'Å'
+		{Rune: 0xc6, Width: 16, Height: 12, XOffset: 0, YOffset: -12, Advance: 16, Offset: 11756},   // 'Æ'
+		{Rune: 0xc7, Width: 11, Height: 16, XOffset: 0, YOffset: -12, Advance: 12, Offset: 11948},   // 'Ç'
+		{Rune: 0xc8, Width: 10, Height: 16, XOffset: 1, YOffset: -16, Advance: 11, Offset: 12124},   // 'È'
+		{Rune: 0xc9, Width: 10, Height: 16, XOffset: 1, YOffset: -16, Advance: 11, Offset: 12284},   // 'É'
+		{Rune: 0xca, Width: 10, Height: 16, XOffset: 1, YOffset: -16, Advance: 11, Offset: 12444},   // 'Ê'
+		{Rune: 0xcb, Width: 10, Height: 15, XOffset: 1, YOffset: -15, Advance: 11, Offset: 12604},   // 'Ë'
+		{Rune: 0xcc, Width: 6, Height: 16, XOffset: 0, YOffset: -16, Advance: 6, Offset: 12754},     // 'Ì'
+		{Rune: 0xcd, Width: 6, Height: 16, XOffset: 0, YOffset: -16, Advance: 6, Offset: 12850},     // 'Í'
+		{Rune: 0xce, Width: 6, Height: 16, XOffset: 0, YOffset: -16, Advance: 6, Offset: 12946},     // 'Î'
+		{Rune: 0xcf, Width: 6, Height: 15, XOffset: 0, YOffset: -15, Advance: 6, Offset: 13042},     // 'Ï'
+		{Rune: 0xd0, Width: 11, Height: 12, XOffset: 0, YOffset: -12, Advance: 12, Offset: 13132},   // 'Ð'
+		{Rune: 0xd1, Width: 10, Height: 15, XOffset: 1, YOffset: -15, Advance: 12, Offset: 13264},   // 'Ñ'
+		{Rune: 0xd2, Width: 12, Height: 17, XOffset: 0, YOffset: -16, Advance: 12, Offset: 13414},   // 'Ò'
+		{Rune: 0xd3, Width: 12, Height: 17, XOffset: 0, YOffset: -16, Advance: 12, Offset: 13618},   // 'Ó'
+		{Rune: 0xd4, Width: 12, Height: 17, XOffset: 0, YOffset: -16, Advance: 12, Offset: 13822},   // 'Ô'
+		{Rune: 0xd5, Width: 12, Height: 16, XOffset: 0, YOffset: -15, Advance: 12, Offset: 14026},   // 'Õ'
+		{Rune: 0xd6, Width: 12, Height: 16, XOffset: 0, YOffset: -15, Advance: 12, Offset: 14218},   // 'Ö'
+		{Rune: 0xd7, Width: 9, Height: 9, XOffset: 0, YOffset: -9, Advance: 9, Offset: 14410},       // '×'
+		{Rune: 0xd8, Width: 12, Height: 13, XOffset: 0, YOffset: -12, Advance: 12, Offset: 14491},   // 'Ø'
+		{Rune: 0xd9, Width: 10, Height: 17, XOffset: 1, YOffset: -16, Advance: 12, Offset: 14647},   // 'Ù'
+		{Rune: 0xda, Width: 10, Height: 17, XOffset: 1, YOffset: -16, Advance: 12, Offset: 14817},   // 'Ú'
+		{Rune: 0xdb, Width: 10, Height: 17, XOffset: 1, YOffset: -16, Advance: 12, Offset: 14987},   // 'Û'
+		{Rune: 0xdc, Width: 10, Height: 16, XOffset: 1, YOffset: -15, Advance: 12, Offset: 15157},   // 'Ü'
+		{Rune: 0xdd, Width: 11, Height: 16, XOffset: 0, YOffset: -16, Advance: 11, Offset: 15317},   // 'Ý'
+		{Rune: 0xde, Width: 10, Height: 12, XOffset: 1, YOffset: -12, Advance: 11, Offset: 15493},   // 'Þ'
+		{Rune: 0xdf, Width: 9, Height: 14, XOffset: 1, YOffset: -13, Advance: 10, Offset: 15613},    // 'ß'
+		{Rune: 0xe0, Width: 9, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 15739},     // 'à'
+		{Rune: 0xe1, Width: 9, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 15865},     // 'á'
+		{Rune: 0xe2, Width: 9, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 15991},     // 'â'
+		{Rune: 0xe3, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 16117},     // 'ã'
+		{Rune: 0xe4, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 16234},     // 'ä'
+		{Rune: 0xe5, Width: 9, Height: 15, XOffset: 0, YOffset: -14, Advance: 9, Offset: 16351},     // 'å'
+		{Rune: 0xe6, Width: 14, Height: 10, XOffset: 0, YOffset: -9, Advance: 14, Offset: 16486},    // 'æ'
+		{Rune: 0xe7, Width: 8, Height: 13, XOffset: 0, YOffset: -9, Advance: 8, Offset: 16626},      // 'ç'
+		{Rune: 0xe8, Width: 8, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 16730},     // 'è'
+		{Rune: 0xe9, Width: 8, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 16842},     // 'é'
+		{Rune: 0xea, Width: 8, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 16954},     // 'ê'
+		{Rune: 0xeb, Width: 8, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 17066},     // 'ë'
+		{Rune: 0xec, Width: 5, Height: 13, XOffset: -1, YOffset: -13, Advance: 4, Offset: 17170},    // 'ì'
+		{Rune: 0xed, Width: 5, Height: 13, XOffset: 0, YOffset: -13, Advance: 4, Offset: 17235},     // 'í'
+		{Rune: 0xee, Width: 6, Height: 13, XOffset: -1, YOffset: -13, Advance: 4, Offset: 17300},    // 'î'
+		{Rune: 0xef, Width: 6, Height: 12, XOffset: -1, YOffset: -12, Advance: 4, Offset: 17378},    // 'ï'
+		{Rune: 0xf0, Width: 9, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 17450},     // 'ð'
+		{Rune: 0xf1, Width: 7, Height: 12, XOffset: 1, YOffset: -12, Advance: 9, Offset: 17576},     // 'ñ'
+		{Rune: 0xf2, Width: 9, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 17660},     // 'ò'
+		{Rune: 0xf3, Width: 9, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 17786},     // 'ó'
+		{Rune: 0xf4, Width: 9, Height: 14, XOffset: 0, YOffset: -13, Advance: 9, Offset: 17912},     // 'ô'
+		{Rune: 0xf5, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 18038},     // 'õ'
+		{Rune: 0xf6, Width: 9, Height: 13, XOffset: 0, YOffset: -12, Advance: 9, Offset: 18155},     // 'ö'
+		{Rune: 0xf7, Width: 9, Height: 10, XOffset: 0, YOffset: -10, Advance: 9, Offset: 18272},     // '÷'
+		{Rune: 0xf8, Width: 8, Height: 10, XOffset: 1, YOffset: -9, Advance: 10, Offset: 18362},     // 'ø'
+		{Rune: 0xf9, Width: 7, Height: 14, XOffset: 1, YOffset: -13, Advance: 9, Offset: 18442},     // 'ù'
+		{Rune: 0xfa, Width: 7, Height: 14, XOffset: 1, YOffset: -13, Advance: 9, Offset: 18540},     // 'ú'
+		{Rune: 0xfb, Width: 7, Height: 14, XOffset: 1, YOffset: -13, Advance: 9, Offset: 18638},     // 'û'
+		{Rune: 0xfc, Width: 7, Height: 13, XOffset: 1, YOffset: -12, Advance: 9, Offset: 18736},     // 'ü'
+		{Rune: 0xfd, Width: 8, Height: 17, XOffset: 0, YOffset: -13, Advance: 8, Offset: 18827},     // 'ý'
+		{Rune: 0xfe, Width: 8, Height: 17, XOffset: 1, YOffset: -13, Advance: 9, Offset: 18963},     // 'þ'
+		{Rune: 0xff, Width: 8, Height: 16, XOffset: 0, YOffset: -12, Advance: 8, Offset: 19099},     // 'ÿ'
+		{Rune: 0xfffd, Width: 16, Height: 16, XOffset: 0, YOffset: -14, Advance: 16, Offset: 19227}, // '�'
+	},
+	Bitmap: "" +
+		"\x38\x25\xf3\x5f\x35\xf2\x5f\x24\xf2\x4f\x13\xf1\x2c\x00\x00\x49\x27\xf4\x15\x13\x54\xf3\x8e\x3f\x37\xd2\xf2\x7c\x1b\x15\x90\x00" +
+		"\x27\x01\x70\x00\x06\x90\x5a\x00\x00\xa5\x09\x60\x01\x1d\x31\xd4\x10\xdd\xed\xdf\xd8\x00\x69\x05\xa0\x00\x0a\x50\x96\x00\x47\xe8" +
+		"\x7d\x86\x06\x9e\x89\xe8\x70\x06\x90\x5a\x00\x00\xa5\x09\x60\x00\x0d\x20\xd2\x00\x00\x00\x06\x00\x00\x01\x6e\x74\x10\x2e\xdd\x9d" +
+		"\x70\x8d\x0c\x00\x10\xac\x0c\x00\x00\x5f\x6c\x00\x00\x08\xfe\x10\x00\x00\x4e\xe6\x00\x00\x0c\x8f\x50\x00\x0c\x0b\xa0\x00\x0c\x0c" +
+		"\x90\xc6\x4c\x9f\x30\x8c\xef\xc4\x00\x00\x0b\x00\x00\x05\x85\x00\x00\x02\x80\x00\x8d\x5d\x80\x00\x1c\x50\x00\xe6\x06\xe0\x00\xa8" +
+		"\x00\x00\xf4\x04\xf0\x06\xb0\x00\x00\xd8\x08\xd0\x3d\x10\x00\x00\x4e\xbe\x41\xd4\x00\x00\x00\x01\x31\x0b\x71\xad\xd6\x00\x00\x00" +
+		"\x8a\x07\xd0\x4f\x20\x00\x05\xd1\x0b\x90\x0f\x50\x00\x2e\x30\x0a\xa0\x1f\x40\x01\xc5\x00\x05\xe4\x8d\x10\x0a\x90\x00\x00\x5a\x92" +
+		"\x00\x00\x5b\xc8\x00\x00\x00\x3f\x97\xf6\x00\x00\x07\xf1\x0d\x90\x00\x00\x6f\x32\xf6\x00\x00\x01\xec\xea\x00\x00\x02\xaf\xf7\x00" +
+		"\x00\x01\xdb\x2d\xb0\x03\x70\x5f\x30\x4f\x60\x8d\x08\xf1\x00\xae\x2b\x90\x6f\x40\x01\xdd\xe1\x01\xdd\x30\x19\xfb\x00\x02\xcf\xef" +
+		"\xc8\xfa\x00\x00\x24\x20\x00\x00\x25\x25\xf6\x4f\x53\xf4\x1e\x20\x00\x10\x04\x90\x3e\x20\xc7\x04\xf1\x09\xc0\x0c\xa0\x0e\x90\x0e" +
+		"\x90\x0c\xa0\x09\xc0\x04\xf1\x00\xc7\x00\x2e\x20\x04\x90\x00\x11\x00\x00\x59\x00\x00\xb7\x00\x02\xe2\x00\x0b\x90\x00\x7e\x00\x05" +
+		"\xf2\x00\x4f\x40\x04\xf4\x00\x5f\x20\x07\xe0\x00\xb9\x00\x2e\x20\x0c\x70\x05\x80\x00\x10\x00\x00\x01\xc5\x00\x01\x00\xe5\x00\x09" +
+		"\xb4\xc4\x8e\x05\x9b\x08\xa8\x10\x09\x7b\x10\x00\x8e\x2a\xd1\x00\x67\x02\xa1\x00\x00\x07\x20\x00\x00\x00\xe4\x00\x00\x00\x0e\x40" +
+		"\x00\x13\x33\xe6\x33\x23\xee\xef\xfe\xe8\x00\x00\xe4\x00\x00\x00\x0e\x40\x00\x00\x00\xe4\x00\x00\x00\x03\x10\x00\x6e\x77\xf7\x0b" +
+		"\x61\xd3\x46\x01\x33\x33\x33\x32\x3e\xee\xee\xee\x80\x00\x7f\x97\xf9\x00\x07\x30\x01\xf3\x00\x4e\x00\x08\xa0\x00\xc6\x00\x1f\x20" +
+		"\x05\xe0\x00\x8a\x00\x0c\x60\x01\xf2\x00\x5d\x00\x09\xa0\x00\xc6\x00\x01\x00\x00\x00\x3a\xc9\x30\x00\x4e\xa6\xbe\x20\x0b\xd0\x01" +
+		"\xe9\x01\xf8\x00\x0b\xe0\x3f\x50\x08\xdf\x15\xf4\x06\xa5\xf3\x5f\x45\xa0\x5f\x44\xf9\xb1\x06\xf2\x3f\xc1\x00\x8f\x10\xdb\x00\x0c" +
+		"\xc0\x08\xf4\x06\xf6\x00\x0a\xfe\xf8\x00\x00\x01\x41\x00\x00\x15\x98\x00\x05\xfd\xfa\x00\x02\x20\xda\x00\x00\x00\xda\x00\x00\x00" +
+		"\xda\x00\x00\x00\xda\x00\x00\x00\xda\x00\x00\x00\xda\x00\x00\x00\xda\x00\x00\x00\xda\x00\x01\x22\xeb\x22\x05\xff\xff\xff\x20\x49" +
+		"\xcc\x81\x00\xd9\x57\xeb\x00\x10\x00\x7f\x30\x00\x00\x5f\x40\x00\x00\x8f\x10\x00\x02\xea\x00\x00\x1c\xb1\x00\x01\xcb\x10\x00\x0b" +
+		"\xc1\x00\x00\x8f\x20\x00\x02\xfd\x55\x55\x23\xff\xff\xff\x44\x9c\xb8\x10\x88\x57\xec\x00\x00\x07\xf1\x00\x00\x7f\x10\x00\x2d\x80" +
+		"\x07\xbe\x60\x00\x47\xbe\x60\x00\x00\x8f\x30\x00\x02\xf7\x00\x00\x3f\x65\x10\x1b\xe2\xcf\xef\xd4\x00\x24\x30\x00\x00\x00\x07\x70" +
+		"\x00\x00\x06\xfc\x00\x00\x02\xed\xc0\x00\x00\xca\x8c\x00\x00\x8d\x18\xc0\x00\x4f\x30\x8c\x00\x1e\x70\x08\xc0\x0a\xe8\x88\xce\x83" +
+		"\x8b\xbb\xbd\xeb\x40\x00\x00\x9c\x00\x00\x00\x09\xc0\x00\x00\x00\x9c\x00\x48\x88\x88\x38\xec\xcc\xc4\x8c\x00\x00\x08\xc0\x00\x00" +
+		"\x8c\x00\x00\x08\xfe\xc8\x10\x23\x48\xec\x00\x00\x06\xf5\x00\x00\x2f\x70\x00\x04\xf6\x31\x02\xce\x1b\xfe\xfc\x30\x14\x42\x00\x00" +
+		"\x00\x7a\xc9\x30\x00\xad\x75\x95\x00\x6f\x20\x00\x00\x0d\xa0\x00\x00\x02\xf7\x15\x51\x00\x3f\x9d\xbe\xd2\x05\xfd\x10\x1e\xb0\x4f" +
+		"\x70\x00\x9e\x02\xf7\x00\x08\xf0\x0d\xa0\x00\x9d\x00\x6f\x40\x3e\x70\x00\x8f\xef\xa1\x00\x00\x14\x20\x00\x88\x88\x88\x84\xcd\xdd" +
+		"\xdd\xf7\x00\x00\x06\xe2\x00\x00\x1e\x70\x00\x00\x9c\x00\x00\x04\xf3\x00\x00\x0d\xa0\x00\x00\x6f\x30\x00\x01\xea\x00\x00\x06\xf4" +
+		"\x00\x00\x0b\xe0\x00\x00\x0f\xa0\x00\x00\x00\x29\xcc\x71\x00\x2e\xa6\x7e\xa0\x08\xd0\x00\x7f\x00\x7f\x10\x08\xc0\x02\xec\x24\xd4" +
+		"\x00\x04\xff\xe4\x00\x02\xca\xaf\xd3\x00\xbb\x00\x4e\xe1\x1f\x60\x00\x5f\x62\xf6\x00\x02\xf6\x0d\xd3\x01\xae\x20\x2c\xfe\xfd\x40" +
+		"\x00\x02\x42\x00\x00\x06\xbc\x81\x00\x08\xe7\x6d\xc0\x01\xf7\x00\x2f\x60\x4f\x30\x00\xcb\x04\xf4\x00\x0b\xd0\x2f\x70\x01\xef\x00" +
+		"\xbe\x65\xbd\xe0\x01\x9c\xa4\xbd\x00\x00\x00\x0d\xa0\x00\x00\x03\xf4\x00\x41\x02\xcc\x00\x0a\xfe\xfb\x10\x00\x02\x41\x00\x00\x49" +
+		"\x47\xf8\x25\x30\x00\x00\x00\x00\x00\x06\xe7\x7f\x84\x94\x7f\x82\x53\x00\x00\x00\x00\x00\x00\x6e\x77\xf7\x0b\x61\xd3\x46\x00\x00" +
+		"\x00\x00\x13\x00\x00\x01\x7e\x70\x00\x17\xec\x50\x01\x7e\xc5\x00\x00\xaf\xc2\x00\x00\x00\x3b\xf9\x20\x00\x00\x03\xbf\x92\x00\x00" +
+		"\x00\x3b\x80\x00\x00\x00\x01\xbe\xee\xee\xee\xe2\x46\x66\x66\x66\x61\x00\x00\x00\x00\x00\x79\x99\x99\x99\x91\x8b\xbb\xbb\xbb\xb1" +
+		"\x12\x00\x00\x00\x02\xfa\x20\x00\x00\x02\xaf\xa2\x00\x00\x00\x2a\xfa\x20\x00\x00\x09\xfd\x20\x00\x6d\xd6\x00\x06\xdd\x60\x00\x03" +
+		"\xd6\x00\x00\x00\x00\x00\x00\x00\x05\x9b\xca\x60\x89\x65\x9f\x70\x00\x00\xcc\x00\x00\x0e\xb0\x00\x09\xe3\x00\x09\xd2\x00\x06\xe2" +
+		"\x00\x00\xbb\x00\x00\x0c\x90\x00\x00\x00\x00\x00\x07\x50\x00\x00\xea\x00\x00\x00\x03\x9b\xca\x50\x00\x00\x1a\xb5\x21\x3a\xb1\x00" +
+		"\x1c\x60\x01\x32\x18\x90\x0b\x60\x1b\xcd\xf3\x0c\x24\xa0\x0c\x30\x2f\x00\x94\xa3\x07\x80\x06\xc0\x08\x5d\x10\xd4\x01\xe9\x00\xa2" +
+		"\xd0\x0f\x20\xac\x60\x3b\x0c\x10\xe8\xa6\xd7\x6b\x20\x87\x04\x94\x06\x97\x10\x01\xd6\x00\x00\x10\x00\x00\x01\x9d\xaa\xd8\x00\x00" +
+		"\x00\x00\x13\x41\x00\x00\x00\x00\x00\x58\x20\x00\x00\x00\x0d\xf8\x00\x00\x00\x03\xfe\xe0\x00\x00\x00\x9d\x7f\x50\x00\x00\x1e\x81" +
+		"\xfa\x00\x00\x06\xf2\x0b\xf1\x00\x00\xbb\x00\x5f\x60\x00\x2f\xa6\x67\xfc\x00\x08\xfc\xcc\xcd\xf2\x00\xd9\x00\x00\x3f\x80\x4f\x40" +
+		"\x00\x00\xdd\x0a\xd0\x00\x00\x07\xf4\x68\x88\x87\x30\x0b\xfa\xab\xef\x90\xbe\x00\x00\xcf\x1b\xe0\x00\x09\xf1\xbe\x00\x02\xda\x0b" +
+		"\xf7\x8a\xd8\x10\xbf\x89\xae\xa2\x0b\xe0\x00\x1b\xe2\xbe\x00\x00\x3f\x7b\xe0\x00\x03\xf8\xbe\x44\x46\xcf\x4b\xff\xff\xec\x40\x00" +
+		"\x01\x7a\xcc\xb8\x30\x05\xee\x96\x79\xd8\x02\xec\x20\x00\x00\x10\x9f\x40\x00\x00\x00\x0d\xd0\x00\x00\x00\x00\xfb\x00\x00\x00\x00" +
+		"\x1f\xb0\x00\x00\x00\x00\xed\x00\x00\x00\x00\x0c\xf2\x00\x00\x00\x00\x5f\xa0\x00\x00\x00\x00\xbf\xb4\x11\x39\x70\x00\x7e\xff\xff" +
+		"\xc4\x00\x00\x02\x43\x10\x06\x88\x87\x53\x00\x0b\xfa\xab\xef\xa2\x0b\xe0\x00\x07\xfc\x0b\xe0\x00\x00\x9f\x6b\xe0\x00\x00\x2f\x9b" +
+		"\xe0\x00\x00\x0f\xbb\xe0\x00\x00\x0f\xbb\xe0\x00\x00\x1f\x9b\xe0\x00\x00\x5f\x6b\xe0\x00\x01\xdd\x0b\xe4\x45\x7e\xd3\x0b\xff\xfe" +
+		"\xb8\x10\x04\x88\x88\x88\x87\x08\xfb\xaa\xaa\xa9\x08\xf2\x00\x00\x00\x08\xf2\x00\x00\x00\x08\xf2\x00\x00\x00\x08\xfa\x99\x99\x91" +
+		"\x08\xf9\x99\x99\x91\x08\xf2\x00\x00\x00\x08\xf2\x00\x00\x00\x08\xf2\x00\x00\x00\x08\xf5\x44\x44\x44\x18\xff\xff\xff\xff\x34\x88" +
+		"\x88\x88\x83\x8f\xba\xaa\xaa\x38\xf2\x00\x00\x00\x8f\x20\x00\x00\x08\xf2\x00\x00\x00\x8f\x87\x77\x74\x08\xfc\xbb\xbb\x60\x8f\x20" +
+		"\x00\x00\x08\xf2\x00\x00\x00\x8f\x20\x00\x00\x08\xf2\x00\x00\x00\x8f\x20\x00\x00\x00\x00\x17\xac\xca\x86\x00\x6e\xd9\x67\x8c\xe0" +
+		"\x3f\xb1\x00\x00\x01\x0b\xf2\x00\x00\x00\x00\xfb\x00\x00\x00\x00\x2f\x90\x00\x00\x00\x03\xf8\x00\x00\x36\x66\x1f\xa0\x00\x07\xce" +
+		"\xf0\xed\x00\x00\x00\xaf\x08\xf8\x00\x00\x0a\xf0\x1d\xfa\x31\x02\xbf\x00\x18\xef\xff\xff\xc0\x00\x00\x24\x43\x00\x68\x00\x00\x03" +
+		"\x82\xbe\x00\x00\x06\xf4\xbe\x00\x00\x06\xf4\xbe\x00\x00\x06\xf4\xbe\x00\x00\x06\xf4\xbf\xaa\xaa\xac\xf4\xbf\x88\x88\x8b\xf4\xbe" +
+		"\x00\x00\x06\xf4\xbe\x00\x00\x06\xf4\xbe\x00\x00\x06\xf4\xbe\x00\x00\x06\xf4\xbe\x00\x00\x06\xf4\x08\x88\x84\x0a\xdf\xa4\x00\x9f" +
+		"\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x04\xbf\x41\x0f\xff\xf6\x00\x28\x88\x30" +
+		"\x02\xab\xf5\x00\x00\x4f\x50\x00\x04\xf5\x00\x00\x4f\x50\x00\x04\xf5\x00\x00\x4f\x50\x00\x04\xf5\x00\x00\x4f\x50\x00\x04\xf5\x00" +
+		"\x00\x5f\x50\x00\x06\xf3\x62\x02\xcd\x0b\xff\xfd\x40\x01\x43\x00\x04\x80\x00\x03\x82\x08\xf0\x00\x2e\x90\x08\xf0\x01\xdb\x00\x08" +
+		"\xf0\x0b\xd1\x00\x08\xf0\x9e\x20\x00\x07\xf7\xf4\x00\x00\x08\xfa\xf5\x00\x00\x08\xf1\xbf\x40\x00\x08\xf0\x1c\xe3\x00\x08\xf0\x02" +
+		"\xde\x20\x08\xf0\x00\x3e\xd1\x08\xf0\x00\x04\xfc\x16\x80\x00\x00\x0b\xe0\x00\x00\x0b\xe0\x00\x00\x0b\xe0\x00\x00\x0b\xe0\x00\x00" +
+		"\x0b\xe0\x00\x00\x0b\xe0\x00\x00\x0b\xe0\x00\x00\x0b\xe0\x00\x00\x0b\xe0\x00\x00\x0b\xe4\x44\x44\x2b\xff\xff\xff\x96\x86\x00\x00" +
+		"\x01\x88\x0b\xfe\x00\x00\x06\xff\x1b\xff\x40\x00\x0b\xff\x1b\xbe\xa0\x00\x2f\xaf\x1b\xb9\xe1\x00\x7c\x7f\x1b\xb4\xf5\x00\xd7\x7f" +
+		"\x1b\xb0\xdb\x03\xf1\x7f\x1b\xb0\x8f\x18\xb0\x7f\x1b\xb0\x3f\x7e\x50\x7f\x1b\xb0\x0d\xee\x10\x7f\x1b\xb0\x07\xf9\x00\x7f\x1b\xb0" +
+		"\x01\x31\x00\x7f\x16\x81\x00\x00\x18\x2b\xf9\x00\x00\x2f\x4b\xff\x40\x00\x2f\x4b\xce\xd1\x00\x2f\x4b\xb5\xf9\x00\x2f\x4b\xb0\xaf" +
+		"\x40\x2f\x4b\xb0\x1e\xd1\x2f\x4b\xb0\x05\xf9\x2f\x4b\xb0\x00\xaf\x6f\x4b\xb0\x00\x1e\xef\x4b\xb0\x00\x05\xff\x4b\xb0\x00\x00\xaf" +
+		"\x40\x00\x28\xbc\x96\x00\x00\x06\xec\x87\xaf\xb1\x00\x3f\xa0\x00\x04\xfa\x00\xbf\x10\x00\x00\x9f\x30\xfa\x00\x00\x00\x4f\x72\xf9" +
+		"\x00\x00\x00\x2f\x93\xf8\x00\x00\x00\x1f\xa1\xfa\x00\x00\x00\x3f\x80\xed\x00\x00\x00\x6f\x60\x6f\x50\x00\x00\xdd\x00\x0c\xf6\x10" +
+		"\x3c\xf4\x00\x00\x8f\xff\xfc\x30\x00\x00\x00\x33\x10\x00\x06\x88\x88\x87\x20\xaf\xaa\xac\xff\x5a\xe0\x00\x02\xec\xae\x00\x00\x0c" +
+		"\xea\xe0\x00\x00\xec\xae\x00\x00\x8f\x8a\xfa\xac\xef\xa0\xaf\x88\x86\x20\x0a\xe0\x00\x00\x00\xae\x00\x00\x00\x0a\xe0\x00\x00\x00" +
+		"\xae\x00\x00\x00\x00\x00\x28\xbc\x96\x00\x00\x00\x6e\xc8\x7a\xfb\x10\x00\x3f\xa0\x00\x04\xfa\x00\x0b\xf1\x00\x00\x09\xf3\x00\xfb" +
+		"\x00\x00\x00\x4f\x70\x2f\x90\x00\x00\x02\xf9\x03\xf8\x00\x00\x00\x1f\xa0\x1f\xa0\x00\x00\x03\xf8\x00\xdd\x00\x00\x00\x6f\x60\x06" +
+		"\xf5\x00\x00\x0d\xd0\x00\x0c\xf6\x10\x3c\xe4\x00\x00\x09\xff\xff\xe3\x00\x00\x00\x00\x34\x6d\xea\x61\x00\x00\x00\x00\x16\xcf\x60" +
+		"\x00\x00\x00\x00\x00\x20\x68\x88\x88\x51\x00\x0b\xfa\xaa\xcf\xd2\x00\xbe\x00\x00\x5f\x70\x0b\xe0\x00\x01\xf9\x00\xbe\x00\x00\x4f" +
+		"\x70\x0b\xe2\x23\x6d\xc0\x00\xbf\xff\xff\xa0\x00\x0b\xe2\x22\xce\x10\x00\xbe\x00\x03\xfb\x00\x0b\xe0\x00\x07\xf6\x00\xbe\x00\x00" +
+		"\x0c\xe2\x0b\xe0\x00\x00\x3f\xc0\x00\x4a\xcc\xa8\x60\x06\xfb\x76\x8a\xe0\x0d\xc0\x00\x00\x00\x0e\xc0\x00\x00\x00\x0a\xf8\x10\x00" +
+		"\x00\x01\xbf\xfb\x61\x00\x00\x04\xae\xfe\x60\x00\x00\x01\x6d\xf5\x00\x00\x00\x02\xfa\x00\x00\x00\x01\xf9\x1c\x73\x10\x19\xf4\x1b" +
+		"\xef\xff\xfe\x60\x00\x02\x34\x20\x00\x78\x88\x88\x88\x85\x8a\xaa\xfd\xaa\xa6\x00\x00\xeb\x00\x00\x00\x00\xeb\x00\x00\x00\x00\xeb" +
+		"\x00\x00\x00\x00\xeb\x00\x00\x00\x00\xeb\x00\x00\x00\x00\xeb\x00\x00\x00\x00\xeb\x00\x00\x00\x00\xeb\x00\x00\x00\x00\xeb\x00\x00" +
+		"\x00\x00\xeb\x00\x00\x68\x00\x00\x02\x82\xbe\x00\x00\x03\xf4\xbe\x00\x00\x03\xf4\xbe\x00\x00\x03\xf4\xbe\x00\x00\x03\xf4\xbe\x00" +
+		"\x00\x03\xf4\xbe\x00\x00\x03\xf4\xae\x00\x00\x03\xf4\x9f\x00\x00\x04\xf3\x7f\x30\x00\x07\xf1\x1e\xd3\x00\x4e\xa0\x03\xcf\xff\xfa" +
+		"\x00\x00\x02\x43\x10\x00\x58\x10\x00\x00\x08\x45\xf6\x00\x00\x03\xf3\x0e\xc0\x00\x00\x9c\x00\x8f\x20\x00\x0e\x70\x02\xf8\x00\x05" +
+		"\xf1\x00\x0c\xe0\x00\xab\x00\x00\x6f\x50\x1f\x50\x00\x01\xeb\x06\xe0\x00\x00\x09\xf2\xc9\x00\x00\x00\x3f\xaf\x30\x00\x00\x00\xdf" +
+		"\xd0\x00\x00\x00\x07\xf7\x00\x00\x67\x00\x00\x28\x40\x00\x04\x78\xf1\x00\x07\xfb\x00\x00\xb9\x4f\x50\x00\xbf\xf1\x00\x1f\x51\xf9" +
+		"\x00\x1f\xbf\x50\x04\xf1\x0b\xc0\x05\xf3\xf9\x00\x8c\x00\x8f\x10\xab\x0d\xd0\x0d\x70\x04\xf5\x0e\x70\x8f\x22\xf3\x00\x0e\x94\xf2" +
+		"\x04\xf7\x6e\x00\x00\xbd\x8d\x00\x0e\xba\xa0\x00\x07\xfe\x80\x00\xaf\xe6\x00\x00\x3f\xf4\x00\x06\xff\x10\x00\x00\xee\x00\x00\x2f" +
+		"\xc0\x00\x38\x50\x00\x00\x38\x10\xce\x20\x00\x1d\x90\x03\xfc\x00\x0b\xc0\x00\x07\xf7\x07\xe2\x00\x00\x0b\xf6\xf5\x00\x00\x00\x2e" +
+		"\xf9\x00\x00\x00\x00\xbf\x90\x00\x00\x00\x7e\xbf\x40\x00\x00\x3f\x51\xde\x10\x00\x1d\x90\x04\xfa\x00\x0a\xd1\x00\x08\xf6\x06\xe3" +
+		"\x00\x00\x0c\xe2\x58\x20\x00\x00\x28\x22\xfb\x00\x00\x0c\xb0\x07\xf6\x00\x06\xe2\x00\x0d\xe1\x02\xe7\x00\x00\x4f\x90\xbc\x00\x00" +
+		"\x00\xaf\x9f\x20\x00\x00\x01\xef\x70\x00\x00\x00\x09\xf1\x00\x00\x00\x00\x9f\x00\x00\x00\x00\x09\xf0\x00\x00\x00\x00\x9f\x00\x00" +
+		"\x00\x00\x09\xf0\x00\x00\x07\x88\x88\x88\x80\x8a\xaa\xaa\xfe\x00\x00\x00\x9f\x60\x00\x00\x4f\xb0\x00\x00\x1d\xe1\x00\x00\x0a\xf5" +
+		"\x00\x00\x05\xfa\x00\x00\x01\xed\x10\x00\x00\xbf\x40\x00\x00\x6f\x90\x00\x00\x1e\xe6\x55\x55\x53\xff\xff\xff\xff\x15\x55\x2f\xdc" +
+		"\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\x30\x2f\xdc\x15\x54\x31\x00\x0c\x60" +
+		"\x00\x8a\x00\x04\xe0\x00\x1f\x30\x00\xc7\x00\x08\xb0\x00\x4e\x00\x00\xe4\x00\x0b\x80\x00\x7c\x00\x03\xf1\x00\x0e\x40\x00\x11\x35" +
+		"\x53\x6c\xe9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x00\xc9\x6d\xe9\x25\x53\x00" +
+		"\x05\x00\x00\x00\xe6\x00\x00\x7e\xe0\x00\x0e\x6d\x70\x07\xd0\x5e\x11\xe5\x00\xd7\x27\x00\x04\x6f\xff\xff\xff\xfe\x22\x22\x22\x22" +
+		"\x20\x76\x00\x03\xe6\x00\x03\xd2\x01\x59\xa8\x20\x00\x8c\x99\xee\x10\x01\x00\x05\xf4\x00\x00\x00\x3f\x50\x01\x8c\xde\xf5\x00\xcc" +
+		"\x30\x3f\x50\x2f\x60\x03\xf5\x02\xfb\x23\xbf\x80\x08\xff\xd4\xaf\x70\x01\x20\x00\x20\x44\x00\x00\x00\xcb\x00\x00\x00\xcb\x00\x00" +
+		"\x00\xcb\x00\x00\x00\xcb\x16\x94\x00\xcb\xbd\xdf\x50\xcf\x90\x1d\xd0\xcd\x00\x08\xf1\xcb\x00\x07\xf3\xcb\x00\x08\xf1\xcb\x00\x0b" +
+		"\xe0\xcc\x11\x6f\x60\xcf\xff\xe7\x00\x10\x22\x00\x00\x00\x17\x99\x71\x03\xee\xa8\xb2\x0c\xe2\x00\x00\x2f\x90\x00\x00\x4f\x60\x00" +
+		"\x00\x3f\x70\x00\x00\x1f\xb0\x00\x00\x08\xf9\x22\x52\x00\x8f\xff\xd3\x00\x00\x21\x00\x00\x00\x00\x44\x00\x00\x00\xda\x00\x00\x00" +
+		"\xda\x00\x00\x00\xda\x00\x17\x99\xea\x03\xec\x89\xea\x0c\xd1\x00\xda\x2f\x80\x00\xda\x4f\x60\x00\xda\x4f\x60\x00\xda\x2f\x90\x04" +
+		"\xfa\x0b\xe5\x6d\xea\x02\xdf\xe4\xda\x00\x02\x00\x00\x00\x27\x97\x20\x04\xeb\x8c\xe2\x0d\xb0\x01\xda\x2f\x82\x22\xbd\x4f\xff\xff" +
+		"\xfe\x3f\x60\x00\x00\x1e\xb0\x00\x00\x07\xf9\x21\x36\x00\x6e\xff\xeb\x00\x00\x22\x00\x00\x27\x60\x2e\xcb\x07\xf0\x00\x9e\x00\x5c" +
+		"\xe7\x58\xdf\xa7\x09\xe0\x00\x9e\x00\x09\xe0\x00\x9e\x00\x09\xe0\x00\x9e\x00\x09\xe0\x00\x01\x79\x97\x50\x3e\xc8\x9e\xb0\xcd\x10" +
+		"\x0c\xb1\xf8\x00\x0c\xb3\xf6\x00\x0c\xb3\xf7\x00\x0d\xb1\xec\x00\x8f\xb0\x7f\xbb\xcc\xb0\x07\xb8\x1d\xa0\x00\x00\x0f\x82\x40\x01" +
+		"\xaf\x23\xef\xef\xe5\x00\x01\x42\x00\x04\x40\x00\x00\xcb\x00\x00\x0c\xb0\x00\x00\xcb\x00\x00\x0c\xb0\x59\x70\xcb\xad\xcf\x7c\xf9" +
+		"\x00\xdb\xcd\x00\x0b\xcc\xb0\x00\xbc\xcb\x00\x0b\xcc\xb0\x00\xbc\xcb\x00\x0b\xcc\xb0\x00\xbc\x99\xdc\x00\x65\xcb\xcb\xcb\xcb\xcb" +
+		"\xcb\xcb\xcb\x00\x7b\x00\x0a\xf0\x00\x00\x00\x04\x70\x00\x9e\x00\x09\xe0\x00\x9e\x00\x09\xe0\x00\x9e\x00\x09\xe0\x00\x9e\x00\x09" +
+		"\xe0\x00\x9d\x00\x0c\xb0\xae\xe3\x02\x31\x00\x44\x00\x00\x0c\xb0\x00\x00\xcb\x00\x00\x0c\xb0\x00\x00\xcb\x00\x46\x0c\xb0\x2e\x60" +
+		"\xcb\x0c\xb0\x0c\xb7\xe2\x00\xcd\xf8\x00\x0c\xb9\xe2\x00\xcb\x1d\xd1\x0c\xb0\x3e\xa0\xcb\x00\x6f\x74\x40\x0c\xb0\x0c\xb0\x0c\xb0" +
+		"\x0c\xb0\x0c\xb0\x0c\xb0\x0c\xb0\x0c\xb0\x0c\xb0\x0c\xc0\x0a\xe2\x02\xdf\x10\x02\x06\x51\x78\x30\x38\x82\x0c\xcc\xbd\xe4\xeb\xed" +
+		"\x0c\xf6\x03\xfe\x30\x7f\x2c\xc0\x01\xf8\x00\x5f\x3c\xb0\x01\xf7\x00\x5f\x3c\xb0\x01\xf7\x00\x5f\x3c\xb0\x01\xf7\x00\x5f\x3c\xb0" +
+		"\x01\xf7\x00\x5f\x3c\xb0\x01\xf7\x00\x5f\x36\x50\x59\x70\xcb\xad\xcf\x7c\xf9\x00\xdb\xcd\x00\x0b\xcc\xb0\x00\xbc\xcb\x00\x0b\xcc" +
+		"\xb0\x00\xbc\xcb\x00\x0b\xcc\xb0\x00\xbc\x00\x27\x97\x10\x00\x4e\xc8\xde\x30\x0d\xd0\x01\xdb\x02\xf8\x00\x09\xf1\x4f\x60\x00\x7f" +
+		"\x33\xf7\x00\x08\xf2\x1f\x90\x00\xbe\x00\x9f\x51\x6f\x70\x01\x9f\xff\x80\x00\x00\x12\x00\x00\x65\x16\x94\x00\xcb\xbd\xdf\x50\xcf" +
+		"\x90\x1d\xd0\xcd\x00\x08\xf1\xcb\x00\x07\xf3\xcb\x00\x08\xf1\xcb\x00\x0b\xe0\xcc\x11\x6f\x60\xcf\xff\xe7\x00\xcb\x22\x00\x00\xcb" +
+		"\x00\x00\x00\xcb\x00\x00\x00\x11\x00\x00\x00\x00\x17\x99\x75\x03\xec\x89\xea\x0c\xd1\x00\xda\x2f\x80\x00\xda\x4f\x60\x00\xda\x4f" +
+		"\x60\x00\xda\x2f\x90\x04\xfa\x0b\xe5\x6d\xea\x02\xdf\xe4\xda\x00\x02\x00\xda\x00\x00\x00\xda\x00\x00\x00\xda\x00\x00\x00\x11\x65" +
+		"\x28\x2c\xcc\xc3\xcf\x60\x0c\xc0\x00\xcb\x00\x0c\xb0\x00\xcb\x00\x0c\xb0\x00\xcb\x00\x00\x06\x9a\x83\x00\xae\x98\xb6\x00\xe9\x00" +
+		"\x00\x00\xce\x61\x00\x00\x3c\xff\x91\x00\x00\x49\xfb\x00\x00\x00\x9f\x11\x72\x02\xcd\x01\xcf\xff\xc3\x00\x00\x22\x00\x00\x44\x00" +
+		"\x0c\xb0\x06\xed\x74\x8e\xea\x60\xcb\x00\x0c\xb0\x00\xcb\x00\x0c\xb0\x00\xcb\x00\x0a\xd1\x00\x4f\xf2\x00\x12\x06\x50\x00\x65\xda" +
+		"\x00\x0d\xad\xa0\x00\xda\xda\x00\x0d\xad\xa0\x00\xda\xda\x00\x0d\xad\xa0\x04\xfa\xbd\x47\xde\xa4\xff\xd2\xda\x01\x20\x00\x06\x60" +
+		"\x00\x04\x67\xf1\x00\x0c\x82\xf6\x00\x3f\x20\xcc\x00\x9c\x00\x7f\x20\xe6\x00\x1f\x75\xf1\x00\x0b\xda\xa0\x00\x06\xff\x40\x00\x01" +
+		"\xfd\x00\x06\x50\x00\x75\x00\x07\x3a\xc0\x03\xfd\x00\x2f\x36\xf1\x08\xef\x20\x6d\x03\xf5\x0c\x6e\x60\xb9\x00\xe8\x2f\x1b\xa0\xe5" +
+		"\x00\xbc\x7c\x07\xe4\xf1\x00\x7f\xc7\x03\xfb\xc0\x00\x3f\xf2\x00\xef\x70\x00\x0e\xd0\x00\x9f\x30\x04\x72\x00\x07\x31\xeb\x00\x7d" +
+		"\x10\x5f\x62\xe5\x00\x0a\xeb\xb0\x00\x03\xff\x20\x00\x09\xdf\x60\x00\x4f\x2b\xe2\x00\xc8\x01\xeb\x07\xd1\x00\x5f\x66\x60\x00\x04" +
+		"\x67\xf1\x00\x0d\x82\xf6\x00\x3f\x20\xcc\x00\x9b\x00\x7f\x21\xe5\x00\x1f\x76\xe1\x00\x0b\xcc\x90\x00\x06\xff\x30\x00\x01\xfc\x00" +
+		"\x00\x01\xf6\x00\x00\x07\xf1\x00\x00\x0d\xa0\x00\x00\x01\x10\x00\x01\x77\x77\x77\x22\xaa\xaa\xef\x40\x00\x04\xfa\x00\x00\x1e\xd1" +
+		"\x00\x00\xce\x20\x00\x09\xf5\x00\x00\x5f\x80\x00\x03\xed\x32\x22\x16\xff\xff\xff\x60\x00\x32\x01\xce\x50\x6e\x10\x08\xd0\x00\x6f" +
+		"\x10\x03\xf1\x00\x4e\x00\x7d\x50\x07\xd6\x00\x04\xe0\x00\x3f\x10\x06\xf1\x00\x8d\x00\x06\xe1\x00\x1b\xf6\x00\x03\x23\x48\xb8\xb8" +
+		"\xb8\xb8\xb8\xb8\xb8\xb8\xb8\xb8\xb8\xb8\xb8\xb2\x30\x41\x00\x01\xde\x40\x00\x09\xc0\x00\x08\xd0\x00\x0a\xb0\x00\x0b\x80\x00\x08" +
+		"\x90\x00\x01\xda\x10\x02\xda\x10\x08\x90\x00\x0b\x80\x00\x0a\xb0\x00\x08\xd0\x00\x0a\xc0\x01\xee\x30\x00\x31\x00\x00\x6e\xd6\x00" +
+		"\x85\x0f\x65\xdc\x7e\x41\x80\x01\x7b\x70\x17\x32\xf7\x01\x00\x62\x0e\x40\xf5\x0f\x50\xf5\x1f\x61\xf6\x2f\x72\xf7\x01\x10\x00\x16" +
+		"\x00\x00\x01\xb0\x00\x1a\xef\xfc\x0c\xd5\xb2\x55\xf5\x1b\x00\x8f\x21\xb0\x09\xf1\x1b\x00\x7f\x31\xb0\x02\xea\x1b\x00\x06\xfc\xda" +
+		"\xc0\x03\x8d\x74\x00\x01\xb0\x00\x00\x29\xcb\x40\x00\xdc\x67\x40\x04\xf4\x00\x00\x06\xf2\x00\x00\x06\xf2\x00\x00\x9d\xfc\x90\x00" +
+		"\x5a\xf7\x50\x00\x06\xf2\x00\x00\x07\xf1\x00\x00\x0c\xa0\x00\x00\xbf\x65\x55\x31\xff\xff\xff\x81\x00\x00\x01\xc7\x47\x38\xa2\xec" +
+		"\x8d\xd1\x2e\x00\x1e\x13\xc0\x00\xc2\x0e\x50\x6d\x08\xca\xea\xd6\x71\x00\x01\x65\x81\x00\x01\x82\x3f\x90\x00\x9c\x00\x9f\x30\x3f" +
+		"\x30\x01\xeb\x0b\xa0\x00\x07\xf9\xe2\x00\x00\x0d\xf7\x00\x00\x9c\xdf\xcc\x50\x01\x18\xf2\x11\x00\x79\xcf\x99\x30\x03\x49\xf5\x42" +
+		"\x00\x00\x7f\x10\x00\x00\x07\xf1\x00\x03\x38\xa8\xa8\xa8\xa8\xa3\x40\x00\x04\x58\xa8\xa8\xa8\xa8\xa2\x30\x4a\xcb\x93\x6f\x96\x68" +
+		"\x5c\x90\x00\x00\xcd\x20\x00\x03\xee\x93\x00\x4d\x9e\xf9\x1c\x70\x18\xf8\xdb\x00\x0b\xb7\xfc\x40\xb7\x05\xdf\xdd\x00\x00\x5c\xf5" +
+		"\x00\x00\x0b\xc3\x00\x00\xab\xfd\xa9\xde\x42\x68\x86\x10\x34\x03\x58\xc0\x7d\x00\x01\x47\x74\x00\x00\x00\x5c\x85\x59\xc3\x00\x07" +
+		"\xa1\x00\x00\x2c\x40\x2c\x01\x9c\xbd\x02\xc1\x85\x09\x90\x00\x00\x75\xb1\x0e\x20\x00\x00\x48\xc0\x0f\x10\x00\x00\x39\x93\x0c\x50" +
+		"\x00\x00\x67\x59\x04\xd7\x69\x00\xb3\x0b\x40\x26\x74\x07\x80\x01\xb8\x10\x02\xaa\x00\x00\x07\xbc\xca\x50\x00\x07\xbc\x40\x03\x16" +
+		"\xc0\x04\x8b\xd0\x2e\x45\xd0\x2e\x6a\xe2\x04\x72\x74\x00\x04\x50\x36\x00\x2e\x42\xd5\x02\xd7\x1d\x80\x0c\xa0\xbc\x00\x06\xe2\x5e" +
+		"\x30\x00\x8c\x07\xd1\x00\x0a\x70\x89\x00\x00\x00\x01\x5e\xee\xee\xee\x51\x33\x33\x33\xc6\x00\x00\x00\x0c\x60\x00\x00\x00\xc6\x00" +
+		"\x00\x00\x08\x41\x33\x32\x4e\xee\x90\x00\x14\x77\x40\x00\x00\x05\xc8\x55\x9c\x30\x00\x7a\x10\x00\x02\xc4\x02\xc0\x0d\xac\x50\x2c" +
+		"\x18\x50\x0e\x04\xc0\x07\x5b\x10\x0e\x18\x90\x04\x8c\x00\x0f\xce\x10\x03\x99\x30\x0f\x0b\x70\x06\x75\x90\x0f\x02\xe2\x0b\x30\xb4" +
+		"\x05\x00\x33\x78\x00\x1b\x81\x00\x2a\xa0\x00\x00\x7b\xcc\xa5\x00\x02\x88\x88\x88\x81\x29\x99\x99\x99\x10\x2a\xc6\x00\xd7\x4d\x41" +
+		"\xe0\x08\x70\xc8\x5d\x30\x29\xb5\x00\x00\x03\x10\x00\x00\x00\xe4\x00\x00\x00\x0e\x40\x00\x15\x55\xe8\x55\x32\xcc\xcf\xdc\xc6\x00" +
+		"\x00\xe4\x00\x00\x00\x0e\x40\x00\x00\x00\x93\x00\x00\x22\x22\x22\x21\x3f\xff\xff\xff\x80\x36\x73\x02\x73\x5f\x30\x00\x0c\x70\x00" +
+		"\x3e\x20\x04\xc3\x00\x4c\x10\x03\xe6\x33\x24\x99\x99\x40\x57\x73\x00\x74\x5f\x30\x00\x0d\x30\x28\xb5\x00\x14\x7c\x20\x00\x0a\x90" +
+		"\x30\x2e\x61\x9b\xa5\x00\x04\x82\x02\xe7\x00\xb7\x00\x65\x00\x06\x5c\xb0\x00\xcb\xcb\x00\x0c\xbc\xb0\x00\xcb\xcb\x00\x0c\xbc\xb0" +
+		"\x00\xcb\xcb\x00\x4f\xbc\xe4\x6d\xeb\xce\xfd\x3c\xbc\xb1\x00\x00\xcb\x00\x00\x0c\xb0\x00\x00\x11\x00\x00\x00\x15\x88\x88\x0c\xff" +
+		"\xd6\xe2\xff\xfc\x0d\x2f\xff\xc0\xd0\xcf\xfc\x0d\x02\xcf\xc0\xd0\x00\x3c\x0d\x00\x01\xc0\xd0\x00\x1c\x0d\x00\x01\xc0\xd0\x00\x1c" +
+		"\x0d\x00\x01\xc0\xd0\x00\x1c\x0d\x00\x01\xc0\xd0\x00\x04\x04\x67\x1c\xf2\x57\x11\xb0\x3a\xa4\x7c\x35\x10\x36\x20\x08\x8e\x40\x00" +
+		"\x0e\x40\x00\x0e\x40\x00\x0e\x40\x00\x0e\x40\x01\x2e\x52\x07\x99\x99\x10\x5c\xb3\x02\xf3\x5e\x16\xd0\x1f\x35\xe0\x1f\x31\xe7\x9c" +
+		"\x00\x28\x71\x06\x20\x63\x00\x6d\x15\xd2\x00\x9c\x18\xc1\x00\xda\x0c\xb0\x4e\x43\xe5\x2e\x61\xd7\x0a\x70\x98\x00\x10\x01\x00\x00" +
+		"\x37\x60\x00\x00\x4a\x00\x1a\xc9\x00\x00\x1d\x20\x00\x08\x90\x00\x0a\x60\x00\x00\x89\x00\x05\xa0\x00\x00\x08\x90\x02\xd1\x00\x00" +
+		"\x00\x89\x00\xb5\x01\xd6\x00\x08\x90\x79\x00\xbd\x60\x00\x33\x3c\x10\x87\xa6\x00\x00\x0c\x30\x4b\x0a\x60\x00\x08\x80\x0c\xdc\xed" +
+		"\x50\x04\xc0\x00\x12\x2a\x71\x01\xd2\x00\x00\x00\xa6\x00\x23\x00\x00\x00\x00\x00\x03\x76\x00\x00\x09\x50\x01\xac\x90\x00\x05\xb0" +
+		"\x00\x00\x89\x00\x02\xd1\x00\x00\x08\x90\x00\xb5\x00\x00\x00\x89\x00\x79\x00\x10\x00\x08\x90\x3d\x1a\xbd\xa0\x00\x89\x0c\x30\x10" +
+		"\x0e\x50\x03\x38\x80\x00\x01\xf2\x00\x04\xc0\x00\x00\xb7\x00\x01\xd2\x00\x00\xb7\x00\x00\xa6\x00\x00\x99\x00\x00\x5b\x00\x00\x0f" +
+		"\xfe\xe5\x04\x10\x00\x00\x00\x00\x00\x8b\xa3\x00\x00\x08\x60\x04\x18\xc0\x00\x04\xc0\x00\x00\x7b\x00\x01\xd2\x00\x05\xcc\x10\x00" +
+		"\x97\x00\x00\x02\x9b\x00\x5b\x00\x00\x00\x02\xf1\x2d\x21\xc7\x01\x75\xac\x0b\x50\xad\x80\x16\x86\x16\xa0\x69\x88\x00\x00\x02\xd1" +
+		"\x3b\x08\x80\x00\x00\xc4\x0a\xdc\xee\x60\x00\x88\x00\x12\x29\x91\x00\x3c\x00\x00\x00\x88\x00\x03\x20\x00\x00\x00\x00\x00\x01\x73" +
+		"\x00\x00\x02\xf7\x00\x00\x00\x10\x00\x00\x01\x63\x00\x00\x02\xf5\x00\x00\x04\xf2\x00\x00\x2d\x70\x00\x04\xe7\x00\x00\x3f\x80\x00" +
+		"\x00\x7f\x30\x00\x00\x5f\x70\x00\x21\x1a\xfd\xce\xf4\x00\x24\x53\x10\x00\x02\x10\x00\x00\x00\x00\x8e\x20\x00\x00\x00\x00\x8c\x00" +
+		"\x00\x00\x00\x00\x52\x00\x00\x00\x00\x58\x20\x00\x00\x00\x0d\xf9\x00\x00\x00\x04\xfe\xe0\x00\x00\x00\x9d\x7f\x50\x00\x00\x1e\x81" +
+		"\xfa\x00\x00\x06\xf2\x0b\xf1\x00\x00\xbb\x00\x5f\x60\x00\x2f\xa6\x67\xfc\x00\x08\xfc\xcc\xcd\xf2\x00\xd9\x00\x00\x3f\x80\x4f\x40" +
+		"\x00\x00\xdd\x0a\xd0\x00\x00\x07\xf4\x00\x00\x00\x21\x00\x00\x00\x00\x6f\x40\x00\x00\x00\x3e\x40\x00\x00\x00\x04\x30\x00\x00\x00" +
+		"\x00\x58\x20\x00\x00\x00\x0d\xf8\x00\x00\x00\x03\xfe\xe0\x00\x00\x00\x9d\x7f\x50\x00\x00\x1e\x81\xfa\x00\x00\x06\xf2\x0b\xf1\x00" +
+		"\x00\xbb\x00\x5f\x60\x00\x2f\xa6\x67\xfc\x00\x08\xfc\xcc\xcd\xf2\x00\xd9\x00\x00\x3f\x80\x4f\x40\x00\x00\xdd\x0a\xd0\x00\x00\x07" +
+		"\xf4\x00\x00\x12\x00\x00\x00\x00\x1d\xea\x00\x00\x00\x0b\xa2\xd6\x00\x00\x01\x50\x01\x50\x00\x00\x00\x58\x20\x00\x00\x00\x0d\xf9" +
+		"\x00\x00\x00\x04\xfe\xe0\x00\x00\x00\x9d\x7f\x50\x00\x00\x1e\x81\xfa\x00\x00\x06\xf2\x0b\xf1\x00\x00\xbb\x00\x5f\x60\x00\x2f\xa6" +
+		"\x67\xfc\x00\x08\xfc\xcc\xcd\xf2\x00\xd9\x00\x00\x3f\x80\x4f\x40\x00\x00\xdd\x0a\xd0\x00\x00\x07\xf4\x00\x04\x72\x18\x00\x00\x01" +
+		"\xe7\xdd\x90\x00\x00\x13\x00\x20\x00\x00\x00\x05\x82\x00\x00\x00\x00\xdf\x80\x00\x00\x00\x3f\xee\x00\x00\x00\x09\xd7\xf5\x00\x00" +
+		"\x01\xe8\x1f\xa0\x00\x00\x6f\x20\xbf\x10\x00\x0b\xb0\x05\xf6\x00\x02\xfa\x66\x7f\xc0\x00\x8f\xcc\xcc\xdf\x20\x0d\x90\x00\x03\xf8" +
+		"\x04\xf4\x00\x00\x0d\xd0\xad\x00\x00\x00\x7f\x40\x00\x21\x01\x10\x00\x00\x0d\x70\xc9\x00\x00\x00\x32\x03\x20\x00\x00\x00\x58\x20" +
+		"\x00\x00\x00\x0d\xf8\x00\x00\x00\x03\xfe\xe0\x00\x00\x00\x9d\x7f\x50\x00\x00\x1e\x81\xfa\x00\x00\x06\xf2\x0b\xf1\x00\x00\xbb\x00" +
+		"\x5f\x60\x00\x2f\xa6\x67\xfc\x00\x08\xfc\xcc\xcd\xf2\x00\xd9\x00\x00\x3f\x80\x4f\x40\x00\x00\xdd\x0a\xd0\x00\x00\x07\xf4\x00\x00" +
+		"\x01\x00\x00\x00\x00\x0a\xa8\x00\x00\x00\x04\x60\x91\x00\x00\x00\x1b\x5b\x00\x00\x00\x00\x7e\x30\x00\x00\x00\x0d\xf9\x00\x00\x00" +
+		"\x04\xfe\xe0\x00\x00\x00\x9d\x7f\x50\x00\x00\x1e\x81\xfa\x00\x00\x06\xf2\x0b\xf1\x00\x00\xbb\x00\x5f\x60\x00\x2f\xa6\x67\xfc\x00" +
+		"\x08\xfc\xcc\xcd\xf2\x00\xd9\x00\x00\x3f\x80\x4f\x40\x00\x00\xdd\x0a\xd0\x00\x00\x07\xf4\x00\x00\x00\x06\x88\x88\x88\x82\x00\x00" +
+		"\x00\x3f\xfd\xaa\xaa\xa2\x00\x00\x00\xcd\xf9\x00\x00\x00\x00\x00\x07\xf5\xf9\x00\x00\x00\x00\x00\x2e\x91\xf9\x00\x00\x00\x00\x00" +
+		"\xbe\x11\xfd\x99\x99\x40\x00\x05\xf5\x01\xfc\x99\x99\x40\x00\x1e\xe7\x77\xf9\x00\x00\x00\x00\x9e\xbb\xbc\xf9\x00\x00\x00\x04\xf6" +
+		"\x00\x01\xf9\x00\x00\x00\x0d\xc0\x00\x01\xfa\x33\x33\x32\x8f\x30\x00\x01\xff\xff\xff\xf8\x00\x01\x7a\xcc\xb8\x30\x05\xee\x96\x79" +
+		"\xd8\x02\xec\x20\x00\x00\x10\x9f\x40\x00\x00\x00\x0d\xd0\x00\x00\x00\x00\xfb\x00\x00\x00\x00\x1f\xb0\x00\x00\x00\x00\xed\x00\x00" +
+		"\x00\x00\x0c\xf2\x00\x00\x00\x00\x5f\xa0\x00\x00\x00\x00\xbf\xb4\x11\x39\x70\x00\x8e\xff\xff\xc4\x00\x00\x06\x93\x10\x00\x00\x00" +
+		"\x4b\x70\x00\x00\x00\x05\x99\x00\x00\x00\x00\x44\x00\x00\x00\x12\x00\x00\x00\x00\x2e\x80\x00\x00\x00\x02\xe5\x00\x00\x00\x00\x25" +
+		"\x00\x00\x48\x88\x88\x88\x70\x8f\xba\xaa\xaa\x90\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\xa9\x99\x99\x10" +
+		"\x8f\x99\x99\x99\x10\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\x54\x44\x44\x41\x8f\xff\xff\xff\xf3\x00\x00" +
+		"\x01\x20\x00\x00\x00\x1d\xa0\x00\x00\x00\xaa\x00\x00\x00\x01\x60\x00\x00\x48\x88\x88\x88\x70\x8f\xba\xaa\xaa\x90\x8f\x20\x00\x00" +
+		"\x00\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\xa9\x99\x99\x10\x8f\x99\x99\x99\x10\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f" +
+		"\x20\x00\x00\x00\x8f\x54\x44\x44\x41\x8f\xff\xff\xff\xf3\x00\x00\x21\x00\x00\x00\x07\xef\x30\x00\x00\x4e\x37\xd1\x00\x00\x43\x00" +
+		"\x52\x00\x48\x88\x88\x88\x70\x8f\xaa\xaa\xaa\x90\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\xa9\x99\x99\x10" +
+		"\x8f\x99\x99\x99\x10\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\x53\x33\x33\x31\x8f\xff\xff\xff\xf3\x00\x12" +
+		"\x01\x20\x00\x00\x7d\x06\xe0\x00\x00\x23\x01\x30\x00\x48\x88\x88\x88\x70\x8f\xba\xaa\xaa\x90\x8f\x20\x00\x00\x00\x8f\x20\x00\x00" +
+		"\x00\x8f\x20\x00\x00\x00\x8f\xa9\x99\x99\x10\x8f\x99\x99\x99\x10\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f\x20\x00\x00\x00\x8f" +
+		"\x54\x44\x44\x41\x8f\xff\xff\xff\xf3\x02\x10\x00\x0a\xd1\x00\x00\xaa\x00\x00\x06\x10\x08\x88\x84\x0a\xdf\xa4\x00\x9f\x00\x00\x9f" +
+		"\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x03\xbf\x41\x0f\xff\xf6\x00\x00\x21\x00\x08\xe2\x00" +
+		"\x5e\x20\x00\x52\x00\x08\x88\x84\x0a\xdf\xa4\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00" +
+		"\x00\x9f\x00\x03\xbf\x41\x0f\xff\xf6\x00\x12\x00\x02\xee\x80\x1c\x83\xe4\x25\x00\x25\x08\x88\x84\x0a\xdf\xa4\x00\x9f\x00\x00\x9f" +
+		"\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x04\xbf\x41\x0f\xff\xf6\x02\x10\x21\x0f\x50\xe6\x04" +
+		"\x10\x31\x08\x88\x84\x0a\xdf\xa4\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00\x00\x9f\x00" +
+		"\x04\xbf\x41\x0f\xff\xf6\x05\x88\x88\x63\x00\x00\x9f\xaa\xbd\xfb\x20\x09\xf0\x00\x07\xfd\x00\x9f\x00\x00\x08\xf7\x09\xf0\x00\x00" +
+		"\x1f\xa7\xcf\x88\x50\x00\xed\x9d\xfa\xa6\x00\x0d\xc0\x9f\x00\x00\x00\xfa\x09\xf0\x00\x00\x4f\x70\x9f\x00\x00\x1c\xd1\x09\xf4\x45" +
+		"\x7d\xe4\x00\x9f\xff\xec\x81\x00\x00\x17\x61\x44\x00\x00\x6b\x9e\xe4\x00\x00\x21\x01\x10\x00\x68\x10\x00\x01\x82\xbf\x90\x00\x02" +
+		"\xf4\xbf\xf4\x00\x02\xf4\xbc\xed\x10\x02\xf4\xbb\x5f\x90\x02\xf4\xbb\x0a\xf4\x02\xf4\xbb\x01\xed\x12\xf4\xbb\x00\x5f\x92\xf4\xbb" +
+		"\x00\x0a\xf6\xf4\xbb\x00\x01\xee\xf4\xbb\x00\x00\x5f\xf4\xbb\x00\x00\x0a\xf4\x00\x00\x21\x00\x00\x00\x00\x00\xad\x10\x00\x00\x00" +
+		"\x00\x0a\xb0\x00\x00\x00\x00\x00\x61\x00\x00\x00\x02\x8b\xc9\x60\x00\x00\x6e\xc8\x7a\xfb\x10\x03\xfa\x00\x00\x4f\xa0\x0b\xf1\x00" +
+		"\x00\x09\xf3\x0f\xa0\x00\x00\x04\xf7\x2f\x90\x00\x00\x02\xf9\x3f\x80\x00\x00\x01\xfa\x1f\xa0\x00\x00\x03\xf8\x0e\xd0\x00\x00\x06" +
+		"\xf6\x06\xf5\x00\x00\x0d\xd0\x00\xcf\x61\x03\xcf\x40\x00\x08\xff\xff\xc3\x00\x00\x00\x03\x31\x00\x00\x00\x00\x00\x02\x10\x00\x00" +
+		"\x00\x00\x8e\x30\x00\x00\x00\x04\xe3\x00\x00\x00\x00\x05\x20\x00\x00\x00\x02\x8b\xc9\x60\x00\x00\x6e\xc8\x7a\xfb\x10\x03\xfa\x00" +
+		"\x00\x4f\xa0\x0b\xf1\x00\x00\x09\xf3\x0f\xa0\x00\x00\x04\xf7\x2f\x90\x00\x00\x02\xf9\x3f\x80\x00\x00\x01\xfa\x1f\xa0\x00\x00\x03" +
+		"\xf8\x0e\xd0\x00\x00\x06\xf6\x06\xf5\x00\x00\x0d\xd0\x00\xcf\x61\x03\xcf\x40\x00\x08\xff\xff\xc3\x00\x00\x00\x03\x31\x00\x00\x00" +
+		"\x00\x01\x20\x00\x00\x00\x00\x2e\xe8\x00\x00\x00\x00\xc8\x3e\x40\x00\x00\x02\x50\x02\x50\x00\x00\x02\x8b\xc9\x60\x00\x00\x6e\xc8" +
+		"\x7a\xfb\x10\x03\xfa\x00\x00\x4f\xa0\x0b\xf1\x00\x00\x09\xf3\x0f\xa0\x00\x00\x04\xf7\x2f\x90\x00\x00\x02\xf9\x3f\x80\x00\x00\x01" +
+		"\xfa\x1f\xa0\x00\x00\x03\xf8\x0e\xd0\x00\x00\x06\xf6\x06\xf5\x00\x00\x0d\xd0\x00\xcf\x61\x03\xcf\x40\x00\x08\xff\xff\xc3\x00\x00" +
+		"\x00\x03\x31\x00\x00\x00\x00\x57\x22\x70\x00\x00\x02\xd8\xed\x70\x00\x00\x01\x20\x02\x00\x00\x00\x02\x8b\xc9\x60\x00\x00\x6e\xc8" +
+		"\x7a\xfb\x10\x03\xfa\x00\x00\x4f\xa0\x0b\xf1\x00\x00\x09\xf3\x0f\xa0\x00\x00\x04\xf7\x2f\x90\x00\x00\x02\xf9\x3f\x80\x00\x00\x01" +
+		"\xfa\x1f\xa0\x00\x00\x03\xf8\x0e\xd0\x00\x00\x06\xf6\x06\xf5\x00\x00\x0d\xd0\x00\xcf\x61\x03\xcf\x40\x00\x08\xff\xff\xc3\x00\x00" +
+		"\x00\x03\x31\x00\x00\x00\x00\x21\x02\x10\x00\x00\x00\xf5\x0e\x70\x00\x00\x00\x41\x03\x20\x00\x00\x02\x8b\xc9\x60\x00\x00\x6e\xc8" +
+		"\x7a\xfb\x10\x03\xfa\x00\x00\x4f\xa0\x0b\xf1\x00\x00\x09\xf3\x0f\xa0\x00\x00\x04\xf7\x2f\x90\x00\x00\x02\xf9\x3f\x80\x00\x00\x01" +
+		"\xfa\x1f\xa0\x00\x00\x03\xf8\x0e\xd0\x00\x00\x06\xf6\x06\xf5\x00\x00\x0d\xd0\x00\xcf\x61\x03\xcf\x40\x00\x08\xff\xff\xc3\x00\x00" +
+		"\x00\x03\x31\x00\x00\x03\x00\x00\x03\x00\xd9\x00\x04\xe4\x02\xd9\x04\xf6\x00\x02\xdb\xf6\x00\x00\x06\xfb\x00\x00\x04\xe7\xd9\x00" +
+		"\x04\xf6\x02\xd9\x00\xd6\x00\x02\xd4\x01\x00\x00\x01\x00\x00\x28\xbc\xa5\x1b\x50\x06\xec\x86\xaf\xea\x00\x3f\xa0\x00\x09\xfa\x00" +
+		"\xbe\x10\x00\x4e\xaf\x30\xfa\x00\x02\xe5\x3f\x72\xf9\x00\x0c\x80\x2f\x93\xf8\x00\x9b\x00\x1f\xa1\xf9\x06\xd1\x00\x3f\x80\xed\x3e" +
+		"\x30\x00\x6f\x60\x6f\xe6\x00\x00\xdd\x00\x1e\xf6\x10\x3c\xf4\x00\x8b\x8e\xff\xfc\x30\x00\x41\x01\x34\x10\x00\x00\x01\x20\x00\x00" +
+		"\x00\x02\xd9\x00\x00\x00\x00\x2d\x50\x00\x00\x00\x02\x50\x00\x06\x80\x00\x00\x28\x2b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0" +
+		"\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4a\xe0\x00\x00\x3f\x49\xf0\x00\x00\x4f\x37\xf3\x00\x00" +
+		"\x7f\x11\xed\x30\x04\xea\x00\x3c\xff\xff\xa0\x00\x00\x24\x31\x00\x00\x00\x00\x12\x10\x00\x00\x01\xcb\x10\x00\x00\x09\xb1\x00\x00" +
+		"\x00\x16\x10\x00\x06\x80\x00\x00\x28\x2b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00" +
+		"\x00\x3f\x4b\xe0\x00\x00\x3f\x4a\xe0\x00\x00\x3f\x49\xf0\x00\x00\x4f\x37\xf3\x00\x00\x7f\x11\xed\x30\x04\xea\x00\x3c\xff\xff\xa0" +
+		"\x00\x00\x24\x31\x00\x00\x00\x02\x10\x00\x00\x00\x7f\xf3\x00\x00\x03\xe4\x6d\x10\x00\x04\x30\x04\x30\x06\x80\x00\x00\x28\x2b\xe0" +
+		"\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4a\xe0\x00\x00" +
+		"\x3f\x49\xf0\x00\x00\x4f\x37\xf3\x00\x00\x7f\x11\xed\x30\x04\xea\x00\x3c\xff\xff\xa0\x00\x00\x24\x31\x00\x00\x01\x20\x02\x00\x00" +
+		"\x05\xf0\x4f\x10\x00\x01\x40\x14\x00\x06\x80\x00\x00\x28\x2b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00" +
+		"\x00\x3f\x4b\xe0\x00\x00\x3f\x4b\xe0\x00\x00\x3f\x4a\xe0\x00\x00\x3f\x49\xf0\x00\x00\x4f\x37\xf3\x00\x00\x7f\x11\xed\x30\x04\xea" +
+		"\x00\x3c\xff\xff\xa0\x00\x00\x24\x31\x00\x00\x00\x00\x02\x20\x00\x00\x00\x05\xf5\x00\x00\x00\x02\xe5\x00\x00\x00\x00\x44\x00\x00" +
+		"\x05\x82\x00\x00\x02\x82\x2f\xb0\x00\x00\xcb\x00\x7f\x60\x00\x6e\x20\x00\xde\x10\x2e\x70\x00\x04\xf9\x0b\xc0\x00\x00\x0a\xf9\xf2" +
+		"\x00\x00\x00\x1e\xf7\x00\x00\x00\x00\x9f\x10\x00\x00\x00\x09\xf0\x00\x00\x00\x00\x9f\x00\x00\x00\x00\x09\xf0\x00\x00\x00\x00\x9f" +
+		"\x00\x00\x06\x80\x00\x00\x00\x0a\xe0\x00\x00\x00\x0a\xf6\x66\x55\x20\x0a\xfd\xdd\xef\xf7\x0a\xe0\x00\x02\xdf\x2a\xe0\x00\x00\x7f" +
+		"\x4a\xe0\x00\x00\x9f\x2a\xe0\x00\x04\xed\x0a\xfa\xaa\xdf\xd3\x0a\xf9\x99\x75\x00\x0a\xe0\x00\x00\x00\x0a\xe0\x00\x00\x00\x00\x37" +
+		"\x75\x10\x00\x6f\xbb\xed\x00\x0c\xa0\x06\xf3\x00\xe8\x00\x7e\x10\x0f\x80\x1e\x60\x00\xf8\x09\xa0\x00\x0f\x80\xdb\x00\x00\xf8\x07" +
+		"\xfb\x10\x0f\x80\x06\xfd\x20\xf8\x00\x03\xec\x0f\x80\x00\x07\xf1\xf8\x25\x11\xae\x0f\x82\xdf\xfe\x50\x00\x00\x12\x00\x00\x06\x80" +
+		"\x00\x00\x00\x1d\x90\x00\x00\x00\x1c\x50\x00\x00\x00\x00\x00\x00\x15\x9a\x82\x00\x08\xc9\x9e\xe1\x00\x10\x00\x5f\x40\x00\x00\x03" +
+		"\xf5\x00\x18\xcd\xef\x50\x0c\xc3\x03\xf5\x02\xf6\x00\x3f\x50\x2f\xb2\x3b\xf8\x00\x8f\xfd\x4a\xf7\x00\x12\x00\x02\x00\x00\x00\x76" +
+		"\x00\x00\x00\x8d\x20\x00\x00\x4d\x20\x00\x00\x00\x00\x00\x00\x15\x9a\x82\x00\x08\xc9\x9e\xe1\x00\x10\x00\x5f\x40\x00\x00\x03\xf5" +
+		"\x00\x18\xcd\xef\x50\x0c\xc3\x03\xf5\x02\xf6\x00\x3f\x50\x2f\xb2\x3b\xf8\x00\x8f\xfd\x4a\xf7\x00\x12\x00\x02\x00\x00\x48\x50\x00" +
+		"\x00\x2e\xae\x30\x00\x0c\x60\x6c\x10\x00\x00\x00\x00\x00\x15\x9a\x82\x00\x08\xc9\x9e\xe1\x00\x10\x00\x5f\x40\x00\x00\x03\xf5\x00" +
+		"\x18\xcd\xef\x50\x0c\xc3\x03\xf5\x02\xf6\x00\x3f\x50\x2f\xb2\x3b\xf8\x00\x8f\xfd\x4a\xf7\x00\x12\x00\x02\x00\x0a\xc7\x5a\x00\x03" +
+		"\xb2\x9c\x40\x00\x00\x00\x00\x00\x01\x59\xa8\x20\x00\x8c\x99\xee\x10\x01\x00\x05\xf4\x00\x00\x00\x3f\x50\x01\x8c\xde\xf5\x00\xcc" +
+		"\x30\x3f\x50\x2f\x60\x03\xf5\x02\xfb\x23\xbf\x80\x08\xff\xd4\xaf\x70\x01\x20\x00\x20\x00\x63\x05\x40\x00\x0b\x70\xa8\x00\x00\x00" +
+		"\x00\x00\x00\x15\x9a\x82\x00\x08\xc9\x9e\xe1\x00\x10\x00\x5f\x40\x00\x00\x03\xf5\x00\x18\xcd\xef\x50\x0c\xc3\x03\xf5\x02\xf6\x00" +
+		"\x3f\x50\x2f\xb2\x3b\xf8\x00\x8f\xfd\x4a\xf7\x00\x12\x00\x02\x00\x00\x38\x30\x00\x00\x0a\x3a\x10\x00\x02\x80\x82\x00\x00\x07\xa8" +
+		"\x00\x00\x00\x00\x00\x00\x01\x59\xa8\x20\x00\x8c\x99\xee\x10\x01\x00\x05\xf4\x00\x00\x00\x3f\x50\x01\x8c\xde\xf5\x00\xcc\x30\x3f" +
+		"\x50\x2f\x60\x03\xf5\x02\xfb\x23\xbf\x80\x08\xff\xd4\xaf\x70\x01\x20\x00\x20\x01\x58\xa8\x30\x69\x84\x00\x08\xc9\x8c\xfd\xd8\xaf" +
+		"\x60\x01\x00\x01\xfe\x10\x09\xe1\x00\x00\x00\xfb\x22\x26\xf3\x01\x7c\xdd\xff\xff\xff\xf4\x0c\xc3\x00\xfa\x00\x00\x00\x2f\x60\x00" +
+		"\xfe\x10\x00\x00\x2f\xb3\x39\xae\xb3\x12\x52\x07\xff\xe7\x03\xcf\xff\xd3\x00\x12\x00\x00\x01\x20\x00\x00\x17\x99\x71\x03\xee\xa8" +
+		"\xb2\x0c\xe2\x00\x00\x2f\x90\x00\x00\x4f\x60\x00\x00\x3f\x70\x00\x00\x1f\xb0\x00\x00\x08\xf9\x22\x52\x00\x8f\xff\xd3\x00\x02\xb1" +
+		"\x00\x00\x02\xaa\x00\x00\x04\x7d\x00\x00\x03\x51\x00\x00\x67\x00\x00\x00\x2d\x80\x00\x00\x02\xd4\x00\x00\x00\x00\x00\x00\x27\x97" +
+		"\x20\x04\xeb\x8c\xe2\x0d\xb0\x01\xda\x2f\x82\x22\xbd\x4f\xff\xff\xfe\x3f\x60\x00\x00\x1e\xb0\x00\x00\x07\xf9\x21\x36\x00\x6e\xff" +
+		"\xeb\x00\x00\x22\x00\x00\x00\x08\x60\x00\x00\x9d\x10\x00\x05\xc1\x00\x00\x00\x00\x00\x00\x27\x97\x20\x04\xeb\x8c\xe2\x0d\xb0\x01" +
+		"\xda\x2f\x82\x22\xbd\x4f\xff\xff\xfe\x3f\x60\x00\x00\x1e\xb0\x00\x00\x07\xf9\x21\x36\x00\x6e\xff\xeb\x00\x00\x22\x00\x00\x04\x85" +
+		"\x00\x00\x2e\xae\x30\x00\xc7\x05\xc1\x00\x00\x00\x00\x00\x27\x97\x20\x04\xeb\x8c\xe2\x0d\xb0\x01\xda\x2f\x82\x22\xbd\x4f\xff\xff" +
+		"\xfe\x3f\x60\x00\x00\x1e\xb0\x00\x00\x07\xf9\x21\x36\x00\x6e\xff\xeb\x00\x00\x22\x00\x00\x55\x04\x50\x00\x99\x08\xa0\x00\x00\x00" +
+		"\x00\x00\x27\x97\x20\x04\xeb\x8c\xe2\x0d\xb0\x01\xda\x2f\x82\x22\xbd\x4f\xff\xff\xfe\x3f\x60\x00\x00\x1e\xb0\x00\x00\x07\xf9\x21" +
+		"\x36\x00\x6e\xff\xeb\x00\x00\x22\x00\x18\x50\x00\x5e\x40\x00\x4d\x10\x00\x00\x00\x65\x00\x0c\xb0\x00\xcb\x00\x0c\xb0\x00\xcb\x00" +
+		"\x0c\xb0\x00\xcb\x00\x0c\xb0\x00\xcb\x00\x06\x70\x04\xe4\x02\xd4\x00\x00\x00\x00\x65\x00\x0c\xb0\x00\xcb\x00\x0c\xb0\x00\xcb\x00" +
+		"\x0c\xb0\x00\xcb\x00\x0c\xb0\x00\xcb\x00\x01\x88\x00\x0a\xcc\x90\x6c\x11\xc5\x00\x00\x00\x00\x65\x00\x00\xcb\x00\x00\xcb\x00\x00" +
+		"\xcb\x00\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x27\x11\x71\x3d\x12\xd2\x00\x00\x00\x00\x65\x00\x00\xcb\x00" +
+		"\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x00\xcb\x00\x14\x23\x60\x00\x02\xdf\xf7\x00\x00\x00\x6b" +
+		"\xe8\x00\x00\x07\x12\xd8\x00\x00\x15\x77\xf3\x00\x3d\xeb\xef\x90\x0c\xd1\x02\xee\x02\xf7\x00\x09\xf1\x4f\x50\x00\x7f\x34\xf6\x00" +
+		"\x08\xf2\x1f\x90\x00\xae\x00\x9f\x41\x5f\x70\x01\x9f\xff\x80\x00\x00\x02\x00\x00\x08\xd8\x3c\x01\xc2\x8c\x50\x00\x00\x00\x06\x50" +
+		"\x59\x70\xcb\xad\xcf\x7c\xf9\x00\xdb\xcd\x00\x0b\xcc\xb0\x00\xbc\xcb\x00\x0b\xcc\xb0\x00\xbc\xcb\x00\x0b\xcc\xb0\x00\xbc\x00\x67" +
+		"\x00\x00\x00\x02\xd7\x00\x00\x00\x02\xd4\x00\x00\x00\x00\x00\x00\x00\x27\x97\x10\x00\x4e\xc8\xde\x30\x0d\xd0\x01\xdb\x02\xf8\x00" +
+		"\x09\xf1\x4f\x60\x00\x7f\x33\xf7\x00\x08\xf2\x1f\x90\x00\xbe\x00\x9f\x51\x6f\x70\x01\x9f\xff\x80\x00\x00\x12\x00\x00\x00\x00\x08" +
+		"\x50\x00\x00\x09\xd1\x00\x00\x05\xc1\x00\x00\x00\x00\x00\x00\x00\x27\x97\x10\x00\x4e\xc8\xde\x30\x0d\xd0\x01\xdb\x02\xf8\x00\x09" +
+		"\xf1\x4f\x60\x00\x7f\x33\xf7\x00\x08\xf2\x1f\x90\x00\xbe\x00\x9f\x51\x6f\x70\x01\x9f\xff\x80\x00\x00\x12\x00\x00\x00\x05\x84\x00" +
+		"\x00\x03\xea\xe2\x00\x01\xc5\x07\xc0\x00\x00\x00\x00\x00\x00\x27\x97\x10\x00\x4e\xc8\xde\x30\x0d\xd0\x01\xdb\x02\xf8\x00\x09\xf1" +
+		"\x4f\x60\x00\x7f\x33\xf7\x00\x08\xf2\x1f\x90\x00\xbe\x00\x9f\x51\x6f\x70\x01\x9f\xff\x80\x00\x00\x12\x00\x00\x00\x8d\x83\xd0\x00" +
+		"\x1c\x28\xc6\x00\x00\x00\x00\x00\x00\x02\x79\x71\x00\x04\xec\x8d\xe3\x00\xdd\x00\x1d\xb0\x2f\x80\x00\x9f\x14\xf6\x00\x07\xf3\x3f" +
+		"\x70\x00\x8f\x21\xf9\x00\x0b\xe0\x09\xf5\x16\xf7\x00\x19\xff\xf8\x00\x00\x01\x20\x00\x00\x05\x40\x55\x00\x00\xa8\x09\x90\x00\x00" +
+		"\x00\x00\x00\x00\x27\x97\x10\x00\x4e\xc8\xde\x30\x0d\xd0\x01\xdb\x02\xf8\x00\x09\xf1\x4f\x60\x00\x7f\x33\xf7\x00\x08\xf2\x1f\x90" +
+		"\x00\xbe\x00\x9f\x51\x6f\x70\x01\x9f\xff\x80\x00\x00\x12\x00\x00\x00\x01\x42\x00\x00\x00\x4f\xa0\x00\x00\x03\xa6\x00\x00\x00\x00" +
+		"\x00\x00\x13\x33\x33\x33\x23\xee\xee\xee\xe8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\xe9\x00\x00\x00\x4f\xa0\x00\x00\x69\x95" +
+		"\x74\x0b\xe9\xaf\xd0\x6f\x40\x2e\xf3\xbd\x00\xb8\xf7\xcc\x07\xa0\xf9\xcd\x3d\x11\xf9\x9f\xd3\x04\xf6\x2f\xc1\x2c\xd1\x6c\xdf\xfc" +
+		"\x20\x21\x02\x10\x00\x06\x70\x00\x00\x2d\x80\x00\x00\x2d\x40\x00\x00\x00\x00\x65\x00\x06\x5d\xa0\x00\xda\xda\x00\x0d\xad\xa0\x00" +
+		"\xda\xda\x00\x0d\xad\xa0\x00\xda\xda\x00\x4f\xab\xd4\x7d\xea\x4f\xfd\x2d\xa0\x12\x00\x00\x00\x01\x85\x00\x00\xac\x10\x00\x6c\x10" +
+		"\x00\x00\x00\x00\x65\x00\x06\x5d\xa0\x00\xda\xda\x00\x0d\xad\xa0\x00\xda\xda\x00\x0d\xad\xa0\x00\xda\xda\x00\x4f\xab\xd4\x7d\xea" +
+		"\x4f\xfd\x2d\xa0\x12\x00\x00\x00\x58\x40\x00\x4e\xae\x20\x1d\x50\x7b\x00\x00\x00\x00\x65\x00\x06\x5d\xa0\x00\xda\xda\x00\x0d\xad" +
+		"\xa0\x00\xda\xda\x00\x0d\xad\xa0\x00\xda\xda\x00\x4f\xab\xd4\x7d\xea\x4f\xfd\x2d\xa0\x12\x00\x00\x06\x40\x54\x00\xb7\x0a\x80\x00" +
+		"\x00\x00\x06\x50\x00\x65\xda\x00\x0d\xad\xa0\x00\xda\xda\x00\x0d\xad\xa0\x00\xda\xda\x00\x0d\xad\xa0\x04\xfa\xbd\x47\xde\xa4\xff" +
+		"\xd2\xda\x01\x20\x00\x00\x00\x04\x82\x00\x00\x2e\x70\x00\x00\xb7\x00\x00\x00\x00\x00\x06\x60\x00\x04\x67\xf1\x00\x0d\x82\xf6\x00" +
+		"\x3f\x20\xcc\x00\x9b\x00\x7f\x21\xe5\x00\x1f\x76\xe1\x00\x0b\xcc\x90\x00\x06\xff\x30\x00\x01\xfc\x00\x00\x01\xf6\x00\x00\x07\xf1" +
+		"\x00\x00\x0d\xa0\x00\x00\x01\x10\x00\x04\x40\x00\x00\x0c\xb0\x00\x00\x0c\xb0\x00\x00\x0c\xb0\x00\x00\x0c\xb1\x69\x40\x0c\xbb\xdd" +
+		"\xf5\x0c\xf9\x01\xdd\x0c\xd0\x00\x8f\x1c\xb0\x00\x7f\x3c\xb0\x00\x8f\x1c\xb0\x00\xbe\x0c\xc1\x16\xf6\x0c\xff\xfe\x70\x0c\xb2\x20" +
+		"\x00\x0c\xb0\x00\x00\x0c\xb0\x00\x00\x01\x10\x00\x00\x00\x17\x20\x72\x00\x1d\x40\xd5\x00\x00\x00\x00\x06\x60\x00\x04\x67\xf1\x00" +
+		"\x0d\x82\xf6\x00\x3f\x20\xcc\x00\x9b\x00\x7f\x21\xe5\x00\x1f\x76\xe1\x00\x0b\xcc\x90\x00\x06\xff\x30\x00\x01\xfc\x00\x00\x01\xf6" +
+		"\x00\x00\x07\xf1\x00\x00\x0d\xa0\x00\x00\x01\x10\x00\x00\x00\x00\x00\x88\x00\x00\x00\x00\x00\x00\x08\xff\x80\x00\x00\x00\x00\x00" +
+		"\x8f\xff\xf8\x00\x00\x00\x00\x08\x85\x33\x5c\x80\x00\x00\x00\x8f\x24\x63\x00\xc7\x00\x00\x07\xff\xef\xff\x10\x8f\x70\x00\x7f\xff" +
+		"\xff\xff\x10\xcf\xf7\x07\xff\xff\xff\xf7\x07\xff\xff\x77\xff\xff\xff\xb0\x6f\xff\xff\x80\x8f\xff\xff\x30\xdf\xff\xf8\x00\x08\xff" +
+		"\xff\x00\xef\xff\x80\x00\x00\x8f\xff\xff\xff\xf8\x00\x00\x00\x08\xfe\x44\xef\x80\x00\x00\x00\x00\x8e\x00\xe8\x00\x00\x00\x00\x00" +
+		"\x08\xff\x80\x00\x00\x00\x00\x00\x00\x88\x00\x00\x00\x00",
+}
@@ -24,7 +24,7 @@ type Device struct {
 func New(i2c drivers.I2C, intPin machine.Pin) *Device {
 	return &Device{
 		bus:     i2c,
-		buf:     make([]byte, 11),
+		buf:     make([]byte, 13),
 		Address: Address,
 		intPin:  intPin,
 	}
@@ -70,27 +70,65 @@ func (d *Device) GetFirmwareID() uint8 {
 
 // Read reads the registers.
 func (d *Device) Read() []byte {
-	d.bus.Tx(uint16(d.Address), []byte{0x02}, d.buf[:])
-	return d.buf[:]
+	d.bus.Tx(uint16(d.Address), []byte{0x02}, d.buf[:11])
+	return d.buf[:11]
 }
 
 // ReadTouchPoint reads a single touch.Point from the device. The maximum value
 // for each touch.Point is 0xFFFF.
 func (d *Device) ReadTouchPoint() touch.Point {
 	d.Read()
-	z := 0xFFFFF
+	p := point(d.buf[1:])
 	if d.buf[0] == 0 {
-		z = 0
+		p.Z = 0
 	}
+	return p
+}
+
+// ReadTouches reads the up to 2 touches of the panel, with their ID, and
+// implements touch.MultiPointer. The values are scaled like ReadTouchPoint.
+func (d *Device) ReadTouches(touches []touch.Touch) int {
+	d.bus.Tx(uint16(d.Address), []byte{0x02}, d.buf[:13])
+	n := 0
+	for i := 0; i < int(d.buf[0]&0x0F) && i < 2 && n < len(touches); i++ {
+		p := d.buf[1+6*i:]
+		if p[0]>>6 == eventLiftUp || p[0]>>6 == eventNone {
+			continue
+		}
+		touches[n] = touch.Touch{ID: p[2] >> 4, Point: point(p)}
+		n++
+	}
+	return n
+}
 
+// point decodes the X and Y registers of a touch.
+func point(b []byte) touch.Point {
 	//Scale X&Y to 16 bit for consistency across touch drivers
 	return touch.Point{
-		X: (int(d.buf[1]&0x0F)<<8 + int(d.buf[2])) * ((1 << 16) / 320),
-		Y: (int(d.buf[3]&0x0F)<<8 + int(d.buf[4])) * ((1 << 16) / 270),
-		Z: z,
+		X: (int(b[0]&0x0F)<<8 + int(b[1])) * ((1 << 16) / 320),
+		Y: (int(b[2]&0x0F)<<8 + int(b[3])) * ((1 << 16) / 270),
+		Z: 0xFFFFF,
 	}
 }
 
+// Gesture IDs returned by GestureID.
+const (
+	GestureNone      = 0x00
+	GestureMoveUp    = 0x10
+	GestureMoveRight = 0x14
+	GestureMoveDown  = 0x18
+	GestureMoveLeft  = 0x1C
+	GestureZoomIn    = 0x48
+	GestureZoomOut   = 0x49
+)
+
+// GestureID reads the gesture detected by the controller. Most panels only
+// report GestureNone: touch.GestureRecognizer recognizes gestures from the
+// touches instead.
+func (d *Device) GestureID() uint8 {
+	return d.read8bit(RegGestureID)
+}
+
 // Touched returns if touched or not.
 func (d *Device) Touched() bool {
 	p := d.ReadTouchPoint()
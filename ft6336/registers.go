@@ -43,7 +43,16 @@ package ft6336
 const (
 	Address = 0x38
 
+	RegGestureID    = 0x01
 	RegPeriodActive = 0x88
 	RegGMode        = 0xA4
 	RegFirmid       = 0xA6
 )
+
+// Event flags of a touch, in the high bits of its XH register.
+const (
+	eventPressDown = 0
+	eventLiftUp    = 1
+	eventContact   = 2
+	eventNone      = 3
+)
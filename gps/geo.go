@@ -0,0 +1,254 @@
+package gps
+
+import (
+	"errors"
+	"math"
+)
+
+var errVincentyNoConvergence = errors.New("Vincenty formula failed to converge")
+
+// EarthRadius is the mean radius of the earth in meters, used for the
+// spherical earth model.
+const EarthRadius = 6371008.8
+
+// WGS-84 ellipsoid parameters, used by VincentyDistance.
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+// Point is a position on earth in decimal degrees. Negative numbers indicate
+// S and W.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Point returns the position of the fix.
+func (fix Fix) Point() Point {
+	return Point{Latitude: float64(fix.Latitude), Longitude: float64(fix.Longitude)}
+}
+
+// Distance returns the great circle distance to q in meters, using the
+// haversine formula on a spherical earth. The error is up to about 0.5%.
+func (p Point) Distance(q Point) float64 {
+	lat1, lat2 := radians(p.Latitude), radians(q.Latitude)
+	dlat, dlon := lat2-lat1, radians(q.Longitude-p.Longitude)
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(math.Min(a, 1)))
+}
+
+// VincentyDistance returns the distance to q in meters on the WGS-84
+// ellipsoid, accurate to within a millimeter. It returns an error for nearly
+// antipodal points, where the formula does not converge.
+func (p Point) VincentyDistance(q Point) (float64, error) {
+	L := radians(q.Longitude - p.Longitude)
+	U1 := math.Atan((1 - wgs84F) * math.Tan(radians(p.Latitude)))
+	U2 := math.Atan((1 - wgs84F) * math.Tan(radians(q.Latitude)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	for i := 0; i < 100; i++ {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma := math.Sqrt((cosU2*sinLambda)*(cosU2*sinLambda) +
+			(cosU1*sinU2-sinU1*cosU2*cosLambda)*(cosU1*sinU2-sinU1*cosU2*cosLambda))
+		if sinSigma == 0 {
+			return 0, nil // coincident points
+		}
+		cosSigma := sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma := math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha := 1 - sinAlpha*sinAlpha
+		cos2SigmaM := 0.0
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha // zero on the equator
+		}
+		C := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+		prev := lambda
+		lambda = L + (1-C)*wgs84F*sinAlpha*
+			(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) < 1e-12 {
+			uSq := cosSqAlpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+			A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+			B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+			deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+				B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+			return wgs84B * A * (sigma - deltaSigma), nil
+		}
+	}
+	return 0, errVincentyNoConvergence
+}
+
+// Bearing returns the initial bearing to q along the great circle, in
+// degrees clockwise from true north.
+func (p Point) Bearing(q Point) float64 {
+	lat1, lat2 := radians(p.Latitude), radians(q.Latitude)
+	dlon := radians(q.Longitude - p.Longitude)
+	y := math.Sin(dlon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dlon)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Destination returns the point reached after travelling distance meters
+// along the great circle starting at the given bearing.
+func (p Point) Destination(bearing, distance float64) Point {
+	lat1, lon1 := radians(p.Latitude), radians(p.Longitude)
+	brng := radians(bearing)
+	d := distance / EarthRadius
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(brng))
+	lon2 := lon1 + math.Atan2(math.Sin(brng)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return Point{
+		Latitude:  degrees(lat2),
+		Longitude: math.Mod(degrees(lon2)+540, 360) - 180,
+	}
+}
+
+// Geofence is an area on earth.
+type Geofence interface {
+	// Contains reports whether p lies within the area.
+	Contains(p Point) bool
+}
+
+// Circle is a circular geofence.
+type Circle struct {
+	Center Point
+
+	// Radius in meters.
+	Radius float64
+}
+
+// Contains implements Geofence.
+func (c Circle) Contains(p Point) bool {
+	return c.Center.Distance(p) <= c.Radius
+}
+
+// Polygon is a geofence bounded by the straight lines (in latitude and
+// longitude) between its vertices. The last vertex connects to the first. It
+// must not cross the antimeridian.
+type Polygon []Point
+
+// Contains implements Geofence.
+func (poly Polygon) Contains(p Point) bool {
+	inside := false
+	for i, j := 0, len(poly)-1; i < len(poly); j, i = i, i+1 {
+		a, b := poly[i], poly[j]
+		if (a.Latitude > p.Latitude) != (b.Latitude > p.Latitude) &&
+			p.Longitude < (b.Longitude-a.Longitude)*(p.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// GeofenceEvent is the result of a geofence update.
+type GeofenceEvent uint8
+
+const (
+	GeofenceNone GeofenceEvent = iota
+	GeofenceEnter
+	GeofenceExit
+)
+
+// GeofenceMonitor tracks whether successive fixes are inside a geofence.
+type GeofenceMonitor struct {
+	Fence Geofence
+
+	known  bool
+	inside bool
+}
+
+// NewGeofenceMonitor returns a monitor for the given geofence.
+func NewGeofenceMonitor(fence Geofence) GeofenceMonitor {
+	return GeofenceMonitor{Fence: fence}
+}
+
+// Update checks the fix against the geofence and returns GeofenceEnter or
+// GeofenceExit when the fix crossed its boundary. Invalid fixes are ignored.
+// The first valid fix inside the geofence counts as entering it.
+func (m *GeofenceMonitor) Update(fix Fix) GeofenceEvent {
+	if !fix.Valid {
+		return GeofenceNone
+	}
+	inside := m.Fence.Contains(fix.Point())
+	if m.known && inside == m.inside {
+		return GeofenceNone
+	}
+	first := !m.known
+	m.known = true
+	m.inside = inside
+	switch {
+	case inside:
+		return GeofenceEnter
+	case first:
+		return GeofenceNone
+	default:
+		return GeofenceExit
+	}
+}
+
+// Inside reports whether the last valid fix was inside the geofence.
+func (m *GeofenceMonitor) Inside() bool {
+	return m.inside
+}
+
+// Odometer sums the distance travelled over successive fixes.
+type Odometer struct {
+	// MinDistance is the distance in meters from the last counted fix below
+	// which movement is considered jitter and not counted.
+	MinDistance float64
+
+	// MaxSpeed is the speed in m/s above which a fix is considered an outlier
+	// and ignored. 0 disables the check.
+	MaxSpeed float64
+
+	total float64
+	last  Fix
+	valid bool
+}
+
+// Update adds the distance to the fix and returns the total distance in
+// meters. Invalid fixes are ignored.
+func (o *Odometer) Update(fix Fix) float64 {
+	if !fix.Valid {
+		return o.total
+	}
+	if !o.valid {
+		o.last = fix
+		o.valid = true
+		return o.total
+	}
+	d := o.last.Point().Distance(fix.Point())
+	if d < o.MinDistance {
+		return o.total
+	}
+	if o.MaxSpeed > 0 {
+		dt := fix.Time.Sub(o.last.Time).Seconds()
+		if dt <= 0 || d/dt > o.MaxSpeed {
+			return o.total
+		}
+	}
+	o.total += d
+	o.last = fix
+	return o.total
+}
+
+// Distance returns the total distance in meters.
+func (o *Odometer) Distance() float64 {
+	return o.total
+}
+
+// Reset sets the total distance to zero and forgets the last fix.
+func (o *Odometer) Reset() {
+	o.total = 0
+	o.valid = false
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,116 @@
+package gps
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func dms(d, m, s float64) float64 {
+	if d < 0 {
+		return d - m/60 - s/3600
+	}
+	return d + m/60 + s/3600
+}
+
+// closeTo checks that got is within tolerance of want.
+func closeTo(c *qt.C, got, want, tolerance float64) {
+	c.Helper()
+	c.Assert(math.Abs(got-want) <= tolerance, qt.IsTrue, qt.Commentf("got %f, want %f ± %f", got, want, tolerance))
+}
+
+// Reference values from https://www.movable-type.co.uk/scripts/latlong.html
+// and the Vincenty paper (Flinders Peak to Buninyong).
+var (
+	landsEnd     = Point{Latitude: dms(50, 3, 59), Longitude: dms(-5, 42, 53)}
+	johnOGroats  = Point{Latitude: dms(58, 38, 38), Longitude: dms(-3, 4, 12)}
+	flindersPeak = Point{Latitude: dms(-37, 57, 3.72030), Longitude: dms(144, 25, 29.52440)}
+	buninyong    = Point{Latitude: dms(-37, 39, 10.15610), Longitude: dms(143, 55, 35.38390)}
+)
+
+func TestDistance(t *testing.T) {
+	c := qt.New(t)
+	closeTo(c, landsEnd.Distance(johnOGroats), 968900, 100)
+	closeTo(c, johnOGroats.Distance(landsEnd), 968900, 100)
+	c.Assert(landsEnd.Distance(landsEnd), qt.Equals, 0.0)
+
+	d, err := flindersPeak.VincentyDistance(buninyong)
+	c.Assert(err, qt.IsNil)
+	closeTo(c, d, 54972.271, 0.001)
+
+	d, err = landsEnd.VincentyDistance(landsEnd)
+	c.Assert(err, qt.IsNil)
+	c.Assert(d, qt.Equals, 0.0)
+
+	_, err = Point{0, 0}.VincentyDistance(Point{0.5, 179.7})
+	c.Assert(err, qt.Equals, errVincentyNoConvergence)
+}
+
+func TestBearing(t *testing.T) {
+	c := qt.New(t)
+	closeTo(c, landsEnd.Bearing(johnOGroats), dms(9, 7, 11), 1.0/3600)
+	closeTo(c, Point{0, 0}.Bearing(Point{0, -1}), 270, 1e-9)
+	closeTo(c, Point{0, 0}.Bearing(Point{-1, 0}), 180, 1e-9)
+}
+
+func TestDestination(t *testing.T) {
+	c := qt.New(t)
+	start := Point{Latitude: dms(53, 19, 14), Longitude: dms(-1, 43, 47)}
+	p := start.Destination(dms(96, 1, 18), 124800)
+	closeTo(c, p.Latitude, dms(53, 11, 18), 1.0/3600)
+	closeTo(c, p.Longitude, dms(0, 8, 0), 1.0/3600)
+
+	p = Point{0, 179.5}.Destination(90, Point{0, 0}.Distance(Point{0, 1}))
+	closeTo(c, p.Longitude, -179.5, 1e-9)
+}
+
+func TestGeofence(t *testing.T) {
+	c := qt.New(t)
+	square := Polygon{{52, 4}, {52, 5}, {53, 5}, {53, 4}}
+	c.Assert(square.Contains(Point{52.5, 4.5}), qt.IsTrue)
+	c.Assert(square.Contains(Point{51.5, 4.5}), qt.IsFalse)
+	c.Assert(square.Contains(Point{52.5, 5.5}), qt.IsFalse)
+
+	circle := Circle{Center: Point{52, 4}, Radius: 1000}
+	c.Assert(circle.Contains(Point{52.008, 4}), qt.IsTrue)
+	c.Assert(circle.Contains(Point{52.01, 4}), qt.IsFalse)
+
+	m := NewGeofenceMonitor(circle)
+	fix := func(lat float32, valid bool) Fix {
+		return Fix{Valid: valid, Latitude: lat, Longitude: 4}
+	}
+	c.Assert(m.Update(fix(52.02, true)), qt.Equals, GeofenceNone)
+	c.Assert(m.Update(fix(52.005, false)), qt.Equals, GeofenceNone)
+	c.Assert(m.Update(fix(52.005, true)), qt.Equals, GeofenceEnter)
+	c.Assert(m.Inside(), qt.IsTrue)
+	c.Assert(m.Update(fix(52.001, true)), qt.Equals, GeofenceNone)
+	c.Assert(m.Update(fix(52.02, true)), qt.Equals, GeofenceExit)
+	c.Assert(m.Inside(), qt.IsFalse)
+
+	m = NewGeofenceMonitor(square)
+	c.Assert(m.Update(Fix{Valid: true, Latitude: 52.5, Longitude: 4.5}), qt.Equals, GeofenceEnter)
+}
+
+func TestOdometer(t *testing.T) {
+	c := qt.New(t)
+	o := Odometer{MinDistance: 5, MaxSpeed: 50}
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	fix := func(sec int, lat float32) Fix {
+		return Fix{Valid: true, Time: start.Add(time.Duration(sec) * time.Second), Latitude: lat, Longitude: 4}
+	}
+
+	// About 11.1 m per 0.0001° of latitude.
+	c.Assert(o.Update(fix(0, 52)), qt.Equals, 0.0)
+	c.Assert(o.Update(fix(1, 52.00002)), qt.Equals, 0.0) // jitter
+	closeTo(c, o.Update(fix(2, 52.0001)), 11.1, 0.1)
+	closeTo(c, o.Update(fix(3, 52.01)), 11.1, 0.1) // outlier
+	closeTo(c, o.Update(Fix{Latitude: 53}), 11.1, 0.1)
+	closeTo(c, o.Update(fix(4, 52.0002)), 22.2, 0.2)
+	closeTo(c, o.Distance(), 22.2, 0.2)
+
+	o.Reset()
+	c.Assert(o.Distance(), qt.Equals, 0.0)
+	c.Assert(o.Update(fix(5, 52.0003)), qt.Equals, 0.0)
+}
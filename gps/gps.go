@@ -23,6 +23,8 @@ type Device struct {
 	uart     drivers.UART
 	bus      drivers.I2C
 	address  uint16
+	ubxRx    []byte
+	ubxTx    []byte
 }
 
 // NewUART creates a new UART GPS connection. The UART must already be configured.
@@ -121,7 +123,7 @@ func (gps *Device) WriteBytes(bytes []byte) {
 	if gps.uart != nil {
 		gps.uart.Write(bytes)
 	} else {
-		gps.bus.Tx(gps.address, []byte{}, bytes)
+		gps.bus.Tx(gps.address, bytes, nil)
 	}
 }
 
@@ -135,7 +137,7 @@ func validSentence(sentence string) error {
 		cs ^= sentence[i]
 	}
 	checksum := hex.EncodeToString([]byte{cs})
-	if (checksum[0] != sentence[len(sentence)-2]) || (checksum[1] != sentence[len(sentence)-1]) {
+	if !strings.EqualFold(checksum, sentence[len(sentence)-2:]) {
 		return errInvalidNMEAChecksum
 	}
 
@@ -0,0 +1,45 @@
+package gps
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/tester"
+)
+
+func TestValidSentence(t *testing.T) {
+	c := qt.New(t)
+	// Receivers send the checksum in upper case, as NMEA 0183 specifies.
+	c.Assert(validSentence("$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6A"), qt.IsNil)
+	c.Assert(validSentence("$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6a"), qt.IsNil)
+	c.Assert(validSentence("$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6B"), qt.Equals, errInvalidNMEAChecksum)
+	c.Assert(validSentence("$GP*"), qt.Equals, errInvalidNMEASentenceLength)
+}
+
+// txRecorder is an I2C device recording the data written with Tx.
+type txRecorder struct {
+	written []byte
+}
+
+func (d *txRecorder) Addr() uint8                             { return I2C_ADDRESS }
+func (d *txRecorder) ReadRegister(r uint8, buf []byte) error  { return nil }
+func (d *txRecorder) WriteRegister(r uint8, buf []byte) error { return nil }
+
+func (d *txRecorder) Tx(w, r []byte) error {
+	d.written = append(d.written, w...)
+	return nil
+}
+
+func TestWriteBytesI2C(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewI2CBus(c)
+	rec := &txRecorder{}
+	bus.AddDevice(rec)
+	dev := NewI2C(bus)
+
+	// The bytes are written to the receiver, not used as a read buffer.
+	cmd := []byte("$PUBX,40,GLL,0,0,0,0*5C\r\n")
+	dev.WriteBytes(cmd)
+	c.Assert(rec.written, qt.DeepEquals, cmd)
+}
@@ -0,0 +1,424 @@
+// Package gpssim simulates a GPS receiver following a scripted track, so code
+// using the gps package can be tested on the host without a real receiver.
+//
+// The simulator generates checksummed NMEA sentences and UBX messages and
+// can be used directly as the drivers.UART of gps.NewUART, or added to a
+// tester.I2CBus and used with gps.NewI2C:
+//
+//	sim := gpssim.New(start, []gpssim.Waypoint{
+//		{Latitude: 52.3731, Longitude: 4.8922, Satellites: 8},
+//		{At: time.Minute, Latitude: 52.3792, Longitude: 4.9003, Satellites: 8},
+//	})
+//	dev := gps.NewUART(sim)
+//
+// The simulator is not tied to the wall clock: a new navigation epoch is
+// generated whenever the reader needs more data. Once the end of the track is
+// reached, the receiver stays at the last waypoint.
+package gpssim // import "tinygo.org/x/drivers/gps/gpssim"
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"tinygo.org/x/drivers/gps"
+)
+
+// Output selects the messages sent for every navigation epoch.
+type Output uint8
+
+const (
+	OutputGGA Output = 1 << iota
+	OutputRMC
+	OutputNavPVT
+	OutputNavSat
+)
+
+// minBuffered is the number of bytes kept available to readers. It is larger
+// than the read size of gps.Device, so the device never waits for data.
+const minBuffered = 256
+
+// maxFrame is the largest UBX frame accepted from the host.
+const maxFrame = 1024
+
+const knotsPerMeterPerSecond = 1.943844
+
+// Waypoint is a point of the scripted track.
+type Waypoint struct {
+	// At is the time the receiver reaches the waypoint, relative to the start
+	// of the track.
+	At time.Duration
+
+	// Latitude and Longitude in decimal degrees.
+	Latitude  float64
+	Longitude float64
+
+	// Altitude above mean sea level, in meters.
+	Altitude float64
+
+	// Satellites is the number of satellites used from this waypoint on.
+	Satellites int
+
+	// NoFix simulates loss of fix from this waypoint on.
+	NoFix bool
+}
+
+// State is the simulated receiver state at a given time.
+type State struct {
+	Time      time.Time
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+
+	// Speed over ground, in m/s.
+	Speed float64
+
+	// Heading of motion, in degrees from true north.
+	Heading float64
+
+	Satellites int
+	Fix        bool
+}
+
+// Simulator simulates a GPS receiver following a track.
+type Simulator struct {
+	// Start is the UTC time at the start of the track.
+	Start time.Time
+
+	// Interval between navigation epochs.
+	Interval time.Duration
+
+	// Output selects the messages sent every epoch.
+	Output Output
+
+	track   []Waypoint
+	epoch   int
+	pending []byte
+	rx      []byte
+}
+
+// New returns a simulator for the given track, sending GGA and RMC sentences
+// once per second. The waypoints must be sorted by time.
+func New(start time.Time, track []Waypoint) *Simulator {
+	return &Simulator{
+		Start:    start,
+		Interval: time.Second,
+		Output:   OutputGGA | OutputRMC,
+		track:    track,
+	}
+}
+
+// Elapsed returns the track time of the next epoch to be generated.
+func (s *Simulator) Elapsed() time.Duration {
+	return time.Duration(s.epoch) * s.Interval
+}
+
+// Done reports whether the end of the track has been generated.
+func (s *Simulator) Done() bool {
+	return len(s.track) == 0 || s.Elapsed() > s.track[len(s.track)-1].At
+}
+
+// StateAt returns the receiver state at the given track time. Positions
+// between two waypoints are interpolated linearly.
+func (s *Simulator) StateAt(t time.Duration) State {
+	st := State{Time: s.Start.Add(t)}
+	if len(s.track) == 0 {
+		return st
+	}
+	i := 0
+	for i+1 < len(s.track) && s.track[i+1].At <= t {
+		i++
+	}
+	from := s.track[i]
+	st.Latitude = from.Latitude
+	st.Longitude = from.Longitude
+	st.Altitude = from.Altitude
+	st.Satellites = from.Satellites
+	st.Fix = !from.NoFix
+	if i+1 < len(s.track) && t >= from.At {
+		to := s.track[i+1]
+		span := to.At - from.At
+		f := float64(t-from.At) / float64(span)
+		st.Latitude += (to.Latitude - from.Latitude) * f
+		st.Longitude += (to.Longitude - from.Longitude) * f
+		st.Altitude += (to.Altitude - from.Altitude) * f
+		p := gps.Point{Latitude: from.Latitude, Longitude: from.Longitude}
+		q := gps.Point{Latitude: to.Latitude, Longitude: to.Longitude}
+		st.Speed = p.Distance(q) / span.Seconds()
+		if st.Speed > 0 {
+			st.Heading = p.Bearing(q)
+		}
+	}
+	return st
+}
+
+// Next generates the output for the next navigation epoch.
+func (s *Simulator) Next() []byte {
+	st := s.StateAt(s.Elapsed())
+	s.epoch++
+
+	var out []byte
+	if s.Output&OutputGGA != 0 {
+		out = append(out, GGA(st)...)
+	}
+	if s.Output&OutputRMC != 0 {
+		out = append(out, RMC(st)...)
+	}
+	if s.Output&OutputNavPVT != 0 {
+		pvt := NavPVT(st)
+		out = pvt.Message().AppendFrame(out)
+	}
+	if s.Output&OutputNavSat != 0 {
+		sat := NavSat(st)
+		out = sat.Message().AppendFrame(out)
+	}
+	return out
+}
+
+// fill generates epochs until at least n bytes are pending.
+func (s *Simulator) fill(n int) {
+	for len(s.pending) < n {
+		s.pending = append(s.pending, s.Next()...)
+	}
+}
+
+// Read implements drivers.UART.
+func (s *Simulator) Read(buf []byte) (int, error) {
+	s.fill(len(buf))
+	n := copy(buf, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+// Buffered implements drivers.UART.
+func (s *Simulator) Buffered() int {
+	s.fill(minBuffered)
+	return len(s.pending)
+}
+
+// Write implements drivers.UART. UBX messages written to the simulator are
+// answered like a u-blox receiver would: CFG messages are acknowledged and
+// NAV-PVT and NAV-SAT polls return the current state.
+func (s *Simulator) Write(buf []byte) (int, error) {
+	s.rx = append(s.rx, buf...)
+	for {
+		// Drop everything before the next UBX frame.
+		for len(s.rx) > 0 && s.rx[0] != gps.UBX_SYNC_1 {
+			s.rx = s.rx[1:]
+		}
+		if len(s.rx) < 8 {
+			return len(buf), nil
+		}
+		n := 8 + int(s.rx[4]) + int(s.rx[5])<<8
+		if s.rx[1] != gps.UBX_SYNC_2 || n > maxFrame {
+			s.rx = s.rx[1:]
+			continue
+		}
+		if len(s.rx) < n {
+			return len(buf), nil
+		}
+		msg, err := gps.ParseUBX(s.rx[:n])
+		if err != nil {
+			s.rx = s.rx[1:]
+			continue
+		}
+		s.reply(msg)
+		s.rx = s.rx[n:]
+	}
+}
+
+// reply queues the answer to a UBX message received from the host.
+func (s *Simulator) reply(msg gps.UBXMessage) {
+	st := s.StateAt(s.Elapsed())
+	switch {
+	case msg.Class == gps.UBX_CLASS_CFG:
+		ack := gps.UBXMessage{Class: gps.UBX_CLASS_ACK, ID: gps.UBX_ACK_ACK, Payload: []byte{msg.Class, msg.ID}}
+		s.pending = ack.AppendFrame(s.pending)
+	case msg.Class == gps.UBX_CLASS_NAV && msg.ID == gps.UBX_NAV_PVT:
+		pvt := NavPVT(st)
+		s.pending = pvt.Message().AppendFrame(s.pending)
+	case msg.Class == gps.UBX_CLASS_NAV && msg.ID == gps.UBX_NAV_SAT:
+		sat := NavSat(st)
+		s.pending = sat.Message().AppendFrame(s.pending)
+	}
+}
+
+// Addr implements tester.I2CDevice.
+func (s *Simulator) Addr() uint8 {
+	return gps.I2C_ADDRESS
+}
+
+// ReadRegister implements tester.I2CDevice.
+func (s *Simulator) ReadRegister(r uint8, buf []byte) error {
+	switch r {
+	case gps.BYTES_AVAIL_REG:
+		n := s.Buffered()
+		if n > 0xffff {
+			n = 0xffff
+		}
+		if len(buf) > 0 {
+			buf[0] = byte(n >> 8)
+		}
+		if len(buf) > 1 {
+			buf[1] = byte(n)
+		}
+	case gps.DATA_STREAM_REG:
+		s.Read(buf)
+	}
+	return nil
+}
+
+// WriteRegister implements tester.I2CDevice.
+func (s *Simulator) WriteRegister(r uint8, buf []byte) error {
+	s.Write(append([]byte{r}, buf...))
+	return nil
+}
+
+// Tx implements tester.I2CDevice.
+func (s *Simulator) Tx(w, r []byte) error {
+	if len(w) == 1 && len(r) > 0 {
+		return s.ReadRegister(w[0], r)
+	}
+	if len(w) > 0 {
+		s.Write(w)
+	}
+	if len(r) > 0 {
+		s.Read(r)
+	}
+	return nil
+}
+
+// GGA returns the checksummed GGA sentence for the state.
+func GGA(st State) string {
+	quality, sats, lat, lon, alt := 0, 0, ",", ",", ""
+	if st.Fix {
+		quality = 1
+		sats = st.Satellites
+		lat = latitude(st.Latitude)
+		lon = longitude(st.Longitude)
+		alt = fmt.Sprintf("%.1f", st.Altitude)
+	}
+	return sentence(fmt.Sprintf("GPGGA,%s,%s,%s,%d,%02d,1.0,%s,M,0.0,M,,",
+		utcTime(st.Time), lat, lon, quality, sats, alt))
+}
+
+// RMC returns the checksummed RMC sentence for the state.
+func RMC(st State) string {
+	status, mode, lat, lon, speed, heading := "V", "N", ",", ",", "", ""
+	if st.Fix {
+		status, mode = "A", "A"
+		lat = latitude(st.Latitude)
+		lon = longitude(st.Longitude)
+		speed = fmt.Sprintf("%.2f", st.Speed*knotsPerMeterPerSecond)
+		heading = fmt.Sprintf("%.2f", st.Heading)
+	}
+	return sentence(fmt.Sprintf("GPRMC,%s,%s,%s,%s,%s,%s,%s,,,%s",
+		utcTime(st.Time), status, lat, lon, speed, heading, st.Time.UTC().Format("020106"), mode))
+}
+
+// NavPVT returns the NAV-PVT solution for the state.
+func NavPVT(st State) gps.NavPVT {
+	t := st.Time.UTC()
+	pvt := gps.NavPVT{
+		ITOW:   gpsTimeOfWeek(t),
+		Year:   uint16(t.Year()),
+		Month:  uint8(t.Month()),
+		Day:    uint8(t.Day()),
+		Hour:   uint8(t.Hour()),
+		Minute: uint8(t.Minute()),
+		Second: uint8(t.Second()),
+		Valid:  gps.NavValidDate | gps.NavValidTime,
+		Nano:   int32(t.Nanosecond()),
+	}
+	if !st.Fix {
+		return pvt
+	}
+	rad := st.Heading * math.Pi / 180
+	pvt.FixType = gps.NavFix3D
+	pvt.Flags = gps.NavGNSSFixOK
+	pvt.NumSV = uint8(st.Satellites)
+	pvt.Latitude = int32(math.Round(st.Latitude * 1e7))
+	pvt.Longitude = int32(math.Round(st.Longitude * 1e7))
+	pvt.Height = int32(math.Round(st.Altitude * 1000))
+	pvt.HeightMSL = pvt.Height
+	pvt.HorizontalAccuracy = 2500
+	pvt.VerticalAccuracy = 4000
+	pvt.VelocityN = int32(math.Round(st.Speed * math.Cos(rad) * 1000))
+	pvt.VelocityE = int32(math.Round(st.Speed * math.Sin(rad) * 1000))
+	pvt.GroundSpeed = int32(math.Round(st.Speed * 1000))
+	pvt.HeadingMotion = int32(math.Round(st.Heading * 1e5))
+	pvt.PDOP = 150
+	return pvt
+}
+
+// NavSat returns the NAV-SAT satellite information for the state. The
+// satellites are spread evenly around the sky.
+func NavSat(st State) gps.NavSat {
+	sat := gps.NavSat{ITOW: gpsTimeOfWeek(st.Time.UTC()), Version: 1}
+	for i := 0; i < st.Satellites; i++ {
+		info := gps.SatInfo{
+			SVID:      uint8(i + 1),
+			CNO:       uint8(30 + i%3*5),
+			Elevation: int8(20 + i*7%60),
+			Azimuth:   int16(i * 360 / st.Satellites),
+			Flags:     0x07,
+		}
+		if st.Fix {
+			info.Flags |= 0x08
+		}
+		sat.Satellites = append(sat.Satellites, info)
+	}
+	return sat
+}
+
+// sentence adds the leading $ and the checksum to the NMEA sentence body.
+func sentence(body string) string {
+	var cs byte
+	for i := 0; i < len(body); i++ {
+		cs ^= body[i]
+	}
+	return fmt.Sprintf("$%s*%02X\r\n", body, cs)
+}
+
+// utcTime formats t as hhmmss.sss.
+func utcTime(t time.Time) string {
+	t = t.UTC()
+	return fmt.Sprintf("%02d%02d%02d.%03d", t.Hour(), t.Minute(), t.Second(), t.Nanosecond()/1e6)
+}
+
+// latitude formats the latitude as ddmm.mmmmm,N.
+func latitude(v float64) string {
+	hemi := "N"
+	if v < 0 {
+		hemi = "S"
+		v = -v
+	}
+	d, m := degreesMinutes(v)
+	return fmt.Sprintf("%02d%08.5f,%s", d, m, hemi)
+}
+
+// longitude formats the longitude as dddmm.mmmmm,E.
+func longitude(v float64) string {
+	hemi := "E"
+	if v < 0 {
+		hemi = "W"
+		v = -v
+	}
+	d, m := degreesMinutes(v)
+	return fmt.Sprintf("%03d%08.5f,%s", d, m, hemi)
+}
+
+// degreesMinutes splits v into whole degrees and minutes, rounded to the
+// precision used in NMEA sentences.
+func degreesMinutes(v float64) (int, float64) {
+	m := math.Round(v*60*1e5) / 1e5
+	d := int(m / 60)
+	return d, m - float64(d)*60
+}
+
+// gpsTimeOfWeek returns the GPS time of week in ms, ignoring leap seconds.
+func gpsTimeOfWeek(t time.Time) uint32 {
+	epoch := time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	return uint32(t.Sub(epoch) % week / time.Millisecond)
+}
@@ -0,0 +1,125 @@
+package gpssim
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/gps"
+	"tinygo.org/x/drivers/tester"
+)
+
+var start = time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+
+// track goes north for a minute, loses the fix and then regains it with
+// fewer satellites.
+var track = []Waypoint{
+	{Latitude: 52.0, Longitude: 4.5, Altitude: 10, Satellites: 9},
+	{At: time.Minute, Latitude: 52.01, Longitude: 4.5, Altitude: 10, Satellites: 9},
+	{At: 70 * time.Second, Latitude: 52.01, Longitude: 4.5, Satellites: 0, NoFix: true},
+	{At: 80 * time.Second, Latitude: 52.01, Longitude: 4.5, Altitude: 12, Satellites: 5},
+}
+
+func TestStateAt(t *testing.T) {
+	c := qt.New(t)
+	sim := New(start, track)
+
+	st := sim.StateAt(30 * time.Second)
+	c.Assert(st.Time, qt.Equals, start.Add(30*time.Second))
+	c.Assert(st.Fix, qt.IsTrue)
+	c.Assert(st.Latitude > 52.00499 && st.Latitude < 52.00501, qt.IsTrue)
+	c.Assert(st.Heading, qt.Equals, 0.0)
+	// 0.01° of latitude is about 1112 m.
+	c.Assert(st.Speed > 18.5 && st.Speed < 18.6, qt.IsTrue, qt.Commentf("speed %f", st.Speed))
+
+	c.Assert(sim.StateAt(75*time.Second).Fix, qt.IsFalse)
+	st = sim.StateAt(90 * time.Second)
+	c.Assert(st.Fix, qt.IsTrue)
+	c.Assert(st.Speed, qt.Equals, 0.0)
+	c.Assert(st.Satellites, qt.Equals, 5)
+}
+
+func TestSentences(t *testing.T) {
+	c := qt.New(t)
+	st := State{
+		Time:       time.Date(2022, 6, 1, 12, 35, 19, 500000000, time.UTC),
+		Latitude:   48.1173,
+		Longitude:  -11.516666,
+		Altitude:   545.4,
+		Speed:      11.524,
+		Heading:    84.4,
+		Satellites: 8,
+		Fix:        true,
+	}
+	c.Assert(GGA(st), qt.Equals, "$GPGGA,123519.500,4807.03800,N,01130.99996,W,1,08,1.0,545.4,M,0.0,M,,*7A\r\n")
+	c.Assert(RMC(st), qt.Equals, "$GPRMC,123519.500,A,4807.03800,N,01130.99996,W,22.40,84.40,010622,,,A*7A\r\n")
+
+	st.Fix = false
+	c.Assert(GGA(st), qt.Equals, "$GPGGA,123519.500,,,,,0,00,1.0,,M,0.0,M,,*71\r\n")
+}
+
+func TestUART(t *testing.T) {
+	c := qt.New(t)
+	sim := New(start, track)
+	dev := gps.NewUART(sim)
+	parser := gps.NewParser()
+
+	var valid, invalid int
+	for !sim.Done() {
+		s, err := dev.NextSentence()
+		c.Assert(err, qt.IsNil)
+		fix, err := parser.Parse(s)
+		c.Assert(err, qt.IsNil)
+		if !fix.Valid {
+			invalid++
+			continue
+		}
+		valid++
+		c.Assert(fix.Latitude >= 52.0 && fix.Latitude <= 52.01, qt.IsTrue)
+		c.Assert(fix.Longitude, qt.Equals, float32(4.5))
+	}
+	c.Assert(invalid > 0, qt.IsTrue)
+	c.Assert(valid > invalid, qt.IsTrue)
+}
+
+func TestI2C(t *testing.T) {
+	c := qt.New(t)
+	sim := New(start, track)
+	sim.Output = OutputGGA
+	bus := tester.NewI2CBus(c)
+	bus.AddDevice(sim)
+	dev := gps.NewI2C(bus)
+	parser := gps.NewParser()
+
+	s, err := dev.NextSentence()
+	c.Assert(err, qt.IsNil)
+	fix, err := parser.Parse(s)
+	c.Assert(err, qt.IsNil)
+	c.Assert(fix.Valid, qt.IsTrue)
+	c.Assert(fix.Satellites, qt.Equals, int16(9))
+	c.Assert(fix.Altitude, qt.Equals, int32(10))
+}
+
+func TestUBX(t *testing.T) {
+	c := qt.New(t)
+	sim := New(start, track)
+	dev := gps.NewUART(sim)
+
+	c.Assert(dev.SetMessageRate(gps.UBX_CLASS_NAV, gps.UBX_NAV_PVT, 1), qt.IsNil)
+	sim.Output = OutputNavPVT
+	for sim.Elapsed() < 20*time.Second {
+		sim.Next()
+	}
+
+	pvt, err := dev.NextNavPVT()
+	c.Assert(err, qt.IsNil)
+	c.Assert(pvt.FixType, qt.Equals, uint8(gps.NavFix3D))
+	c.Assert(pvt.NumSV, qt.Equals, uint8(9))
+	c.Assert(pvt.Longitude, qt.Equals, int32(45000000))
+	c.Assert(pvt.Latitude > 520000000 && pvt.Latitude < 520100000, qt.IsTrue)
+
+	var sat gps.NavSat
+	c.Assert(dev.PollNavSat(&sat), qt.IsNil)
+	c.Assert(sat.Satellites, qt.HasLen, 9)
+}
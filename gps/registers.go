@@ -1,5 +1,7 @@
 package gps
 
+import "time"
+
 // Constants/addresses used for u-blox I2C.
 
 // The I2C address which this device listens to.
@@ -15,3 +17,65 @@ const (
 const (
 	bufferSize = 100
 )
+
+// Constants used for the u-blox UBX binary protocol.
+
+// UBX frame sync characters.
+const (
+	UBX_SYNC_1 = 0xB5
+	UBX_SYNC_2 = 0x62
+)
+
+// UBX message classes.
+const (
+	UBX_CLASS_NAV = 0x01
+	UBX_CLASS_ACK = 0x05
+	UBX_CLASS_CFG = 0x06
+)
+
+// UBX message IDs.
+const (
+	UBX_NAV_PVT  = 0x07
+	UBX_NAV_SAT  = 0x35
+	UBX_ACK_NAK  = 0x00
+	UBX_ACK_ACK  = 0x01
+	UBX_CFG_PRT  = 0x00
+	UBX_CFG_MSG  = 0x01
+	UBX_CFG_RATE = 0x08
+	UBX_CFG_RXM  = 0x11
+	UBX_CFG_NAV5 = 0x24
+	UBX_CFG_PM2  = 0x3B
+	UBX_CFG_GNSS = 0x3E
+)
+
+// UBX port identifiers used by CFG-PRT.
+const (
+	UBX_PORT_DDC   = 0x00
+	UBX_PORT_UART1 = 0x01
+	UBX_PORT_UART2 = 0x02
+	UBX_PORT_USB   = 0x03
+	UBX_PORT_SPI   = 0x04
+)
+
+// UBX protocol masks used by CFG-PRT.
+const (
+	UBX_PROTO_UBX   = 0x0001
+	UBX_PROTO_NMEA  = 0x0002
+	UBX_PROTO_RTCM3 = 0x0020
+)
+
+// UBX_UART_MODE_8N1 is the CFG-PRT mode for 8 data bits, no parity and one stop bit.
+const UBX_UART_MODE_8N1 = 0x000008D0
+
+// UBX low power modes used by CFG-RXM.
+const (
+	UBX_RXM_CONTINUOUS = 0x00
+	UBX_RXM_POWER_SAVE = 0x01
+)
+
+const (
+	ubxHeaderSize  = 6
+	ubxMaxPayload  = 1024
+	ubxAckTimeout  = time.Second
+	ubxPollTimeout = time.Second
+)
@@ -1,10 +1,5 @@
 package gps
 
-import (
-	"errors"
-	"time"
-)
-
 // flight mode disables the GPS COCOM limits
 var flight_mode_cmd = [...]byte{
 	0xB5, 0x62, 0x06, 0x24, 0x24, 0x00, 0xFF, 0xFF, 0x06, 0x03, 0x00, 0x00, 0x00,
@@ -24,30 +19,31 @@ var cfg_gnss_cmd = [...]byte{
 	0x01, 0x01, 0x06, 0x08, 0x0E, 0x00, 0x00, 0x00,
 	0x01, 0x01, 0xFC, 0x11}
 
+// FlightMode disables the GPS COCOM limits, for high altitude balloons.
+func (gps *Device) FlightMode() error {
+	return sendCommand(gps, flight_mode_cmd[:])
+}
+
+// SetCfgGNSS disables every GNSS other than GPS, which power saving needs.
+func (gps *Device) SetCfgGNSS() error {
+	return sendCommand(gps, cfg_gnss_cmd[:])
+}
+
+// FlightMode disables the GPS COCOM limits of d.
+//
+// Deprecated: use Device.FlightMode, which does not copy the device.
 func FlightMode(d Device) (err error) {
-	err = sendCommand(d, flight_mode_cmd[:])
-	return err
+	return d.FlightMode()
 }
 
+// SetCfgGNSS disables every GNSS other than GPS on d.
+//
+// Deprecated: use Device.SetCfgGNSS, which does not copy the device.
 func SetCfgGNSS(d Device) (err error) {
-	err = sendCommand(d, cfg_gnss_cmd[:])
-	return err
+	return d.SetCfgGNSS()
 }
 
-func sendCommand(d Device, command []byte) (err error) {
+func sendCommand(d *Device, command []byte) (err error) {
 	d.WriteBytes(command)
-	start := time.Now()
-	for time.Now().Sub(start) < 1000 {
-		if d.readNextByte() == '\n' {
-			if d.readNextByte() == 0xB5 {
-				d.readNextByte()
-				if d.readNextByte() == 0x05 {
-					if d.readNextByte() == 0x01 {
-						return
-					}
-				}
-			}
-		}
-	}
-	return errors.New("no ACK to GPS command")
+	return d.waitUBXAck(command[2], command[3])
 }
@@ -0,0 +1,260 @@
+package gps
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidUBXFrame    = errors.New("invalid UBX frame")
+	errInvalidUBXChecksum = errors.New("invalid UBX frame checksum")
+	errUBXPayloadTooLong  = errors.New("UBX payload too long")
+	errUBXNak             = errors.New("UBX message rejected by GPS device")
+	errUBXAckTimeout      = errors.New("no ACK to UBX message")
+	errUBXPollTimeout     = errors.New("no response to UBX poll")
+	errUBXTimeout         = errors.New("timeout waiting for UBX message")
+)
+
+// UBXMessage is a single message of the u-blox UBX binary protocol.
+type UBXMessage struct {
+	Class   uint8
+	ID      uint8
+	Payload []byte
+}
+
+// AppendFrame appends the complete UBX frame for the message, including sync
+// characters, length and checksum, to buf and returns the extended buffer.
+func (msg UBXMessage) AppendFrame(buf []byte) []byte {
+	start := len(buf)
+	n := len(msg.Payload)
+	buf = append(buf, UBX_SYNC_1, UBX_SYNC_2, msg.Class, msg.ID, byte(n), byte(n>>8))
+	buf = append(buf, msg.Payload...)
+	cka, ckb := ubxChecksum(buf[start+2:])
+	return append(buf, cka, ckb)
+}
+
+// ParseUBX parses a complete UBX frame. The returned payload refers to the
+// frame, it is not copied.
+func ParseUBX(frame []byte) (msg UBXMessage, err error) {
+	if len(frame) < ubxHeaderSize+2 || frame[0] != UBX_SYNC_1 || frame[1] != UBX_SYNC_2 {
+		return msg, errInvalidUBXFrame
+	}
+	n := int(binary.LittleEndian.Uint16(frame[4:6]))
+	if len(frame) != ubxHeaderSize+n+2 {
+		return msg, errInvalidUBXFrame
+	}
+	cka, ckb := ubxChecksum(frame[2 : ubxHeaderSize+n])
+	if cka != frame[ubxHeaderSize+n] || ckb != frame[ubxHeaderSize+n+1] {
+		return msg, errInvalidUBXChecksum
+	}
+	msg.Class = frame[2]
+	msg.ID = frame[3]
+	msg.Payload = frame[ubxHeaderSize : ubxHeaderSize+n]
+	return msg, nil
+}
+
+// ubxChecksum calculates the 8-bit Fletcher checksum used by UBX frames over
+// the class, ID, length and payload fields.
+func ubxChecksum(data []byte) (a, b uint8) {
+	for _, c := range data {
+		a += c
+		b += a
+	}
+	return a, b
+}
+
+// WriteUBX sends a UBX message to the GPS device without waiting for a reply.
+func (gps *Device) WriteUBX(msg UBXMessage) {
+	gps.ubxTx = msg.AppendFrame(gps.ubxTx[:0])
+	gps.WriteBytes(gps.ubxTx)
+}
+
+// NextUBX returns the next valid UBX message from the GPS device. Any NMEA
+// data in between is skipped. The payload of the returned message is only
+// valid until the next call to NextUBX.
+func (gps *Device) NextUBX() (msg UBXMessage, err error) {
+	return gps.nextUBX(time.Time{})
+}
+
+// nextUBX returns the next valid UBX message from the GPS device. If deadline
+// is not zero, it gives up looking for the start of a message once the
+// deadline has passed.
+func (gps *Device) nextUBX(deadline time.Time) (msg UBXMessage, err error) {
+	for {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return msg, errUBXTimeout
+		}
+		if gps.readNextByte() != UBX_SYNC_1 {
+			continue
+		}
+		b := gps.readNextByte()
+		for b == UBX_SYNC_1 {
+			b = gps.readNextByte()
+		}
+		if b == UBX_SYNC_2 {
+			break
+		}
+	}
+
+	var header [4]byte
+	for i := range header {
+		header[i] = gps.readNextByte()
+	}
+	n := int(binary.LittleEndian.Uint16(header[2:4]))
+	if n > ubxMaxPayload {
+		return msg, errUBXPayloadTooLong
+	}
+	if gps.ubxRx == nil {
+		gps.ubxRx = make([]byte, ubxMaxPayload)
+	}
+	payload := gps.ubxRx[:n]
+	for i := range payload {
+		payload[i] = gps.readNextByte()
+	}
+
+	cka, ckb := ubxChecksum(header[:])
+	for _, c := range payload {
+		cka += c
+		ckb += cka
+	}
+	if gps.readNextByte() != cka || gps.readNextByte() != ckb {
+		return msg, errInvalidUBXChecksum
+	}
+
+	msg.Class = header[0]
+	msg.ID = header[1]
+	msg.Payload = payload
+	return msg, nil
+}
+
+// SendUBX sends a UBX message to the GPS device and waits for it to be
+// acknowledged. It returns an error if the device rejects the message or
+// does not answer in time.
+func (gps *Device) SendUBX(msg UBXMessage) error {
+	gps.WriteUBX(msg)
+	return gps.waitUBXAck(msg.Class, msg.ID)
+}
+
+// PollUBX requests the message with the given class and ID from the GPS
+// device and returns the reply. The payload of the returned message is only
+// valid until the next read from the device.
+func (gps *Device) PollUBX(class, id uint8) (msg UBXMessage, err error) {
+	gps.WriteUBX(UBXMessage{Class: class, ID: id})
+	deadline := time.Now().Add(ubxPollTimeout)
+	for {
+		msg, err = gps.nextUBX(deadline)
+		if err == errUBXTimeout {
+			return UBXMessage{}, errUBXPollTimeout
+		}
+		if err != nil {
+			continue
+		}
+		if msg.Class == class && msg.ID == id {
+			return msg, nil
+		}
+		if msg.Class == UBX_CLASS_ACK && msg.ID == UBX_ACK_NAK && ubxAckMatches(msg, class, id) {
+			return msg, errUBXNak
+		}
+	}
+}
+
+// waitUBXAck waits for the ACK-ACK or ACK-NAK message belonging to the
+// message with the given class and ID.
+func (gps *Device) waitUBXAck(class, id uint8) error {
+	deadline := time.Now().Add(ubxAckTimeout)
+	for {
+		msg, err := gps.nextUBX(deadline)
+		if err == errUBXTimeout {
+			return errUBXAckTimeout
+		}
+		if err != nil || msg.Class != UBX_CLASS_ACK || !ubxAckMatches(msg, class, id) {
+			continue
+		}
+		if msg.ID == UBX_ACK_ACK {
+			return nil
+		}
+		return errUBXNak
+	}
+}
+
+// ubxAckMatches reports whether the ACK message refers to the message with
+// the given class and ID.
+func ubxAckMatches(ack UBXMessage, class, id uint8) bool {
+	return len(ack.Payload) == 2 && ack.Payload[0] == class && ack.Payload[1] == id
+}
+
+// NextNavPVT returns the next NAV-PVT solution sent by the GPS device. The
+// device must be configured to output NAV-PVT messages, see SetMessageRate.
+func (gps *Device) NextNavPVT() (pvt NavPVT, err error) {
+	var msg UBXMessage
+	for {
+		msg, err = gps.NextUBX()
+		if err != nil {
+			return pvt, err
+		}
+		if msg.Class == UBX_CLASS_NAV && msg.ID == UBX_NAV_PVT {
+			err = pvt.Unmarshal(msg.Payload)
+			return pvt, err
+		}
+	}
+}
+
+// PollNavPVT requests the current navigation solution from the GPS device.
+func (gps *Device) PollNavPVT() (pvt NavPVT, err error) {
+	msg, err := gps.PollUBX(UBX_CLASS_NAV, UBX_NAV_PVT)
+	if err != nil {
+		return pvt, err
+	}
+	err = pvt.Unmarshal(msg.Payload)
+	return pvt, err
+}
+
+// PollNavSat requests the current satellite information from the GPS device.
+// The satellites are stored in sat.Satellites, reusing its capacity.
+func (gps *Device) PollNavSat(sat *NavSat) error {
+	msg, err := gps.PollUBX(UBX_CLASS_NAV, UBX_NAV_SAT)
+	if err != nil {
+		return err
+	}
+	return sat.Unmarshal(msg.Payload)
+}
+
+// SetNavigationRate sets the interval between navigation solutions.
+func (gps *Device) SetNavigationRate(interval time.Duration) error {
+	return gps.SendUBX(CfgRate{
+		MeasRate: uint16(interval / time.Millisecond),
+		NavRate:  1,
+		TimeRef:  1,
+	}.Message())
+}
+
+// ConfigurePort configures the protocols and (for UART ports) the baud rate
+// of one of the ports of the GPS device. Changing the baud rate of the port
+// in use means the ACK is sent using the new baud rate, so it is usually
+// missed.
+func (gps *Device) ConfigurePort(cfg CfgPrt) error {
+	return gps.SendUBX(cfg.Message())
+}
+
+// SetMessageRate sets how often the message with the given class and ID is
+// sent on the current port, relative to the navigation rate. A rate of 0
+// disables the message.
+func (gps *Device) SetMessageRate(class, id, rate uint8) error {
+	return gps.SendUBX(CfgMsg{Class: class, ID: id, Rate: rate}.Message())
+}
+
+// SetPowerSave enables or disables the power save mode of the GPS device.
+// The power save behaviour itself is configured with ConfigurePowerSave.
+func (gps *Device) SetPowerSave(enable bool) error {
+	mode := uint8(UBX_RXM_CONTINUOUS)
+	if enable {
+		mode = UBX_RXM_POWER_SAVE
+	}
+	return gps.SendUBX(CfgRxm{LPMode: mode}.Message())
+}
+
+// ConfigurePowerSave configures the power save mode of the GPS device.
+func (gps *Device) ConfigurePowerSave(cfg CfgPM2) error {
+	return gps.SendUBX(cfg.Message())
+}
@@ -0,0 +1,176 @@
+package gps
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestParseUBXCommands(t *testing.T) {
+	c := qt.New(t)
+	msg, err := ParseUBX(flight_mode_cmd[:])
+	c.Assert(err, qt.IsNil)
+	c.Assert(msg.Class, qt.Equals, uint8(UBX_CLASS_CFG))
+	c.Assert(msg.ID, qt.Equals, uint8(UBX_CFG_NAV5))
+	c.Assert(msg.Payload, qt.HasLen, 36)
+	c.Assert(msg.AppendFrame(nil), qt.DeepEquals, flight_mode_cmd[:])
+
+	msg, err = ParseUBX(cfg_gnss_cmd[:])
+	c.Assert(err, qt.IsNil)
+	c.Assert(msg.ID, qt.Equals, uint8(UBX_CFG_GNSS))
+	c.Assert(msg.AppendFrame(nil), qt.DeepEquals, cfg_gnss_cmd[:])
+
+	frame := append([]byte(nil), flight_mode_cmd[:]...)
+	frame[10] ^= 0xff
+	_, err = ParseUBX(frame)
+	c.Assert(err, qt.Equals, errInvalidUBXChecksum)
+
+	_, err = ParseUBX(frame[:20])
+	c.Assert(err, qt.Equals, errInvalidUBXFrame)
+}
+
+func TestCfgMessages(t *testing.T) {
+	c := qt.New(t)
+	// 1 Hz navigation rate aligned to GPS time, as generated by u-center.
+	c.Assert(CfgRate{MeasRate: 1000, NavRate: 1, TimeRef: 1}.Message().AppendFrame(nil), qt.DeepEquals,
+		[]byte{0xB5, 0x62, 0x06, 0x08, 0x06, 0x00, 0xE8, 0x03, 0x01, 0x00, 0x01, 0x00, 0x01, 0x39})
+	// Enable NAV-PVT on the current port.
+	c.Assert(CfgMsg{Class: UBX_CLASS_NAV, ID: UBX_NAV_PVT, Rate: 1}.Message().AppendFrame(nil), qt.DeepEquals,
+		[]byte{0xB5, 0x62, 0x06, 0x01, 0x03, 0x00, 0x01, 0x07, 0x01, 0x13, 0x51})
+
+	prt := CfgPrt{
+		PortID:       UBX_PORT_UART1,
+		Mode:         UBX_UART_MODE_8N1,
+		BaudRate:     115200,
+		InProtoMask:  UBX_PROTO_UBX | UBX_PROTO_NMEA,
+		OutProtoMask: UBX_PROTO_UBX,
+	}
+	var got CfgPrt
+	c.Assert(got.Unmarshal(prt.Message().Payload), qt.IsNil)
+	c.Assert(got, qt.Equals, prt)
+}
+
+func TestNavPVT(t *testing.T) {
+	c := qt.New(t)
+	payload := make([]byte, 92)
+	le := binary.LittleEndian
+	le.PutUint16(payload[4:], 2022)
+	payload[6] = 5
+	payload[7] = 17
+	payload[8] = 12
+	payload[9] = 34
+	payload[10] = 56
+	payload[11] = NavValidDate | NavValidTime
+	le.PutUint32(payload[16:], 250000000)
+	payload[20] = NavFix3D
+	payload[21] = NavGNSSFixOK
+	payload[23] = 9
+	lon, lat := int32(-1224194155), int32(377749295)
+	le.PutUint32(payload[24:], uint32(lon))
+	le.PutUint32(payload[28:], uint32(lat))
+	le.PutUint32(payload[36:], 25800)
+	le.PutUint32(payload[60:], 5144)
+	le.PutUint32(payload[64:], 9000000)
+
+	var pvt NavPVT
+	c.Assert(pvt.Unmarshal(payload[:91]), qt.Equals, errInvalidNavPVTPayload)
+	c.Assert(pvt.Unmarshal(payload), qt.IsNil)
+	c.Assert(pvt.Longitude, qt.Equals, lon)
+	c.Assert(pvt.Latitude, qt.Equals, lat)
+
+	fix := pvt.Fix()
+	c.Assert(fix.Valid, qt.IsTrue)
+	c.Assert(fix.Time, qt.Equals, time.Date(2022, 5, 17, 12, 34, 56, 250000000, time.UTC))
+	c.Assert(fix.Altitude, qt.Equals, int32(25))
+	c.Assert(fix.Satellites, qt.Equals, int16(9))
+	c.Assert(fix.Heading, qt.Equals, float32(90))
+	c.Assert(fix.Speed > 9.99 && fix.Speed < 10.01, qt.IsTrue)
+}
+
+func TestNavSat(t *testing.T) {
+	c := qt.New(t)
+	payload := []byte{
+		0x10, 0x27, 0x00, 0x00, 0x01, 0x02, 0x00, 0x00,
+		0x00, 0x05, 0x28, 0x2d, 0x0e, 0x01, 0xf6, 0xff, 0x0f, 0x00, 0x00, 0x00,
+		0x00, 0x0c, 0x11, 0xf6, 0x5a, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00,
+	}
+	var sat NavSat
+	c.Assert(sat.Unmarshal(payload[:20]), qt.Equals, errInvalidNavSatPayload)
+	c.Assert(sat.Unmarshal(payload), qt.IsNil)
+	c.Assert(sat.ITOW, qt.Equals, uint32(10000))
+	c.Assert(sat.Satellites, qt.DeepEquals, []SatInfo{
+		{SVID: 5, CNO: 40, Elevation: 45, Azimuth: 270, PseudorangeResidual: -10, Flags: 0x0f},
+		{SVID: 12, CNO: 17, Elevation: -10, Azimuth: 90, Flags: 0x01},
+	})
+	c.Assert(sat.Satellites[0].Used(), qt.IsTrue)
+	c.Assert(sat.Satellites[1].Used(), qt.IsFalse)
+}
+
+func TestSendUBX(t *testing.T) {
+	c := qt.New(t)
+	ack := UBXMessage{Class: UBX_CLASS_ACK, ID: UBX_ACK_ACK, Payload: []byte{UBX_CLASS_CFG, UBX_CFG_RATE}}
+	nak := UBXMessage{Class: UBX_CLASS_ACK, ID: UBX_ACK_NAK, Payload: []byte{UBX_CLASS_CFG, UBX_CFG_MSG}}
+
+	var rx []byte
+	rx = append(rx, "$GPTXT,01,01,02,u-blox ag*50\r\n"...)
+	rx = nak.AppendFrame(rx)
+	rx = ack.AppendFrame(rx)
+	uart := &fakeUART{rx: bytes.NewReader(rx)}
+	d := NewUART(uart)
+
+	c.Assert(d.SetNavigationRate(200*time.Millisecond), qt.IsNil)
+	msg, err := ParseUBX(uart.tx.Bytes())
+	c.Assert(err, qt.IsNil)
+	var rate CfgRate
+	c.Assert(rate.Unmarshal(msg.Payload), qt.IsNil)
+	c.Assert(rate, qt.Equals, CfgRate{MeasRate: 200, NavRate: 1, TimeRef: 1})
+
+	uart = &fakeUART{rx: bytes.NewReader(nak.AppendFrame(nil))}
+	d = NewUART(uart)
+	c.Assert(d.SetMessageRate(UBX_CLASS_NAV, UBX_NAV_PVT, 1), qt.Equals, errUBXNak)
+}
+
+func TestFlightMode(t *testing.T) {
+	c := qt.New(t)
+	ack := UBXMessage{Class: UBX_CLASS_ACK, ID: UBX_ACK_ACK, Payload: []byte{UBX_CLASS_CFG, 0x24}}
+	sentence := "$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6A"
+
+	// The ACK is read by the device itself, not a copy of it, so the bytes
+	// read after it stay in its buffer.
+	rx := ack.AppendFrame(nil)
+	rx = append(rx, sentence+"\r\n"...)
+	uart := &fakeUART{rx: bytes.NewReader(rx)}
+	d := NewUART(uart)
+	c.Assert(d.FlightMode(), qt.IsNil)
+	c.Assert(uart.tx.Bytes(), qt.DeepEquals, flight_mode_cmd[:])
+	c.Assert(d.ubxRx, qt.Not(qt.IsNil))
+	s, err := d.NextSentence()
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, sentence)
+}
+
+// fakeUART is a drivers.UART that returns the bytes in rx, followed by
+// zeros, and records all writes in tx.
+type fakeUART struct {
+	rx *bytes.Reader
+	tx bytes.Buffer
+}
+
+func (u *fakeUART) Read(buf []byte) (int, error) {
+	n, _ := u.rx.Read(buf)
+	for i := n; i < len(buf); i++ {
+		buf[i] = 0
+	}
+	return len(buf), nil
+}
+
+func (u *fakeUART) Write(buf []byte) (int, error) {
+	return u.tx.Write(buf)
+}
+
+func (u *fakeUART) Buffered() int {
+	return bufferSize
+}
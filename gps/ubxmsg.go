@@ -0,0 +1,426 @@
+package gps
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidNavPVTPayload = errors.New("invalid NAV-PVT payload length")
+	errInvalidNavSatPayload = errors.New("invalid NAV-SAT payload length")
+	errInvalidCfgPayload    = errors.New("invalid CFG payload length")
+)
+
+// NavPVT fix types.
+const (
+	NavFixNone          = 0
+	NavFixDeadReckoning = 1
+	NavFix2D            = 2
+	NavFix3D            = 3
+	NavFixGNSSDeadRec   = 4
+	NavFixTimeOnly      = 5
+)
+
+// NavPVT validity and fix status flags.
+const (
+	NavValidDate   = 0x01
+	NavValidTime   = 0x02
+	NavFullyResolv = 0x04
+	NavGNSSFixOK   = 0x01
+)
+
+// NavPVT is the navigation position, velocity and time solution sent in a
+// UBX NAV-PVT message. Positions are kept in the units used by the device so
+// no precision is lost.
+type NavPVT struct {
+	// ITOW is the GPS time of week of the navigation epoch, in ms.
+	ITOW uint32
+
+	Year   uint16
+	Month  uint8
+	Day    uint8
+	Hour   uint8
+	Minute uint8
+	Second uint8
+
+	// Valid holds the NavValid* flags for the date and time.
+	Valid uint8
+
+	// TimeAccuracy is the time accuracy estimate, in ns.
+	TimeAccuracy uint32
+
+	// Nano is the fraction of the second, in ns. It may be negative.
+	Nano int32
+
+	// FixType is one of the NavFix* constants.
+	FixType uint8
+
+	// Flags holds the fix status flags, see NavGNSSFixOK.
+	Flags uint8
+
+	// NumSV is the number of satellites used in the solution.
+	NumSV uint8
+
+	// Longitude and Latitude in 1e-7 degrees.
+	Longitude int32
+	Latitude  int32
+
+	// Height above the ellipsoid and above mean sea level, in mm.
+	Height    int32
+	HeightMSL int32
+
+	// HorizontalAccuracy and VerticalAccuracy estimates, in mm.
+	HorizontalAccuracy uint32
+	VerticalAccuracy   uint32
+
+	// Velocity north, east and down, in mm/s.
+	VelocityN int32
+	VelocityE int32
+	VelocityD int32
+
+	// GroundSpeed is the 2D ground speed, in mm/s.
+	GroundSpeed int32
+
+	// HeadingMotion is the 2D heading of motion, in 1e-5 degrees.
+	HeadingMotion int32
+
+	// SpeedAccuracy estimate, in mm/s.
+	SpeedAccuracy uint32
+
+	// HeadingAccuracy estimate, in 1e-5 degrees.
+	HeadingAccuracy uint32
+
+	// PDOP is the position dilution of precision, scaled by 0.01.
+	PDOP uint16
+}
+
+// Unmarshal decodes the payload of a NAV-PVT message.
+func (pvt *NavPVT) Unmarshal(payload []byte) error {
+	if len(payload) < 92 {
+		return errInvalidNavPVTPayload
+	}
+	le := binary.LittleEndian
+	pvt.ITOW = le.Uint32(payload[0:])
+	pvt.Year = le.Uint16(payload[4:])
+	pvt.Month = payload[6]
+	pvt.Day = payload[7]
+	pvt.Hour = payload[8]
+	pvt.Minute = payload[9]
+	pvt.Second = payload[10]
+	pvt.Valid = payload[11]
+	pvt.TimeAccuracy = le.Uint32(payload[12:])
+	pvt.Nano = int32(le.Uint32(payload[16:]))
+	pvt.FixType = payload[20]
+	pvt.Flags = payload[21]
+	pvt.NumSV = payload[23]
+	pvt.Longitude = int32(le.Uint32(payload[24:]))
+	pvt.Latitude = int32(le.Uint32(payload[28:]))
+	pvt.Height = int32(le.Uint32(payload[32:]))
+	pvt.HeightMSL = int32(le.Uint32(payload[36:]))
+	pvt.HorizontalAccuracy = le.Uint32(payload[40:])
+	pvt.VerticalAccuracy = le.Uint32(payload[44:])
+	pvt.VelocityN = int32(le.Uint32(payload[48:]))
+	pvt.VelocityE = int32(le.Uint32(payload[52:]))
+	pvt.VelocityD = int32(le.Uint32(payload[56:]))
+	pvt.GroundSpeed = int32(le.Uint32(payload[60:]))
+	pvt.HeadingMotion = int32(le.Uint32(payload[64:]))
+	pvt.SpeedAccuracy = le.Uint32(payload[68:])
+	pvt.HeadingAccuracy = le.Uint32(payload[72:])
+	pvt.PDOP = le.Uint16(payload[76:])
+	return nil
+}
+
+// Message returns the NAV-PVT message containing the navigation solution.
+func (pvt *NavPVT) Message() UBXMessage {
+	payload := make([]byte, 92)
+	le := binary.LittleEndian
+	le.PutUint32(payload[0:], pvt.ITOW)
+	le.PutUint16(payload[4:], pvt.Year)
+	payload[6] = pvt.Month
+	payload[7] = pvt.Day
+	payload[8] = pvt.Hour
+	payload[9] = pvt.Minute
+	payload[10] = pvt.Second
+	payload[11] = pvt.Valid
+	le.PutUint32(payload[12:], pvt.TimeAccuracy)
+	le.PutUint32(payload[16:], uint32(pvt.Nano))
+	payload[20] = pvt.FixType
+	payload[21] = pvt.Flags
+	payload[23] = pvt.NumSV
+	le.PutUint32(payload[24:], uint32(pvt.Longitude))
+	le.PutUint32(payload[28:], uint32(pvt.Latitude))
+	le.PutUint32(payload[32:], uint32(pvt.Height))
+	le.PutUint32(payload[36:], uint32(pvt.HeightMSL))
+	le.PutUint32(payload[40:], pvt.HorizontalAccuracy)
+	le.PutUint32(payload[44:], pvt.VerticalAccuracy)
+	le.PutUint32(payload[48:], uint32(pvt.VelocityN))
+	le.PutUint32(payload[52:], uint32(pvt.VelocityE))
+	le.PutUint32(payload[56:], uint32(pvt.VelocityD))
+	le.PutUint32(payload[60:], uint32(pvt.GroundSpeed))
+	le.PutUint32(payload[64:], uint32(pvt.HeadingMotion))
+	le.PutUint32(payload[68:], pvt.SpeedAccuracy)
+	le.PutUint32(payload[72:], pvt.HeadingAccuracy)
+	le.PutUint16(payload[76:], pvt.PDOP)
+	return UBXMessage{Class: UBX_CLASS_NAV, ID: UBX_NAV_PVT, Payload: payload}
+}
+
+// Time returns the UTC time of the navigation solution.
+func (pvt *NavPVT) Time() time.Time {
+	return time.Date(int(pvt.Year), time.Month(pvt.Month), int(pvt.Day),
+		int(pvt.Hour), int(pvt.Minute), int(pvt.Second), 0, time.UTC).
+		Add(time.Duration(pvt.Nano))
+}
+
+// Fix converts the navigation solution to a Fix, as returned by the NMEA
+// parser. Speed is converted to knots to match RMC sentences.
+func (pvt *NavPVT) Fix() Fix {
+	return Fix{
+		Valid:      pvt.Flags&NavGNSSFixOK != 0 && pvt.FixType >= NavFix2D && pvt.FixType <= NavFixGNSSDeadRec,
+		Time:       pvt.Time(),
+		Latitude:   float32(pvt.Latitude) / 1e7,
+		Longitude:  float32(pvt.Longitude) / 1e7,
+		Altitude:   pvt.HeightMSL / 1000,
+		Satellites: int16(pvt.NumSV),
+		Speed:      float32(pvt.GroundSpeed) * 0.001943844,
+		Heading:    float32(pvt.HeadingMotion) / 1e5,
+	}
+}
+
+// SatInfo is the information about a single satellite in a NAV-SAT message.
+type SatInfo struct {
+	GNSSID uint8
+	SVID   uint8
+
+	// CNO is the carrier to noise ratio (signal strength), in dBHz.
+	CNO uint8
+
+	// Elevation in degrees, from -90 to 90.
+	Elevation int8
+
+	// Azimuth in degrees, from 0 to 360.
+	Azimuth int16
+
+	// PseudorangeResidual in 0.1 m.
+	PseudorangeResidual int16
+
+	// Flags holds the signal quality and usage flags.
+	Flags uint32
+}
+
+// Used reports whether the satellite is used in the navigation solution.
+func (s SatInfo) Used() bool {
+	return s.Flags&0x08 != 0
+}
+
+// NavSat is the satellite information sent in a UBX NAV-SAT message.
+type NavSat struct {
+	// ITOW is the GPS time of week of the navigation epoch, in ms.
+	ITOW uint32
+
+	Version uint8
+
+	Satellites []SatInfo
+}
+
+// Unmarshal decodes the payload of a NAV-SAT message. The capacity of
+// Satellites is reused where possible.
+func (sat *NavSat) Unmarshal(payload []byte) error {
+	if len(payload) < 8 {
+		return errInvalidNavSatPayload
+	}
+	n := int(payload[5])
+	if len(payload) < 8+12*n {
+		return errInvalidNavSatPayload
+	}
+	le := binary.LittleEndian
+	sat.ITOW = le.Uint32(payload[0:])
+	sat.Version = payload[4]
+	sat.Satellites = sat.Satellites[:0]
+	for i := 0; i < n; i++ {
+		b := payload[8+12*i:]
+		sat.Satellites = append(sat.Satellites, SatInfo{
+			GNSSID:              b[0],
+			SVID:                b[1],
+			CNO:                 b[2],
+			Elevation:           int8(b[3]),
+			Azimuth:             int16(le.Uint16(b[4:])),
+			PseudorangeResidual: int16(le.Uint16(b[6:])),
+			Flags:               le.Uint32(b[8:]),
+		})
+	}
+	return nil
+}
+
+// Message returns the NAV-SAT message containing the satellite information.
+func (sat *NavSat) Message() UBXMessage {
+	payload := make([]byte, 8+12*len(sat.Satellites))
+	le := binary.LittleEndian
+	le.PutUint32(payload[0:], sat.ITOW)
+	payload[4] = sat.Version
+	payload[5] = uint8(len(sat.Satellites))
+	for i, info := range sat.Satellites {
+		b := payload[8+12*i:]
+		b[0] = info.GNSSID
+		b[1] = info.SVID
+		b[2] = info.CNO
+		b[3] = uint8(info.Elevation)
+		le.PutUint16(b[4:], uint16(info.Azimuth))
+		le.PutUint16(b[6:], uint16(info.PseudorangeResidual))
+		le.PutUint32(b[8:], info.Flags)
+	}
+	return UBXMessage{Class: UBX_CLASS_NAV, ID: UBX_NAV_SAT, Payload: payload}
+}
+
+// CfgRate is the UBX CFG-RATE navigation and measurement rate configuration.
+type CfgRate struct {
+	// MeasRate is the time between GNSS measurements, in ms.
+	MeasRate uint16
+
+	// NavRate is the number of measurements per navigation solution.
+	NavRate uint16
+
+	// TimeRef is the time system the measurements are aligned to: 0 for UTC,
+	// 1 for GPS time.
+	TimeRef uint16
+}
+
+// Message returns the CFG-RATE message to send to the device.
+func (cfg CfgRate) Message() UBXMessage {
+	payload := make([]byte, 6)
+	le := binary.LittleEndian
+	le.PutUint16(payload[0:], cfg.MeasRate)
+	le.PutUint16(payload[2:], cfg.NavRate)
+	le.PutUint16(payload[4:], cfg.TimeRef)
+	return UBXMessage{Class: UBX_CLASS_CFG, ID: UBX_CFG_RATE, Payload: payload}
+}
+
+// Unmarshal decodes the payload of a polled CFG-RATE message.
+func (cfg *CfgRate) Unmarshal(payload []byte) error {
+	if len(payload) < 6 {
+		return errInvalidCfgPayload
+	}
+	le := binary.LittleEndian
+	cfg.MeasRate = le.Uint16(payload[0:])
+	cfg.NavRate = le.Uint16(payload[2:])
+	cfg.TimeRef = le.Uint16(payload[4:])
+	return nil
+}
+
+// CfgPrt is the UBX CFG-PRT port configuration.
+type CfgPrt struct {
+	// PortID is one of the UBX_PORT_* constants.
+	PortID uint8
+
+	TxReady uint16
+
+	// Mode is the UART mode, usually UBX_UART_MODE_8N1, or the I2C address
+	// (shifted left by one) for the DDC port.
+	Mode uint32
+
+	// BaudRate of the UART port.
+	BaudRate uint32
+
+	// InProtoMask and OutProtoMask hold the UBX_PROTO_* protocols that are
+	// enabled for input and output.
+	InProtoMask  uint16
+	OutProtoMask uint16
+
+	Flags uint16
+}
+
+// Message returns the CFG-PRT message to send to the device.
+func (cfg CfgPrt) Message() UBXMessage {
+	payload := make([]byte, 20)
+	le := binary.LittleEndian
+	payload[0] = cfg.PortID
+	le.PutUint16(payload[2:], cfg.TxReady)
+	le.PutUint32(payload[4:], cfg.Mode)
+	le.PutUint32(payload[8:], cfg.BaudRate)
+	le.PutUint16(payload[12:], cfg.InProtoMask)
+	le.PutUint16(payload[14:], cfg.OutProtoMask)
+	le.PutUint16(payload[16:], cfg.Flags)
+	return UBXMessage{Class: UBX_CLASS_CFG, ID: UBX_CFG_PRT, Payload: payload}
+}
+
+// Unmarshal decodes the payload of a polled CFG-PRT message.
+func (cfg *CfgPrt) Unmarshal(payload []byte) error {
+	if len(payload) < 20 {
+		return errInvalidCfgPayload
+	}
+	le := binary.LittleEndian
+	cfg.PortID = payload[0]
+	cfg.TxReady = le.Uint16(payload[2:])
+	cfg.Mode = le.Uint32(payload[4:])
+	cfg.BaudRate = le.Uint32(payload[8:])
+	cfg.InProtoMask = le.Uint16(payload[12:])
+	cfg.OutProtoMask = le.Uint16(payload[14:])
+	cfg.Flags = le.Uint16(payload[16:])
+	return nil
+}
+
+// CfgMsg is the UBX CFG-MSG message rate configuration for the current port.
+type CfgMsg struct {
+	Class uint8
+	ID    uint8
+
+	// Rate is the number of navigation solutions between messages, 0
+	// disables the message.
+	Rate uint8
+}
+
+// Message returns the CFG-MSG message to send to the device.
+func (cfg CfgMsg) Message() UBXMessage {
+	return UBXMessage{Class: UBX_CLASS_CFG, ID: UBX_CFG_MSG, Payload: []byte{cfg.Class, cfg.ID, cfg.Rate}}
+}
+
+// CfgRxm is the UBX CFG-RXM receiver power mode configuration.
+type CfgRxm struct {
+	// LPMode is UBX_RXM_CONTINUOUS or UBX_RXM_POWER_SAVE.
+	LPMode uint8
+}
+
+// Message returns the CFG-RXM message to send to the device.
+func (cfg CfgRxm) Message() UBXMessage {
+	return UBXMessage{Class: UBX_CLASS_CFG, ID: UBX_CFG_RXM, Payload: []byte{0x08, cfg.LPMode}}
+}
+
+// CfgPM2 is the UBX CFG-PM2 extended power management configuration.
+type CfgPM2 struct {
+	// MaxStartupStateDuration is the maximum time spent in acquisition state,
+	// in s. 0 means no limit.
+	MaxStartupStateDuration uint8
+
+	Flags uint32
+
+	// UpdatePeriod is the position update period, in ms.
+	UpdatePeriod uint32
+
+	// SearchPeriod is the acquisition retry period when no fix is available,
+	// in ms.
+	SearchPeriod uint32
+
+	// GridOffset is the offset of the update grid within the week, in ms.
+	GridOffset uint32
+
+	// OnTime is the time to stay in tracking state, in s.
+	OnTime uint16
+
+	// MinAcqTime is the minimal search time, in s.
+	MinAcqTime uint16
+}
+
+// Message returns the CFG-PM2 message to send to the device.
+func (cfg CfgPM2) Message() UBXMessage {
+	payload := make([]byte, 44)
+	le := binary.LittleEndian
+	payload[0] = 0x01 // message version
+	payload[2] = cfg.MaxStartupStateDuration
+	le.PutUint32(payload[4:], cfg.Flags)
+	le.PutUint32(payload[8:], cfg.UpdatePeriod)
+	le.PutUint32(payload[12:], cfg.SearchPeriod)
+	le.PutUint32(payload[16:], cfg.GridOffset)
+	le.PutUint16(payload[20:], cfg.OnTime)
+	le.PutUint16(payload[22:], cfg.MinAcqTime)
+	return UBXMessage{Class: UBX_CLASS_CFG, ID: UBX_CFG_PM2, Payload: payload}
+}
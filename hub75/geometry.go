@@ -0,0 +1,105 @@
+package hub75
+
+import "errors"
+
+var errRowPattern = errors.New("hub75: RowPattern must divide half of the panel height")
+
+// Layout is the arrangement of panels chained together, seen from the front.
+// The chain starts at the top right panel and runs from right to left, then
+// goes on with the next row of panels. With Serpentine, the panels of odd
+// rows are mounted upside down and the chain runs from left to right on
+// them, so that the cables between rows stay short.
+type Layout struct {
+	Columns    int16
+	Rows       int16
+	Serpentine bool
+}
+
+// geometry is the arrangement of the panels of a display, and of their pixels
+// in the bit planes shifted to them.
+type geometry struct {
+	width             int16 // of the display
+	height            int16
+	panelWidth        int16
+	panelHeight       int16
+	layout            Layout
+	rowPattern        int16
+	rowSetsPerBuffer  int16
+	chainWidthBytes   int16
+	patternColorBytes uint32
+	sendBufferSize    uint32
+}
+
+// newGeometry returns the geometry of a display of panels of panelWidth x
+// panelHeight pixels, driving rowPattern rows at once, arranged as layout.
+func newGeometry(panelWidth, panelHeight int16, layout Layout, rowPattern int16) (geometry, error) {
+	// Each address drives a row of the top and of the bottom half.
+	if rowPattern < 1 || rowPattern > 32 || panelHeight%(2*rowPattern) != 0 {
+		return geometry{}, errRowPattern
+	}
+	if layout.Columns < 1 {
+		layout.Columns = 1
+	}
+	if layout.Rows < 1 {
+		layout.Rows = 1
+	}
+	g := geometry{
+		width:       panelWidth * layout.Columns,
+		height:      panelHeight * layout.Rows,
+		panelWidth:  panelWidth,
+		panelHeight: panelHeight,
+		layout:      layout,
+		rowPattern:  rowPattern,
+	}
+	// The chain is shifted as a single panel, as wide as all of them.
+	panels := int32(layout.Columns) * int32(layout.Rows)
+	g.chainWidthBytes = int16(int32(panelWidth) * panels / 8)
+	g.rowSetsPerBuffer = panelHeight / 2 / rowPattern
+	g.patternColorBytes = uint32(panelHeight/rowPattern) * uint32(g.chainWidthBytes)
+	g.sendBufferSize = g.patternColorBytes * 3
+	return g, nil
+}
+
+// locate returns the offset in a bit plane of the byte holding the red bit of
+// the pixel x, y of the display, and the mask of that bit. The green and blue
+// bits are patternColorBytes and 2*patternColorBytes bytes before. It returns
+// false for pixels outside of the display.
+func (g *geometry) locate(x, y int16) (offset uint32, bit uint8, ok bool) {
+	if x < 0 || x >= g.width || y < 0 || y >= g.height {
+		return 0, 0, false
+	}
+
+	// Find the panel and the position in the chain.
+	tileRow, tileCol := y/g.panelHeight, x/g.panelWidth
+	x, y = x%g.panelWidth, y%g.panelHeight
+	var inRow int16
+	if g.layout.Serpentine && tileRow%2 == 1 {
+		x, y = g.panelWidth-1-x, g.panelHeight-1-y
+		inRow = tileCol
+	} else {
+		inRow = g.layout.Columns - 1 - tileCol
+	}
+	panel := int32(tileRow)*int32(g.layout.Columns) + int32(inRow)
+	panels := int32(g.layout.Columns) * int32(g.layout.Rows)
+	x = int16((panels-1-panel)*int32(g.panelWidth)) + x
+
+	// The last bits shifted are the end of the chain.
+	x = g.chainWidthBytes*8 - 1 - x
+	vertIndexInBuffer := (y % (g.panelHeight / 2)) / g.rowPattern
+	whichBuffer := y / (g.panelHeight / 2)
+	fromEnd := uint32(x/8) + uint32(g.chainWidthBytes)*uint32(g.rowSetsPerBuffer*whichBuffer+vertIndexInBuffer)
+	offset = uint32(y%g.rowPattern)*g.sendBufferSize + g.sendBufferSize - 1 - fromEnd
+	return offset, uint8(1) << (x % 8), true
+}
+
+// planeBit returns the bit of a color channel v shown in the bit plane of
+// weight 2^plane, of colorDepth planes.
+func planeBit(v uint8, colorDepth, plane uint16) bool {
+	return v>>(8-colorDepth+plane)&1 != 0
+}
+
+// planeLoops returns the busy-wait loops showing the bit plane of weight
+// 2^plane at brightness, given the loops of a bcmUnit.
+func planeLoops(unitLoops uint32, brightness uint8, plane uint16) uint32 {
+	return uint32(uint64(unitLoops) << plane * uint64(brightness) / 255)
+}
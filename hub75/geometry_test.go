@@ -0,0 +1,133 @@
+package hub75
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestNewGeometry(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		name                string
+		width, height       int16
+		layout              Layout
+		rowPattern          int16
+		err                 error
+		displayW, displayH  int16
+		chainBytes, rowSets int16
+		colorBytes, size    uint32
+	}{
+		{"1/16 scan", 64, 32, Layout{}, 16, nil, 64, 32, 8, 1, 16, 48},
+		{"1/8 scan", 64, 32, Layout{}, 8, nil, 64, 32, 8, 2, 32, 96},
+		{"1/32 scan", 64, 64, Layout{}, 32, nil, 64, 64, 8, 1, 16, 48},
+		{"chain", 32, 16, Layout{Columns: 2, Rows: 3}, 8, nil, 64, 48, 24, 1, 48, 144},
+		{"1/32 scan too high", 64, 32, Layout{}, 32, errRowPattern, 0, 0, 0, 0, 0, 0},
+		{"not a divisor", 64, 32, Layout{}, 12, errRowPattern, 0, 0, 0, 0, 0, 0},
+		{"negative", 64, 32, Layout{}, -16, errRowPattern, 0, 0, 0, 0, 0, 0},
+	}
+	for _, test := range tests {
+		c.Run(test.name, func(c *qt.C) {
+			g, err := newGeometry(test.width, test.height, test.layout, test.rowPattern)
+			if test.err != nil {
+				c.Assert(err, qt.Equals, test.err)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(g.width, qt.Equals, test.displayW)
+			c.Assert(g.height, qt.Equals, test.displayH)
+			c.Assert(g.chainWidthBytes, qt.Equals, test.chainBytes)
+			c.Assert(g.rowSetsPerBuffer, qt.Equals, test.rowSets)
+			c.Assert(g.patternColorBytes, qt.Equals, test.colorBytes)
+			c.Assert(g.sendBufferSize, qt.Equals, test.size)
+		})
+	}
+}
+
+func TestLocate(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		name          string
+		width, height int16
+		layout        Layout
+		rowPattern    int16
+		x, y          int16
+		offset        uint32
+		bit           uint8
+		ok            bool
+	}{
+		// A single panel: the first pixel is shifted last.
+		{"first", 64, 32, Layout{}, 16, 0, 0, 40, 0x80, true},
+		{"last column", 64, 32, Layout{}, 16, 63, 0, 47, 0x01, true},
+		{"bottom half", 64, 32, Layout{}, 16, 0, 16, 32, 0x80, true},
+		{"row", 64, 32, Layout{}, 16, 10, 5, 281, 0x20, true},
+		{"1/8 scan", 64, 32, Layout{}, 8, 0, 9, 176, 0x80, true},
+		{"outside", 64, 32, Layout{}, 16, 64, 0, 0, 0, false},
+		{"negative", 64, 32, Layout{}, 16, 0, -1, 0, 0, false},
+
+		// Two panels side by side are shifted as a single wide one.
+		{"left panel", 32, 16, Layout{Columns: 2}, 8, 0, 0, 40, 0x80, true},
+		{"right panel", 32, 16, Layout{Columns: 2}, 8, 32, 0, 44, 0x80, true},
+
+		// Two rows of panels, the top one last in the chain.
+		{"top panel", 32, 16, Layout{Rows: 2}, 8, 0, 0, 44, 0x80, true},
+		{"bottom panel", 32, 16, Layout{Rows: 2}, 8, 0, 16, 40, 0x80, true},
+		{"upside down", 32, 16, Layout{Rows: 2, Serpentine: true}, 8, 0, 16, 371, 0x01, true},
+	}
+	for _, test := range tests {
+		c.Run(test.name, func(c *qt.C) {
+			g, err := newGeometry(test.width, test.height, test.layout, test.rowPattern)
+			c.Assert(err, qt.IsNil)
+			offset, bit, ok := g.locate(test.x, test.y)
+			c.Assert(ok, qt.Equals, test.ok)
+			c.Assert(offset, qt.Equals, test.offset)
+			c.Assert(bit, qt.Equals, test.bit)
+		})
+	}
+}
+
+func TestPlaneBit(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		v          uint8
+		colorDepth uint16
+		plane      uint16
+		on         bool
+	}{
+		{0x80, 8, 7, true},
+		{0x80, 8, 6, false},
+		{0x01, 8, 0, true},
+		{0x01, 4, 0, false}, // dropped with fewer planes
+		{0x10, 4, 0, true},
+		{0x80, 1, 0, true},
+		{0x7f, 1, 0, false},
+	}
+	for _, test := range tests {
+		c.Assert(planeBit(test.v, test.colorDepth, test.plane), qt.Equals, test.on,
+			qt.Commentf("v=%#x depth=%d plane=%d", test.v, test.colorDepth, test.plane))
+	}
+}
+
+func TestPlaneLoops(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		unitLoops  uint32
+		brightness uint8
+		plane      uint16
+		loops      uint32
+	}{
+		{10, 255, 0, 10},
+		{10, 255, 7, 1280},
+		{10, 0, 7, 0},
+		{10, 100, 3, 31},
+		{1 << 25, 127, 7, 2139062143}, // no overflow before scaling
+	}
+	for _, test := range tests {
+		c.Assert(planeLoops(test.unitLoops, test.brightness, test.plane), qt.Equals, test.loops,
+			qt.Commentf("unit=%d brightness=%d plane=%d", test.unitLoops, test.brightness, test.plane))
+	}
+}
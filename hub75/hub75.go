@@ -1,56 +1,81 @@
+//go:build tinygo
+// +build tinygo
+
 // Package hub75 implements a driver for the HUB75 LED matrix.
 //
 // Guide: https://cdn-learn.adafruit.com/downloads/pdf/32x16-32x32-rgb-led-matrix.pdf
 // This driver was inspired by https://github.com/2dom/PxMatrix
 //
+// Colors are shown with binary code modulation: each bit of the color
+// channels is a plane shown for a time proportional to its weight, so a
+// frame of ColorDepth planes shows 2^ColorDepth levels per channel.
+//
+// Several panels can be chained, the output of each one connected to the
+// input of the next one, and tiled in rows to make a larger display, see
+// Layout.
 package hub75 // import "tinygo.org/x/drivers/hub75"
 
 import (
 	"image/color"
 	"machine"
+	"runtime/volatile"
 	"time"
 
 	"tinygo.org/x/drivers"
 )
 
+// bcmUnit is how long the least significant bit plane is shown at full
+// brightness. It is shorter than what time.Sleep can wait, so the planes are
+// shown with a busy-wait calibrated by Configure.
+const bcmUnit = time.Microsecond
+
+// calibrationLoops is how many busy-wait loops Configure times.
+const calibrationLoops = 100000
+
 type Config struct {
-	Width      int16
-	Height     int16
+	// Width and Height are the size of one panel, 64x32 by default. With the
+	// default Layout of a single panel, they are the size of the display, as
+	// before panels could be chained. Size returns the size of the display.
+	Width  int16
+	Height int16
+	// ColorDepth is the number of bits per color channel, from 1 to 8 (the
+	// default). Each bit takes a buffer and doubles the time to show a
+	// frame.
 	ColorDepth uint16
+	// RowPattern is the number of rows driven at once by the panel, 16 by
+	// default for 1/16 scan panels. Use 8 for 1/8 scan panels and 32 for
+	// 1/32 scan panels, which need E.
 	RowPattern int16
 	Brightness uint8
+	// FastUpdate shifts the next bit plane while the current one is shown,
+	// for a faster refresh with slow SPI buses. The least significant planes
+	// are then shown for longer than their weight.
 	FastUpdate bool
+	// DoubleBuffer draws in a buffer which is not shown until Swap is
+	// called, to show animations without tearing. It takes twice the memory.
+	DoubleBuffer bool
+	// Layout is the arrangement of chained panels, one panel by default.
+	Layout Layout
+	// E is the fifth address pin, only used when RowPattern is 32.
+	E machine.Pin
 }
 
 type Device struct {
-	bus               drivers.SPI
-	a                 machine.Pin
-	b                 machine.Pin
-	c                 machine.Pin
-	d                 machine.Pin
-	oe                machine.Pin
-	lat               machine.Pin
-	width             int16
-	height            int16
-	brightness        uint8
-	fastUpdate        bool
-	colorDepth        uint16
-	colorStep         uint16
-	colorHalfStep     uint16
-	colorThirdStep    uint16
-	colorTwoThirdStep uint16
-	rowPattern        int16
-	rowsPerBuffer     int16
-	panelWidth        int16
-	panelWidthBytes   int16
-	pixelCounter      uint32
-	lineCounter       uint32
-	patternColorBytes uint8
-	rowSetsPerBuffer  uint8
-	sendBufferSize    uint16
-	rowOffset         []uint32
-	buffer            [][]uint8 // [ColorDepth][(width * height * 3(rgb)) / 8]uint8
-	displayColor      uint16
+	geometry
+	bus        drivers.SPI
+	a          machine.Pin
+	b          machine.Pin
+	c          machine.Pin
+	d          machine.Pin
+	e          machine.Pin
+	oe         machine.Pin
+	lat        machine.Pin
+	brightness uint8
+	fastUpdate bool
+	colorDepth uint16
+	unitLoops  uint32    // busy-wait loops per bcmUnit
+	buffer     [][]uint8 // [ColorDepth][sendBufferSize * RowPattern]uint8, drawn in
+	front      [][]uint8 // shown, the same as buffer without double buffering
 }
 
 // New returns a new HUB75 driver. Pass in a fully configured SPI bus.
@@ -68,70 +93,95 @@ func New(b drivers.SPI, latPin, oePin, aPin, bPin, cPin, dPin machine.Pin) Devic
 		b:   bPin,
 		c:   cPin,
 		d:   dPin,
+		e:   machine.NoPin,
 		oe:  oePin,
 		lat: latPin,
 	}
 }
 
-// Configure sets up the device.
-func (d *Device) Configure(cfg Config) {
-	if cfg.Width != 0 {
-		d.width = cfg.Width
-	} else {
-		d.width = 64
+// Configure sets up the device. It returns an error if RowPattern does not
+// divide half of the panel height.
+func (d *Device) Configure(cfg Config) error {
+	panelWidth, panelHeight := cfg.Width, cfg.Height
+	if panelWidth == 0 {
+		panelWidth = 64
 	}
-	if cfg.Height != 0 {
-		d.height = cfg.Height
-	} else {
-		d.height = 32
+	if panelHeight == 0 {
+		panelHeight = 32
+	}
+	rowPattern := cfg.RowPattern
+	if rowPattern == 0 {
+		rowPattern = 16
 	}
-	if cfg.ColorDepth != 0 {
+	g, err := newGeometry(panelWidth, panelHeight, cfg.Layout, rowPattern)
+	if err != nil {
+		return err
+	}
+	d.geometry = g
+	if cfg.ColorDepth != 0 && cfg.ColorDepth <= 8 {
 		d.colorDepth = cfg.ColorDepth
 	} else {
 		d.colorDepth = 8
 	}
-	if cfg.RowPattern != 0 {
-		d.rowPattern = cfg.RowPattern
-	} else {
-		d.rowPattern = 16
-	}
 	if cfg.Brightness != 0 {
 		d.brightness = cfg.Brightness
 	} else {
 		d.brightness = 255
 	}
-
-	d.fastUpdate = cfg.FastUpdate
-	d.rowsPerBuffer = d.height / 2
-	d.panelWidth = 1
-	d.panelWidthBytes = (d.width / d.panelWidth) / 8
-	d.rowOffset = make([]uint32, d.height)
-	d.patternColorBytes = uint8((d.height / d.rowPattern) * (d.width / 8))
-	d.rowSetsPerBuffer = uint8(d.rowsPerBuffer / d.rowPattern)
-	d.sendBufferSize = uint16(d.patternColorBytes) * 3
-	d.colorStep = 256 / d.colorDepth
-	d.colorHalfStep = d.colorStep / 2
-	d.colorThirdStep = d.colorStep / 3
-	d.colorTwoThirdStep = 2 * d.colorThirdStep
-	d.buffer = make([][]uint8, d.colorDepth)
-	for i := range d.buffer {
-		d.buffer[i] = make([]uint8, (d.width*d.height*3)/8)
+	if d.rowPattern == 32 {
+		d.e = cfg.E
+		d.e.Configure(machine.PinConfig{Mode: machine.PinOutput})
 	}
+	d.fastUpdate = cfg.FastUpdate
+	d.calibrate()
 
-	d.colorHalfStep = d.colorStep / 2
-	d.colorThirdStep = d.colorStep / 3
-	d.colorTwoThirdStep = 2 * d.colorThirdStep
+	d.buffer = d.newBuffer()
+	d.front = d.buffer
+	if cfg.DoubleBuffer {
+		d.front = d.newBuffer()
+	}
 
 	d.a.Low()
 	d.b.Low()
 	d.c.Low()
 	d.d.Low()
+	if d.e != machine.NoPin {
+		d.e.Low()
+	}
 	d.oe.High()
+	return nil
+}
 
-	var i uint32
-	for i = 0; i < uint32(d.height); i++ {
-		d.rowOffset[i] = (i%uint32(d.rowPattern))*uint32(d.sendBufferSize) + uint32(d.sendBufferSize) - 1
+// calibrate measures how many busy-wait loops take a bcmUnit.
+func (d *Device) calibrate() {
+	start := time.Now()
+	spin(calibrationLoops)
+	elapsed := time.Since(start)
+	d.unitLoops = 1
+	if elapsed > 0 {
+		if loops := uint64(calibrationLoops) * uint64(bcmUnit) / uint64(elapsed); loops > 1 {
+			d.unitLoops = uint32(loops)
+		}
+	}
+}
+
+// spinCounter is read by spin so that its loop is not optimized away.
+var spinCounter uint32
+
+// spin busy-waits for n loops.
+func spin(n uint32) {
+	for i := uint32(0); i < n; i++ {
+		volatile.LoadUint32(&spinCounter)
+	}
+}
+
+// newBuffer allocates the bit planes of a frame.
+func (d *Device) newBuffer() [][]uint8 {
+	buffer := make([][]uint8, d.colorDepth)
+	for i := range buffer {
+		buffer[i] = make([]uint8, d.sendBufferSize*uint32(d.rowPattern))
 	}
+	return buffer
 }
 
 // SetPixel modifies the internal buffer in a single pixel.
@@ -141,82 +191,79 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 
 // fillMatrixBuffer modifies a pixel in the internal buffer given position and RGB values
 func (d *Device) fillMatrixBuffer(x int16, y int16, r uint8, g uint8, b uint8) {
-	if x < 0 || x >= d.width || y < 0 || y >= d.height {
+	offsetR, bit, ok := d.locate(x, y)
+	if !ok {
 		return
 	}
-	x = d.width - 1 - x
-
-	var offsetR uint32
-	var offsetG uint32
-	var offsetB uint32
-
-	vertIndexInBuffer := uint8((int32(y) % int32(d.rowsPerBuffer)) / int32(d.rowPattern))
-	whichBuffer := uint8(y / d.rowsPerBuffer)
-	xByte := x / 8
-	whichPanel := uint8(xByte / d.panelWidthBytes)
-	inRowByteOffset := uint8(xByte % d.panelWidthBytes)
-
-	offsetR = d.rowOffset[y] - uint32(inRowByteOffset) - uint32(d.panelWidthBytes)*
-		(uint32(d.rowSetsPerBuffer)*(uint32(d.panelWidth)*uint32(whichBuffer)+uint32(whichPanel))+uint32(vertIndexInBuffer))
-	offsetG = offsetR - uint32(d.patternColorBytes)
-	offsetB = offsetG - uint32(d.patternColorBytes)
-
-	bitSelect := uint8(x % 8)
+	offsetG := offsetR - d.patternColorBytes
+	offsetB := offsetG - d.patternColorBytes
 
+	// Plane c holds the bit of weight 2^c of the channels.
 	for c := uint16(0); c < d.colorDepth; c++ {
-		colorTresh := uint8(c*d.colorStep + d.colorHalfStep)
-		if r > colorTresh {
-			d.buffer[c][offsetR] |= 1 << bitSelect
-		} else {
-			d.buffer[c][offsetR] = d.buffer[c][offsetR] &^ 1 << bitSelect
-		}
-		if g > colorTresh {
-			d.buffer[(c+d.colorThirdStep)%d.colorDepth][offsetG] |= 1 << bitSelect
-		} else {
-			d.buffer[(c+d.colorThirdStep)%d.colorDepth][offsetG] &^= 1 << bitSelect
-		}
-		if b > colorTresh {
-			d.buffer[(c+d.colorTwoThirdStep)%d.colorDepth][offsetB] |= 1 << bitSelect
-		} else {
-			d.buffer[(c+d.colorTwoThirdStep)%d.colorDepth][offsetB] &^= 1 << bitSelect
-		}
+		plane := d.buffer[c]
+		setBit(plane, offsetR, bit, planeBit(r, d.colorDepth, c))
+		setBit(plane, offsetG, bit, planeBit(g, d.colorDepth, c))
+		setBit(plane, offsetB, bit, planeBit(b, d.colorDepth, c))
+	}
+}
+
+func setBit(buf []uint8, offset uint32, bit uint8, on bool) {
+	if on {
+		buf[offset] |= bit
+	} else {
+		buf[offset] &^= bit
 	}
 }
 
-// Display sends the buffer (if any) to the screen.
+// Display shows a frame: all the rows, with each bit plane shown for a time
+// proportional to its weight. It must be called continuously to keep the
+// image on the panels.
 func (d *Device) Display() error {
+	// Read once, so that a frame is shown whole if Swap is called meanwhile.
+	front := d.front
 	rp := uint16(d.rowPattern)
+	size := d.sendBufferSize
 	for i := uint16(0); i < rp; i++ {
-		// FAST UPDATES (only if brightness = 255)
-		if d.fastUpdate && d.brightness == 255 {
-			d.setMux((i + rp - 1) % rp)
+		for c := uint16(0); c < d.colorDepth; c++ {
+			data := front[c][uint32(i)*size : uint32(i+1)*size]
+			if !d.fastUpdate || i == 0 && c == 0 {
+				d.bus.Tx(data, nil)
+			}
+			d.setMux(i)
 			d.lat.High()
-			d.oe.Low()
 			d.lat.Low()
-			time.Sleep(1 * time.Microsecond)
-			d.bus.Tx(d.buffer[d.displayColor][i*d.sendBufferSize:(i+1)*d.sendBufferSize], nil)
-			time.Sleep(10 * time.Microsecond)
+			d.oe.Low()
+			loops := planeLoops(d.unitLoops, d.brightness, c)
+			if !d.fastUpdate {
+				spin(loops)
+				d.oe.High()
+				continue
+			}
+			// Shift the next plane while this one is shown.
+			start := time.Now()
+			ni, nc := i, c+1
+			if nc == d.colorDepth {
+				ni, nc = (i+1)%rp, 0
+			}
+			if ni != 0 || nc != 0 {
+				d.bus.Tx(front[nc][uint32(ni)*size:uint32(ni+1)*size], nil)
+			}
+			shifted := uint64(time.Since(start)) * uint64(d.unitLoops) / uint64(bcmUnit)
+			if shifted < uint64(loops) {
+				spin(loops - uint32(shifted))
+			}
 			d.oe.High()
-
-		} else { // NO FAST UPDATES
-			d.setMux(i)
-			d.bus.Tx(d.buffer[d.displayColor][i*d.sendBufferSize:(i+1)*d.sendBufferSize], nil)
-			d.latch((255 * uint16(d.brightness)) / 255)
 		}
 	}
-	d.displayColor++
-	if d.displayColor >= d.colorDepth {
-		d.displayColor = 0
-	}
 	return nil
 }
 
-func (d *Device) latch(showTime uint16) {
-	d.lat.High()
-	d.lat.Low()
-	d.oe.Low()
-	time.Sleep(time.Duration(showTime) * time.Microsecond)
-	d.oe.High()
+// Swap shows the frame drawn since the previous call, from the next frame
+// shown by Display. The buffer drawn into is then the one of the frame
+// which was shown, with its content. It does nothing without double
+// buffering.
+func (d *Device) Swap() {
+	d.buffer, d.front = d.front, d.buffer
 }
 
 func (d *Device) setMux(value uint16) {
@@ -240,11 +287,18 @@ func (d *Device) setMux(value uint16) {
 	} else {
 		d.d.Low()
 	}
+	if d.e != machine.NoPin {
+		if (value & 0x10) == 0x10 {
+			d.e.High()
+		} else {
+			d.e.Low()
+		}
+	}
 }
 
 // FlushDisplay flushes the display
 func (d *Device) FlushDisplay() {
-	var i uint16
+	var i uint32
 	for i = 0; i < d.sendBufferSize; i++ {
 		d.bus.Tx([]byte{0x00}, nil)
 	}
@@ -257,15 +311,14 @@ func (d *Device) SetBrightness(brightness uint8) {
 
 // ClearDisplay erases the internal buffer
 func (d *Device) ClearDisplay() {
-	bufferSize := (d.width * d.height * 3) / 8
-	for c := uint16(0); c < d.colorDepth; c++ {
-		for j := int16(0); j < bufferSize; j++ {
-			d.buffer[c][j] = 0
+	for _, plane := range d.buffer {
+		for j := range plane {
+			plane[j] = 0
 		}
 	}
 }
 
-// Size returns the current size of the display.
+// Size returns the current size of the display, with all its panels.
 func (d *Device) Size() (w, h int16) {
 	return d.width, d.height
 }
@@ -5,12 +5,15 @@ import (
 	"image/color"
 	"machine"
 	"time"
+
+	"tinygo.org/x/drivers/pixel"
 )
 
 type Config struct {
 	Width            int16
 	Height           int16
 	Rotation         Rotation
+	Mirror           bool // Mirror flips the display horizontally
 	DisplayInversion bool
 }
 
@@ -18,6 +21,7 @@ type Device struct {
 	width    int16
 	height   int16
 	rotation Rotation
+	mirror   bool
 	driver   driver
 
 	x0, x1 int16 // cached address window; prevents useless/expensive
@@ -68,6 +72,7 @@ func (d *Device) Configure(config Config) {
 	d.width = config.Width
 	d.height = config.Height
 	d.rotation = config.Rotation
+	d.mirror = config.Mirror
 
 	// try to pick an initial cache miss for one of the points
 	d.x0, d.x1 = -(d.width + 1), d.x0
@@ -145,7 +150,7 @@ func (d *Device) Size() (x, y int16) {
 // SetPixel modifies the internal buffer.
 func (d *Device) SetPixel(x, y int16, c color.RGBA) {
 	d.setWindow(x, y, 1, 1)
-	c565 := RGBATo565(c)
+	c565 := pixel.ToRGB565(c)
 	d.startWrite()
 	d.driver.write16(c565)
 	d.endWrite()
@@ -192,13 +197,44 @@ func (d *Device) FillRectangle(x, y, width, height int16, c color.RGBA) error {
 		return errors.New("rectangle coordinates outside display area")
 	}
 	d.setWindow(x, y, width, height)
-	c565 := RGBATo565(c)
+	c565 := pixel.ToRGB565(c)
 	d.startWrite()
 	d.driver.write16n(c565, int(width)*int(height))
 	d.endWrite()
 	return nil
 }
 
+// FillRectangleWithBuffer fills a rectangle at given coordinates with a
+// buffer of colors, row by row
+func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	k, i := d.Size()
+	if x < 0 || y < 0 || width <= 0 || height <= 0 ||
+		x >= k || (x+width) > k || y >= i || (y+height) > i {
+		return errors.New("rectangle coordinates outside display area")
+	}
+	if int(width)*int(height) != len(buffer) {
+		return errors.New("buffer length does not match with rectangle size")
+	}
+	d.setWindow(x, y, width, height)
+	var data [64]byte
+	d.startWrite()
+	for len(buffer) > 0 {
+		n := len(buffer)
+		if n > len(data)/2 {
+			n = len(data) / 2
+		}
+		d.driver.write8sl(data[:pixel.RGB565.Encode(data[:], buffer[:n])])
+		buffer = buffer[n:]
+	}
+	d.endWrite()
+	return nil
+}
+
+// PixelFormat returns the format of the data passed to DrawRGBBitmap8
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.RGB565
+}
+
 // DrawRectangle draws a rectangle at given coordinates with a color
 func (d *Device) DrawRectangle(x, y, w, h int16, c color.RGBA) error {
 	if err := d.DrawFastHLine(x, x+w-1, y, c); err != nil {
@@ -246,32 +282,43 @@ func (d *Device) GetRotation() Rotation {
 	return d.rotation
 }
 
-// SetRotation changes the rotation of the device (clock-wise)
+// madctl holds the memory access control of each rotation, without and with
+// mirroring
+var madctl = [4][2]uint8{
+	Rotation0:   {MADCTL_MX | MADCTL_BGR, MADCTL_BGR},
+	Rotation90:  {MADCTL_MV | MADCTL_BGR, MADCTL_MY | MADCTL_MV | MADCTL_BGR},
+	Rotation180: {MADCTL_MY | MADCTL_BGR, MADCTL_MX | MADCTL_MY | MADCTL_BGR},
+	Rotation270: {MADCTL_MX | MADCTL_MY | MADCTL_MV | MADCTL_BGR, MADCTL_MX | MADCTL_MV | MADCTL_BGR},
+}
+
+// SetRotation changes the rotation of the device (clock-wise). The
+// deprecated Rotation0Mirror to Rotation270Mirror also turn mirroring on.
 func (d *Device) SetRotation(rotation Rotation) {
-	madctl := uint8(0)
-	switch rotation % 8 {
-	case Rotation0:
-		madctl = MADCTL_MX | MADCTL_BGR
-	case Rotation90:
-		madctl = MADCTL_MV | MADCTL_BGR
-	case Rotation180:
-		madctl = MADCTL_MY | MADCTL_BGR
-	case Rotation270:
-		madctl = MADCTL_MX | MADCTL_MY | MADCTL_MV | MADCTL_BGR
-	case Rotation0Mirror:
-		madctl = MADCTL_BGR
-	case Rotation90Mirror:
-		madctl = MADCTL_MY | MADCTL_MV | MADCTL_BGR
-	case Rotation180Mirror:
-		madctl = MADCTL_MX | MADCTL_MY | MADCTL_BGR
-	case Rotation270Mirror:
-		madctl = MADCTL_MX | MADCTL_MY | MADCTL_MV | MADCTL_BGR
+	if rotation%8 >= Rotation0Mirror {
+		d.mirror = true
 	}
-	cmdBuf[0] = madctl
+	rotation %= 4
+	mirror := 0
+	if d.mirror {
+		mirror = 1
+	}
+	cmdBuf[0] = madctl[rotation][mirror]
 	d.sendCommand(MADCTL, cmdBuf[:1])
 	d.rotation = rotation
 }
 
+// GetMirror returns whether the display is flipped horizontally
+func (d *Device) GetMirror() bool {
+	return d.mirror
+}
+
+// SetMirror flips the display horizontally, as some boards such as the
+// M5Stack need, in any rotation
+func (d *Device) SetMirror(mirror bool) {
+	d.mirror = mirror
+	d.SetRotation(d.rotation)
+}
+
 // SetScrollArea sets an area to scroll with fixed top/bottom or left/right parts of the display
 // Rotation affects scroll direction
 func (d *Device) SetScrollArea(topFixedArea, bottomFixedArea int16) {
@@ -363,9 +410,8 @@ func delay(m int) {
 }
 
 // RGBATo565 converts a color.RGBA to uint16 used in the display
+//
+// Deprecated: use pixel.ToRGB565.
 func RGBATo565(c color.RGBA) uint16 {
-	r, g, b, _ := c.RGBA()
-	return uint16((r & 0xF800) +
-		((g & 0xFC00) >> 5) +
-		((b & 0xF800) >> 11))
+	return pixel.ToRGB565(c)
 }
@@ -1,6 +1,9 @@
 package ili9341
 
-type Rotation uint8
+import "tinygo.org/x/drivers"
+
+// Rotation controls the rotation used by the display.
+type Rotation = drivers.Rotation
 
 const (
 
@@ -82,8 +85,12 @@ const (
 	Rotation180 Rotation = 2
 	Rotation270 Rotation = 3
 
-	Rotation0Mirror   Rotation = 4
-	Rotation90Mirror  Rotation = 5
+	// Deprecated: use Config.Mirror or SetMirror with Rotation0.
+	Rotation0Mirror Rotation = 4
+	// Deprecated: use Config.Mirror or SetMirror with Rotation90.
+	Rotation90Mirror Rotation = 5
+	// Deprecated: use Config.Mirror or SetMirror with Rotation180.
 	Rotation180Mirror Rotation = 6
+	// Deprecated: use Config.Mirror or SetMirror with Rotation270.
 	Rotation270Mirror Rotation = 7
 )
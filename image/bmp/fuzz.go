@@ -0,0 +1,29 @@
+//go:build gofuzz
+// +build gofuzz
+
+package bmp
+
+import (
+	"bytes"
+)
+
+func Fuzz(data []byte) int {
+	cfg, err := DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0
+	}
+	if cfg.Width*cfg.Height > 1e6 {
+		return 0
+	}
+	buf := make([]uint16, 64)
+	SetCallback(buf, func(data []uint16, x, y, w, h, width, height int16) {
+		if int(x)+int(w) > cfg.Width || int(y)+int(h) > cfg.Height || len(data) != int(w)*int(h) {
+			panic("pixels outside of the image")
+		}
+	})
+	_, err = Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,439 @@
+// Package bmp implements a BMP image decoder.
+//
+// It supports 1, 4, 8, 16, 24 and 32 bits per pixel, bit fields and 4 and 8
+// bit RLE compression. Like the png and jpeg packages, the decoded pixels are
+// not returned as an image.Image but passed in RGB565 to the callback set by
+// SetCallback, a few pixels at a time, so images can be drawn without holding
+// them in RAM. Pixels skipped by the delta codes of RLE images are not drawn.
+package bmp
+
+import (
+	"bufio"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"math/bits"
+)
+
+// A FormatError reports that the input is not a valid BMP.
+type FormatError string
+
+func (e FormatError) Error() string { return "bmp: invalid format: " + string(e) }
+
+// An UnsupportedError reports that the input uses a valid but unimplemented
+// BMP feature.
+type UnsupportedError string
+
+func (e UnsupportedError) Error() string { return "bmp: unsupported feature: " + string(e) }
+
+var errNoBuffer = errors.New("bmp: no callback buffer")
+
+// Compression methods.
+const (
+	biRGB            = 0
+	biRLE8           = 1
+	biRLE4           = 2
+	biBitFields      = 3
+	biAlphaBitFields = 6
+)
+
+const fileHeaderLen = 14
+
+type reader interface {
+	io.Reader
+	io.ByteReader
+}
+
+type decoder struct {
+	r reader
+
+	width, height int
+	topDown       bool
+	bpp           int
+	compression   uint32
+	dataOffset    int
+	read          int // bytes read before the pixel data
+
+	palette [256]uint16
+	colors  color.Palette // only read by DecodeConfig
+	masks   [3]mask
+
+	tmp [1024]byte
+}
+
+// mask extracts a color channel from a 16 or 32 bit pixel.
+type mask struct {
+	shift, bits uint
+}
+
+func newMask(m uint32) mask {
+	if m == 0 {
+		return mask{}
+	}
+	shift := uint(bits.TrailingZeros32(m))
+	return mask{shift: shift, bits: uint(bits.OnesCount32(m >> shift))}
+}
+
+// value returns the channel of v scaled to 8 bits.
+func (m mask) value(v uint32) uint8 {
+	if m.bits == 0 {
+		return 0
+	}
+	x := v >> m.shift & (1<<m.bits - 1)
+	if m.bits >= 8 {
+		return uint8(x >> (m.bits - 8))
+	}
+	return uint8(x * 255 / (1<<m.bits - 1))
+}
+
+func newDecoder(r io.Reader) *decoder {
+	d := &decoder{}
+	if rr, ok := r.(reader); ok {
+		d.r = rr
+	} else {
+		d.r = bufio.NewReaderSize(r, 256)
+	}
+	return d
+}
+
+func le16(b []byte) uint32 { return uint32(b[0]) | uint32(b[1])<<8 }
+
+func le32(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+}
+
+func rgb565(r, g, b uint8) uint16 {
+	return uint16(r&0xF8)<<8 | uint16(g&0xFC)<<3 | uint16(b)>>3
+}
+
+// readHeader reads the file and DIB headers, the bit field masks and the
+// color table.
+func (d *decoder) readHeader(config bool) error {
+	b := d.tmp[:]
+	if _, err := io.ReadFull(d.r, b[:fileHeaderLen+4]); err != nil {
+		return err
+	}
+	if b[0] != 'B' || b[1] != 'M' {
+		return FormatError("not a BMP file")
+	}
+	d.dataOffset = int(le32(b[10:]))
+	size := int(le32(b[14:]))
+	switch size {
+	case 12, 40, 52, 56, 108, 124:
+	default:
+		return UnsupportedError("DIB header size")
+	}
+	h := b[fileHeaderLen : fileHeaderLen+size]
+	if _, err := io.ReadFull(d.r, h[4:]); err != nil {
+		return err
+	}
+	d.read = fileHeaderLen + size
+
+	var planes uint32
+	if size == 12 {
+		d.width, d.height = int(int16(le16(h[4:]))), int(int16(le16(h[6:])))
+		planes, d.bpp = le16(h[8:]), int(le16(h[10:]))
+	} else {
+		d.width, d.height = int(int32(le32(h[4:]))), int(int32(le32(h[8:])))
+		planes, d.bpp = le16(h[12:]), int(le16(h[14:]))
+		d.compression = le32(h[16:])
+	}
+	if planes != 1 {
+		return FormatError("planes")
+	}
+	if d.height < 0 {
+		d.height, d.topDown = -d.height, true
+	}
+	if d.width <= 0 || d.height == 0 {
+		return FormatError("image size")
+	}
+	if d.width > 0x7FFF || d.height > 0x7FFF {
+		return UnsupportedError("image size")
+	}
+
+	switch d.compression {
+	case biRGB:
+		switch d.bpp {
+		case 1, 4, 8, 24:
+		case 16:
+			d.masks = [3]mask{newMask(0x7C00), newMask(0x03E0), newMask(0x001F)}
+		case 32:
+			d.masks = [3]mask{newMask(0xFF0000), newMask(0xFF00), newMask(0xFF)}
+		default:
+			return UnsupportedError("bits per pixel")
+		}
+	case biRLE8, biRLE4:
+		if d.bpp != 8 && d.compression == biRLE8 || d.bpp != 4 && d.compression == biRLE4 {
+			return FormatError("bits per pixel of RLE image")
+		}
+		if d.topDown {
+			return FormatError("top-down RLE image")
+		}
+	case biBitFields, biAlphaBitFields:
+		if d.bpp != 16 && d.bpp != 32 {
+			return FormatError("bits per pixel of bit fields")
+		}
+		m := h[40:]
+		if size == 40 {
+			// The masks follow the header.
+			m = b[fileHeaderLen+40 : fileHeaderLen+52]
+			if _, err := io.ReadFull(d.r, m); err != nil {
+				return err
+			}
+			d.read += 12
+		}
+		d.masks = [3]mask{newMask(le32(m)), newMask(le32(m[4:])), newMask(le32(m[8:]))}
+	default:
+		return UnsupportedError("compression")
+	}
+
+	if d.bpp <= 8 {
+		n := 1 << d.bpp
+		if size > 12 {
+			// Checked as uint32, as int is 32 bits on some targets.
+			if used := le32(h[32:]); used > uint32(n) {
+				return FormatError("color table size")
+			} else if used != 0 {
+				n = int(used)
+			}
+		}
+		if err := d.readColorTable(n, size == 12, config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readColorTable reads n colors, of 3 bytes in old OS/2 files and 4 bytes
+// otherwise, and converts them to RGB565.
+func (d *decoder) readColorTable(n int, core, config bool) error {
+	entry := 4
+	if core {
+		entry = 3
+	}
+	b := d.tmp[:n*entry]
+	if _, err := io.ReadFull(d.r, b); err != nil {
+		return err
+	}
+	d.read += len(b)
+	if config {
+		d.colors = make(color.Palette, n)
+	}
+	for i := 0; i < n; i++ {
+		c := b[i*entry:]
+		d.palette[i] = rgb565(c[2], c[1], c[0])
+		if config {
+			d.colors[i] = color.RGBA{c[2], c[1], c[0], 0xff}
+		}
+	}
+	return nil
+}
+
+// skipToData skips the bytes between the headers and the pixel data.
+func (d *decoder) skipToData() error {
+	if d.dataOffset < d.read {
+		return FormatError("pixel data offset")
+	}
+	for n := d.dataOffset - d.read; n > 0; {
+		m := n
+		if m > len(d.tmp) {
+			m = len(d.tmp)
+		}
+		if _, err := io.ReadFull(d.r, d.tmp[:m]); err != nil {
+			return err
+		}
+		n -= m
+	}
+	return nil
+}
+
+// pixel returns the pixel x of an uncompressed row.
+func (d *decoder) pixel(row []byte, x int) uint16 {
+	switch d.bpp {
+	case 1:
+		return d.palette[row[x/8]>>(7-x%8)&1]
+	case 4:
+		return d.palette[row[x/2]>>(4*(1-x%2))&0x0F]
+	case 8:
+		return d.palette[row[x]]
+	case 24:
+		return rgb565(row[3*x+2], row[3*x+1], row[3*x])
+	}
+	var v uint32
+	if d.bpp == 16 {
+		v = le16(row[2*x:])
+	} else {
+		v = le32(row[4*x:])
+	}
+	return rgb565(d.masks[0].value(v), d.masks[1].value(v), d.masks[2].value(v))
+}
+
+func (d *decoder) decodeRows() error {
+	stride := (d.width*d.bpp + 31) / 32 * 4
+	row := make([]byte, stride)
+	for i := 0; i < d.height; i++ {
+		if _, err := io.ReadFull(d.r, row); err != nil {
+			return err
+		}
+		y := i
+		if !d.topDown {
+			y = d.height - 1 - i
+		}
+		for x := 0; x < d.width; {
+			n := 0
+			for x+n < d.width && n < len(callbackBuf) {
+				callbackBuf[n] = d.pixel(row, x+n)
+				n++
+			}
+			callback(callbackBuf[:n], int16(x), int16(y), int16(n), 1, int16(d.width), int16(d.height))
+			x += n
+		}
+	}
+	return nil
+}
+
+// decodeRLE decodes a 4 or 8 bit RLE image. The color indices of a row are
+// collected in index, and drawn when the row ends.
+func (d *decoder) decodeRLE() error {
+	index := make([]byte, d.width)
+	drawn := make([]bool, d.width)
+	x, y := 0, 0 // y counts rows from the bottom
+	set := func(c byte) {
+		if x < d.width {
+			index[x], drawn[x] = c, true
+		}
+		x++
+	}
+	flush := func() {
+		if y < d.height {
+			d.drawRLERow(d.height-1-y, index, drawn)
+		}
+		for i := range drawn {
+			drawn[i] = false
+		}
+	}
+	rle4 := d.compression == biRLE4
+	b := d.tmp[:]
+	for y < d.height {
+		if _, err := io.ReadFull(d.r, b[:2]); err != nil {
+			return err
+		}
+		n, c := int(b[0]), b[1]
+		if n > 0 {
+			// Encoded mode: a run of n pixels.
+			for i := 0; i < n; i++ {
+				if !rle4 {
+					set(c)
+				} else if i%2 == 0 {
+					set(c >> 4)
+				} else {
+					set(c & 0x0F)
+				}
+			}
+			continue
+		}
+		switch c {
+		case 0: // end of line
+			flush()
+			x, y = 0, y+1
+		case 1: // end of bitmap
+			flush()
+			return nil
+		case 2: // delta
+			if _, err := io.ReadFull(d.r, b[:2]); err != nil {
+				return err
+			}
+			if b[1] > 0 {
+				flush()
+				y += int(b[1])
+			}
+			x += int(b[0])
+		default:
+			// Absolute mode: c pixels, padded to a 16 bit boundary.
+			n = int(c)
+			size := n
+			if rle4 {
+				size = (n + 1) / 2
+			}
+			size += size % 2
+			if _, err := io.ReadFull(d.r, b[:size]); err != nil {
+				return err
+			}
+			for i := 0; i < n; i++ {
+				if !rle4 {
+					set(b[i])
+				} else if i%2 == 0 {
+					set(b[i/2] >> 4)
+				} else {
+					set(b[i/2] & 0x0F)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// drawRLERow passes the runs of drawn pixels of a row to the callback.
+func (d *decoder) drawRLERow(y int, index []byte, drawn []bool) {
+	for x := 0; x < len(index); {
+		if !drawn[x] {
+			x++
+			continue
+		}
+		n := 0
+		for x+n < len(index) && drawn[x+n] && n < len(callbackBuf) {
+			callbackBuf[n] = d.palette[index[x+n]]
+			n++
+		}
+		callback(callbackBuf[:n], int16(x), int16(y), int16(n), 1, int16(d.width), int16(d.height))
+		x += n
+	}
+}
+
+// Decode reads a BMP image from r. Different from the standard package, the
+// decoded result will be received by the callback set by SetCallback().
+func Decode(r io.Reader) (image.Image, error) {
+	if len(callbackBuf) == 0 {
+		return nil, errNoBuffer
+	}
+	d := newDecoder(r)
+	if err := d.readHeader(false); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if err := d.skipToData(); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	var err error
+	if d.compression == biRLE8 || d.compression == biRLE4 {
+		err = d.decodeRLE()
+	} else {
+		err = d.decodeRows()
+	}
+	return nil, unexpectedEOF(err)
+}
+
+// DecodeConfig returns the color model and dimensions of a BMP image without
+// decoding the entire image.
+func DecodeConfig(r io.Reader) (image.Config, error) {
+	d := newDecoder(r)
+	if err := d.readHeader(true); err != nil {
+		return image.Config{}, unexpectedEOF(err)
+	}
+	var model color.Model = color.RGBAModel
+	if d.colors != nil {
+		model = d.colors
+	}
+	return image.Config{
+		ColorModel: model,
+		Width:      d.width,
+		Height:     d.height,
+	}, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,192 @@
+package bmp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image/color"
+	"io"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const (
+	k = 0x0000
+	r = 0xF800
+	g = 0x07E0
+	b = 0x001F
+	w = 0xFFFF
+)
+
+// canvas collects the pixels passed to the callback.
+type canvas struct {
+	W   int
+	Pix []uint16
+}
+
+func decode(c *qt.C, data []byte, width, height int) []uint16 {
+	cv := &canvas{W: width, Pix: make([]uint16, width*height)}
+	for i := range cv.Pix {
+		cv.Pix[i] = 0x1234
+	}
+	// A buffer smaller than a row splits it.
+	SetCallback(make([]uint16, 3), func(data []uint16, x, y, w, h, width, height int16) {
+		c.Assert(len(data), qt.Equals, int(w)*int(h))
+		copy(cv.Pix[int(y)*cv.W+int(x):], data)
+	})
+	_, err := Decode(bytes.NewReader(data))
+	c.Assert(err, qt.IsNil)
+	return cv.Pix
+}
+
+// file returns a BMP file with a 40 byte header.
+func file(width, height, bpp, compression int, extra, pixels []byte) []byte {
+	var buf bytes.Buffer
+	le := binary.LittleEndian
+	offset := 14 + 40 + len(extra)
+	buf.WriteString("BM")
+	binary.Write(&buf, le, uint32(offset+len(pixels)))
+	binary.Write(&buf, le, uint32(0))
+	binary.Write(&buf, le, uint32(offset))
+	binary.Write(&buf, le, []int32{40, int32(width), int32(height)})
+	binary.Write(&buf, le, []uint16{1, uint16(bpp)})
+	used := 0
+	if bpp <= 8 {
+		used = len(extra) / 4
+	}
+	binary.Write(&buf, le, []uint32{uint32(compression), uint32(len(pixels)), 2835, 2835, uint32(used), 0})
+	buf.Write(extra)
+	buf.Write(pixels)
+	return buf.Bytes()
+}
+
+// colors is a color table of black, red, green, blue and white.
+var colors = []byte{0, 0, 0, 0, 0, 0, 255, 0, 0, 255, 0, 0, 255, 0, 0, 0, 255, 255, 255, 0}
+
+func TestDecodeFormats(t *testing.T) {
+	c := qt.New(t)
+	red, green, blue := color.RGBA{255, 0, 0, 255}, color.RGBA{0, 255, 0, 255}, color.RGBA{0, 0, 255, 255}
+	white, black := color.RGBA{255, 255, 255, 255}, color.RGBA{0, 0, 0, 255}
+	pixels := []color.RGBA{red, green, blue, white, black, black, white, blue, green, red}
+	want := []uint16{r, g, b, w, k, k, w, b, g, r}
+
+	// Bottom-up rows of 24 bit BGR and 32 bit BGRX pixels, and of indexes
+	// in colors, padded to 4 bytes.
+	var rgb, rgbx []byte
+	for y := 1; y >= 0; y-- {
+		for _, p := range pixels[y*5 : y*5+5] {
+			rgb = append(rgb, p.B, p.G, p.R)
+			rgbx = append(rgbx, p.B, p.G, p.R, 0)
+		}
+		rgb = append(rgb, 0)
+	}
+	index := []byte{0, 4, 3, 2, 1, 0, 0, 0, 1, 2, 3, 4, 0, 0, 0, 0}
+
+	c.Assert(decode(c, file(5, 2, 24, biRGB, nil, rgb), 5, 2), qt.DeepEquals, want)
+	c.Assert(decode(c, file(5, 2, 32, biRGB, nil, rgbx), 5, 2), qt.DeepEquals, want)
+	c.Assert(decode(c, file(5, 2, 8, biRGB, colors, index), 5, 2), qt.DeepEquals, want)
+
+	cfg, err := DecodeConfig(bytes.NewReader(file(5, 2, 8, biRGB, colors, index)))
+	c.Assert(err, qt.IsNil)
+	c.Assert(cfg.Width, qt.Equals, 5)
+	c.Assert(cfg.Height, qt.Equals, 2)
+	c.Assert(cfg.ColorModel.(color.Palette)[1], qt.Equals, color.Color(color.RGBA{255, 0, 0, 255}))
+}
+
+func TestDecodeBits(t *testing.T) {
+	c := qt.New(t)
+
+	// 1 bit, bottom-up, with 2 colors.
+	data := file(10, 2, 1, biRGB, colors[:8], []byte{
+		0b10000000, 0b01000000, 0, 0,
+		0b01010101, 0b11000000, 0, 0,
+	})
+	c.Assert(decode(c, data, 10, 2), qt.DeepEquals, []uint16{
+		k, r, k, r, k, r, k, r, r, r,
+		r, k, k, k, k, k, k, k, k, r,
+	})
+
+	// 4 bit, top-down.
+	data = file(3, -2, 4, biRGB, colors, []byte{
+		0x12, 0x30, 0, 0,
+		0x40, 0x10, 0, 0,
+	})
+	c.Assert(decode(c, data, 3, 2), qt.DeepEquals, []uint16{r, g, b, w, k, r})
+
+	// 16 bit, 5-5-5 by default.
+	data = file(3, 1, 16, biRGB, nil, []byte{0x00, 0x7C, 0xE0, 0x03, 0x1F, 0x00, 0, 0})
+	c.Assert(decode(c, data, 3, 1), qt.DeepEquals, []uint16{r, g, b})
+
+	// 16 bit, 5-6-5 bit fields.
+	masks := []byte{0x00, 0xF8, 0, 0, 0xE0, 0x07, 0, 0, 0x1F, 0, 0, 0}
+	data = file(2, 1, 16, biBitFields, masks, []byte{0x00, 0xF8, 0x20, 0x00})
+	c.Assert(decode(c, data, 2, 1), qt.DeepEquals, []uint16{r, 0x0020})
+}
+
+func TestDecodeRLE(t *testing.T) {
+	c := qt.New(t)
+
+	// 8 bit: a run, an absolute run padded to 16 bits and cut at the end of
+	// the row, then a delta skipping a row.
+	data := file(5, 3, 8, biRLE8, colors, []byte{
+		3, 1, 0, 3, 2, 3, 4, 0, 0, 0,
+		0, 2, 4, 1,
+		1, 3, 0, 1,
+	})
+	const u = 0x1234 // not drawn
+	c.Assert(decode(c, data, 5, 3), qt.DeepEquals, []uint16{
+		u, u, u, u, b,
+		u, u, u, u, u,
+		r, r, r, g, b,
+	})
+
+	// 4 bit: runs alternate 2 colors.
+	data = file(6, 1, 4, biRLE4, colors, []byte{
+		5, 0x12, 0, 3, 0x34, 0x10, 0, 1,
+	})
+	c.Assert(decode(c, data, 6, 1), qt.DeepEquals, []uint16{r, g, r, g, r, b})
+	data = file(5, 1, 4, biRLE4, colors, []byte{2, 0x43, 0, 3, 0x21, 0x00, 0, 1})
+	c.Assert(decode(c, data, 5, 1), qt.DeepEquals, []uint16{w, b, g, r, k})
+}
+
+func TestDecodeErrors(t *testing.T) {
+	c := qt.New(t)
+	SetCallback(make([]uint16, 8), func(data []uint16, x, y, w, h, width, height int16) {})
+	data := file(4, 4, 24, biRGB, nil, make([]byte, 48))
+	_, err := Decode(bytes.NewReader(data[:len(data)-1]))
+	c.Assert(err, qt.Equals, io.ErrUnexpectedEOF)
+	_, err = Decode(bytes.NewReader(data[:20]))
+	c.Assert(err, qt.Equals, io.ErrUnexpectedEOF)
+	_, err = Decode(bytes.NewReader(append([]byte("XX"), data[2:]...)))
+	c.Assert(err, qt.ErrorMatches, "bmp: invalid format: not a BMP file")
+	_, err = Decode(bytes.NewReader(file(4, 4, 2, biRGB, nil, nil)))
+	c.Assert(err, qt.ErrorMatches, "bmp: unsupported feature: bits per pixel")
+	_, err = Decode(bytes.NewReader(file(4, -4, 8, biRLE8, colors, nil)))
+	c.Assert(err, qt.ErrorMatches, "bmp: invalid format: top-down RLE image")
+
+	// Color table sizes that are too large, or negative as int32.
+	for _, used := range []uint32{17, 0x80000000, 0xFFFFFFFF} {
+		data = file(2, 2, 4, biRGB, colors, make([]byte, 8))
+		binary.LittleEndian.PutUint32(data[14+32:], used)
+		_, err = Decode(bytes.NewReader(data))
+		c.Assert(err, qt.ErrorMatches, "bmp: invalid format: color table size")
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add(file(3, -2, 4, biRGB, colors, []byte{0x12, 0x30, 0, 0, 0x40, 0x10, 0, 0}))
+	f.Add(file(5, 3, 8, biRLE8, colors, []byte{3, 1, 0, 3, 2, 3, 4, 0, 0, 0, 0, 2, 4, 1, 1, 3, 0, 1}))
+	f.Add(file(6, 1, 4, biRLE4, colors, []byte{5, 0x12, 0, 3, 0x34, 0x10, 0, 1}))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		cfg, err := DecodeConfig(bytes.NewReader(data))
+		if err != nil || cfg.Width*cfg.Height > 1e6 {
+			return
+		}
+		SetCallback(make([]uint16, 64), func(data []uint16, x, y, w, h, width, height int16) {
+			if int(x)+int(w) > cfg.Width || int(y)+int(h) > cfg.Height || len(data) != int(w)*int(h) {
+				t.Fatalf("pixels outside of the image: %d,%d %dx%d", x, y, w, h)
+			}
+		})
+		Decode(bytes.NewReader(data))
+	})
+}
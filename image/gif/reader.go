@@ -0,0 +1,460 @@
+// Package gif implements a GIF image decoder, including animated GIFs.
+//
+// Like the png and jpeg packages, the decoded pixels are not returned as an
+// image.Image but passed in RGB565 to the callback set by SetCallback, a few
+// pixels at a time, so images can be drawn without holding them in RAM.
+// Transparent pixels are skipped, so they show what was drawn before.
+package gif
+
+import (
+	"bufio"
+	"compress/lzw"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"time"
+)
+
+// A FormatError reports that the input is not a valid GIF.
+type FormatError string
+
+func (e FormatError) Error() string { return "gif: invalid format: " + string(e) }
+
+var errNoBuffer = errors.New("gif: no callback buffer")
+
+// Disposal methods of a frame, which say what is done with it before the
+// next frame is drawn.
+const (
+	DisposalNone       = 0x01
+	DisposalBackground = 0x02
+	DisposalPrevious   = 0x03
+)
+
+// Frame describes a frame of an animated GIF.
+type Frame struct {
+	// Index of the frame, from 0.
+	Index int
+
+	// X, Y, W and H are the rectangle of the image covered by the frame.
+	X, Y, W, H int16
+
+	// Delay is the time the frame should be shown.
+	Delay time.Duration
+
+	// Disposal is the disposal method of the frame. DisposalBackground fills
+	// the frame with the background color once it was shown. Restoring the
+	// previous content would need a copy of the image in RAM, so
+	// DisposalPrevious is handled like DisposalNone.
+	Disposal byte
+}
+
+const (
+	fColorTable     = 1 << 7
+	fInterlace      = 1 << 6
+	fColorTableSize = 7
+
+	gcTransparent = 0x01
+
+	sExtension       = 0x21
+	sImageDescriptor = 0x2C
+	sTrailer         = 0x3B
+
+	eGraphicControl = 0xF9
+)
+
+type reader interface {
+	io.Reader
+	io.ByteReader
+}
+
+type decoder struct {
+	r reader
+
+	width, height int
+	background    byte
+	global        [256]uint16
+	local         [256]uint16
+	globalSize    int
+
+	// Graphic control of the next frame.
+	delay       time.Duration
+	disposal    byte
+	transparent int
+
+	tmp    [768]byte
+	blocks blockReader
+	lzw    io.ReadCloser
+	row    []byte
+}
+
+func newDecoder(r io.Reader) *decoder {
+	d := &decoder{transparent: -1}
+	if rr, ok := r.(reader); ok {
+		d.r = rr
+	} else {
+		d.r = bufio.NewReaderSize(r, 256)
+	}
+	d.blocks.r = d.r
+	return d
+}
+
+// readHeader reads the header, the logical screen descriptor and the global
+// color table.
+func (d *decoder) readHeader() error {
+	if _, err := io.ReadFull(d.r, d.tmp[:13]); err != nil {
+		return err
+	}
+	version := string(d.tmp[:6])
+	if version != "GIF87a" && version != "GIF89a" {
+		return FormatError("not a GIF file")
+	}
+	d.width = int(d.tmp[6]) | int(d.tmp[7])<<8
+	d.height = int(d.tmp[8]) | int(d.tmp[9])<<8
+	d.background = d.tmp[11]
+	if flags := d.tmp[10]; flags&fColorTable != 0 {
+		n, err := d.readColorTable(flags, &d.global)
+		if err != nil {
+			return err
+		}
+		d.globalSize = n
+	}
+	return nil
+}
+
+// readColorTable reads a color table, and converts it to RGB565.
+func (d *decoder) readColorTable(flags byte, table *[256]uint16) (int, error) {
+	n := 1 << (1 + uint(flags&fColorTableSize))
+	if _, err := io.ReadFull(d.r, d.tmp[:3*n]); err != nil {
+		return 0, err
+	}
+	for i := 0; i < n; i++ {
+		table[i] = rgb565(d.tmp[3*i], d.tmp[3*i+1], d.tmp[3*i+2])
+	}
+	for i := n; i < len(table); i++ {
+		table[i] = 0
+	}
+	return n, nil
+}
+
+func rgb565(r, g, b uint8) uint16 {
+	return uint16(r&0xF8)<<8 | uint16(g&0xFC)<<3 | uint16(b)>>3
+}
+
+// next reads blocks up to the next frame, and returns io.EOF at the end of
+// the file.
+func (d *decoder) next() (Frame, error) {
+	for {
+		c, err := d.r.ReadByte()
+		if err != nil {
+			return Frame{}, err
+		}
+		switch c {
+		case sExtension:
+			if err := d.readExtension(); err != nil {
+				return Frame{}, err
+			}
+		case sImageDescriptor:
+			return d.readImageDescriptor()
+		case sTrailer:
+			return Frame{}, io.EOF
+		default:
+			return Frame{}, FormatError("unknown block type")
+		}
+	}
+}
+
+func (d *decoder) readExtension() error {
+	label, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if label == eGraphicControl {
+		if _, err := io.ReadFull(d.r, d.tmp[:6]); err != nil {
+			return err
+		}
+		if d.tmp[0] != 4 || d.tmp[5] != 0 {
+			return FormatError("invalid graphic control extension")
+		}
+		flags := d.tmp[1]
+		d.disposal = (flags >> 2) & 7
+		d.delay = time.Duration(int(d.tmp[2])|int(d.tmp[3])<<8) * 10 * time.Millisecond
+		d.transparent = -1
+		if flags&gcTransparent != 0 {
+			d.transparent = int(d.tmp[4])
+		}
+		return nil
+	}
+	// Skip the other extensions: comments, plain text and application
+	// extensions such as the loop count.
+	d.blocks.reset()
+	return d.blocks.skip()
+}
+
+func (d *decoder) readImageDescriptor() (Frame, error) {
+	if _, err := io.ReadFull(d.r, d.tmp[:9]); err != nil {
+		return Frame{}, err
+	}
+	f := Frame{
+		X:        int16(int(d.tmp[0]) | int(d.tmp[1])<<8),
+		Y:        int16(int(d.tmp[2]) | int(d.tmp[3])<<8),
+		W:        int16(int(d.tmp[4]) | int(d.tmp[5])<<8),
+		H:        int16(int(d.tmp[6]) | int(d.tmp[7])<<8),
+		Delay:    d.delay,
+		Disposal: d.disposal,
+	}
+	flags := d.tmp[8]
+	palette := &d.global
+	if flags&fColorTable != 0 {
+		if _, err := d.readColorTable(flags, &d.local); err != nil {
+			return Frame{}, err
+		}
+		palette = &d.local
+	} else if d.globalSize == 0 {
+		return Frame{}, FormatError("no color table")
+	}
+	if err := d.readPixels(f, flags&fInterlace != 0, palette); err != nil {
+		return Frame{}, err
+	}
+	d.delay, d.disposal, d.transparent = 0, 0, -1
+	return f, nil
+}
+
+// readPixels decodes the pixels of a frame, row by row.
+func (d *decoder) readPixels(f Frame, interlaced bool, palette *[256]uint16) error {
+	litWidth, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if litWidth < 2 || litWidth > 8 {
+		return FormatError("invalid LZW code size")
+	}
+	d.blocks.reset()
+	if d.lzw == nil {
+		d.lzw = lzw.NewReader(&d.blocks, lzw.LSB, int(litWidth))
+	} else {
+		d.lzw.(*lzw.Reader).Reset(&d.blocks, lzw.LSB, int(litWidth))
+	}
+	w, h := int(uint16(f.W)), int(uint16(f.H))
+	if cap(d.row) < w {
+		d.row = make([]byte, w)
+	}
+	row := d.row[:w]
+
+	// Interlaced frames have 4 passes over the rows.
+	passes := [][2]int{{0, 1}}
+	if interlaced {
+		passes = [][2]int{{0, 8}, {4, 8}, {2, 4}, {1, 2}}
+	}
+	for _, pass := range passes {
+		for y := pass[0]; y < h; y += pass[1] {
+			if _, err := io.ReadFull(d.lzw, row); err != nil {
+				if err == io.EOF || err == io.ErrUnexpectedEOF {
+					return FormatError("not enough image data")
+				}
+				return err
+			}
+			d.drawRow(int(uint16(f.X)), int(uint16(f.Y))+y, row, palette)
+		}
+	}
+	// Ignore the data after the pixels.
+	return d.blocks.skip()
+}
+
+// drawRow passes the opaque pixels of a row inside the image to the
+// callback.
+func (d *decoder) drawRow(x, y int, row []byte, palette *[256]uint16) {
+	if y >= d.height {
+		return
+	}
+	if x+len(row) > d.width {
+		if x >= d.width {
+			return
+		}
+		row = row[:d.width-x]
+	}
+	for i := 0; i < len(row); {
+		if int(row[i]) == d.transparent {
+			i++
+			continue
+		}
+		n := 0
+		for i+n < len(row) && int(row[i+n]) != d.transparent && n < len(callbackBuf) {
+			callbackBuf[n] = palette[row[i+n]]
+			n++
+		}
+		callback(callbackBuf[:n], int16(x+i), int16(y), int16(n), 1, int16(d.width), int16(d.height))
+		i += n
+	}
+}
+
+// dispose applies the disposal method of a frame that was shown.
+func (d *decoder) dispose(f Frame) {
+	if f.Disposal != DisposalBackground {
+		return
+	}
+	bg := d.global[d.background]
+	x0, y0 := int(uint16(f.X)), int(uint16(f.Y))
+	x1, y1 := x0+int(uint16(f.W)), y0+int(uint16(f.H))
+	if x1 > d.width {
+		x1 = d.width
+	}
+	if y1 > d.height {
+		y1 = d.height
+	}
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; {
+			n := 0
+			for x+n < x1 && n < len(callbackBuf) {
+				callbackBuf[n] = bg
+				n++
+			}
+			callback(callbackBuf[:n], int16(x), int16(y), int16(n), 1, int16(d.width), int16(d.height))
+			x += n
+		}
+	}
+}
+
+// Decode reads the first frame of a GIF image from r. Different from the
+// standard package, the decoded result will be received by the callback set
+// by SetCallback().
+func Decode(r io.Reader) (image.Image, error) {
+	if len(callbackBuf) == 0 {
+		return nil, errNoBuffer
+	}
+	d := newDecoder(r)
+	if err := d.readHeader(); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if _, err := d.next(); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return nil, nil
+}
+
+// DecodeAll reads all the frames of an animated GIF image from r, passing
+// them to the callback set by SetCallback(). After every frame, frame is
+// called to show it: it should update the display and wait for its delay.
+// The disposal of the frame is done when it returns. Decoding stops if it
+// returns an error.
+func DecodeAll(r io.Reader, frame func(f Frame) error) error {
+	if len(callbackBuf) == 0 {
+		return errNoBuffer
+	}
+	d := newDecoder(r)
+	if err := d.readHeader(); err != nil {
+		return unexpectedEOF(err)
+	}
+	for i := 0; ; i++ {
+		f, err := d.next()
+		if err == io.EOF {
+			if i == 0 {
+				return FormatError("no frame")
+			}
+			return nil
+		}
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		f.Index = i
+		if err := frame(f); err != nil {
+			return err
+		}
+		d.dispose(f)
+	}
+}
+
+// DecodeConfig returns the global color table and dimensions of a GIF image
+// without decoding the entire image.
+func DecodeConfig(r io.Reader) (image.Config, error) {
+	d := newDecoder(r)
+	if err := d.readHeader(); err != nil {
+		return image.Config{}, unexpectedEOF(err)
+	}
+	palette := make(color.Palette, d.globalSize)
+	for i := range palette {
+		palette[i] = color.RGBA{d.tmp[3*i], d.tmp[3*i+1], d.tmp[3*i+2], 0xff}
+	}
+	return image.Config{
+		ColorModel: palette,
+		Width:      d.width,
+		Height:     d.height,
+	}, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// blockReader reads the data sub-blocks following an extension or an image
+// descriptor.
+type blockReader struct {
+	r    reader
+	buf  [255]byte
+	i, n int
+	eof  bool
+}
+
+func (b *blockReader) reset() {
+	b.i, b.n, b.eof = 0, 0, false
+}
+
+// fill reads the next sub-block.
+func (b *blockReader) fill() error {
+	if b.eof {
+		return io.EOF
+	}
+	size, err := b.r.ReadByte()
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if size == 0 {
+		b.eof = true
+		return io.EOF
+	}
+	if _, err := io.ReadFull(b.r, b.buf[:size]); err != nil {
+		return unexpectedEOF(err)
+	}
+	b.i, b.n = 0, int(size)
+	return nil
+}
+
+func (b *blockReader) ReadByte() (byte, error) {
+	for b.i == b.n {
+		if err := b.fill(); err != nil {
+			return 0, err
+		}
+	}
+	c := b.buf[b.i]
+	b.i++
+	return c, nil
+}
+
+func (b *blockReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for b.i == b.n {
+		if err := b.fill(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, b.buf[b.i:b.n])
+	b.i += n
+	return n, nil
+}
+
+// skip reads the remaining sub-blocks up to the block terminator.
+func (b *blockReader) skip() error {
+	for {
+		b.i = b.n
+		if err := b.fill(); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
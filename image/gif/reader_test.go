@@ -0,0 +1,145 @@
+package gif
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	stdgif "image/gif"
+	"io"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+var palette = color.Palette{
+	color.RGBA{0, 0, 0, 255},
+	color.RGBA{255, 0, 0, 255},
+	color.RGBA{0, 255, 0, 255},
+	color.RGBA{0, 0, 255, 255},
+}
+
+// canvas collects the pixels passed to the callback.
+type canvas struct {
+	W, H  int
+	Pix   []uint16
+	Calls int
+}
+
+func newCanvas(w, h int) *canvas {
+	c := &canvas{W: w, H: h, Pix: make([]uint16, w*h)}
+	for i := range c.Pix {
+		c.Pix[i] = 0xFFFF
+	}
+	return c
+}
+
+func (c *canvas) callback(data []uint16, x, y, w, h, width, height int16) {
+	c.Calls++
+	for j := 0; j < int(h); j++ {
+		copy(c.Pix[(int(y)+j)*c.W+int(x):], data[j*int(w):(j+1)*int(w)])
+	}
+}
+
+// frame returns a paletted frame with the color index of every pixel.
+func frame(r image.Rectangle, pix ...uint8) *image.Paletted {
+	p := image.NewPaletted(r, palette)
+	copy(p.Pix, pix)
+	return p
+}
+
+func TestDecode(t *testing.T) {
+	c := qt.New(t)
+	var buf bytes.Buffer
+	err := stdgif.Encode(&buf, frame(image.Rect(0, 0, 3, 2), 0, 1, 2, 3, 2, 1), nil)
+	c.Assert(err, qt.IsNil)
+
+	cfg, err := DecodeConfig(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, qt.IsNil)
+	c.Assert(cfg.Width, qt.Equals, 3)
+	c.Assert(cfg.Height, qt.Equals, 2)
+
+	cv := newCanvas(3, 2)
+	// A buffer smaller than a row splits it.
+	SetCallback(make([]uint16, 2), cv.callback)
+	_, err = Decode(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, qt.IsNil)
+	c.Assert(cv.Pix, qt.DeepEquals, []uint16{0x0000, 0xF800, 0x07E0, 0x001F, 0x07E0, 0xF800})
+	c.Assert(cv.Calls, qt.Equals, 4)
+}
+
+func TestDecodeAll(t *testing.T) {
+	c := qt.New(t)
+	anim := &stdgif.GIF{
+		Image: []*image.Paletted{
+			frame(image.Rect(0, 0, 4, 2), 1, 1, 1, 1, 1, 1, 1, 1),
+			// Index 0 is transparent in the second frame.
+			frame(image.Rect(1, 0, 3, 2), 3, 0, 0, 3),
+			frame(image.Rect(0, 1, 2, 2), 2, 2),
+		},
+		Delay:    []int{10, 25, 5},
+		Disposal: []byte{DisposalNone, DisposalBackground, DisposalNone},
+		Config:   image.Config{ColorModel: palette, Width: 4, Height: 2},
+	}
+	anim.Image[1].Palette = color.Palette{color.RGBA{}, palette[1], palette[2], palette[3]}
+	var buf bytes.Buffer
+	c.Assert(stdgif.EncodeAll(&buf, anim), qt.IsNil)
+
+	cv := newCanvas(4, 2)
+	SetCallback(make([]uint16, 16), cv.callback)
+	var frames []Frame
+	var shown [][]uint16
+	err := DecodeAll(&buf, func(f Frame) error {
+		frames = append(frames, f)
+		shown = append(shown, append([]uint16(nil), cv.Pix...))
+		return nil
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(frames, qt.DeepEquals, []Frame{
+		{Index: 0, X: 0, Y: 0, W: 4, H: 2, Delay: 100 * time.Millisecond, Disposal: DisposalNone},
+		{Index: 1, X: 1, Y: 0, W: 2, H: 2, Delay: 250 * time.Millisecond, Disposal: DisposalBackground},
+		{Index: 2, X: 0, Y: 1, W: 2, H: 1, Delay: 50 * time.Millisecond, Disposal: DisposalNone},
+	})
+	const r, b, g, bg = 0xF800, 0x001F, 0x07E0, 0x0000
+	c.Assert(shown, qt.DeepEquals, [][]uint16{
+		{r, r, r, r, r, r, r, r},
+		// The transparent pixels show the previous frame.
+		{r, b, r, r, r, r, b, r},
+		// The second frame was cleared to the background color.
+		{r, bg, bg, r, g, g, bg, r},
+	})
+}
+
+func TestDecodeErrors(t *testing.T) {
+	c := qt.New(t)
+	var buf bytes.Buffer
+	err := stdgif.Encode(&buf, frame(image.Rect(0, 0, 16, 16)), nil)
+	c.Assert(err, qt.IsNil)
+	data := buf.Bytes()
+
+	SetCallback(make([]uint16, 16), func(data []uint16, x, y, w, h, width, height int16) {})
+	_, err = Decode(bytes.NewReader(data[:len(data)/2]))
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = Decode(bytes.NewReader([]byte("GIF88a\x01\x00\x01\x00\x00\x00\x00")))
+	c.Assert(err, qt.ErrorMatches, "gif: invalid format: not a GIF file")
+	_, err = Decode(bytes.NewReader(data[:6]))
+	c.Assert(err, qt.Equals, io.ErrUnexpectedEOF)
+}
+
+func FuzzDecode(f *testing.F) {
+	var buf bytes.Buffer
+	stdgif.Encode(&buf, frame(image.Rect(0, 0, 3, 2), 0, 1, 2, 3, 2, 1), nil)
+	f.Add(buf.Bytes())
+	f.Fuzz(func(t *testing.T, data []byte) {
+		cfg, err := DecodeConfig(bytes.NewReader(data))
+		if err != nil || cfg.Width*cfg.Height > 1e6 {
+			return
+		}
+		SetCallback(make([]uint16, 64), func(data []uint16, x, y, w, h, width, height int16) {
+			if int(x)+int(w) > cfg.Width || int(y)+int(h) > cfg.Height || len(data) != int(w)*int(h) {
+				t.Fatalf("pixels outside of the image: %d,%d %dx%d", x, y, w, h)
+			}
+		})
+		DecodeAll(bytes.NewReader(data), func(f Frame) error { return nil })
+	})
+}
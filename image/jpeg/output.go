@@ -0,0 +1,368 @@
+package jpeg
+
+import (
+	"image"
+	"image/color"
+	"io"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+// DecodeOptions select the part of the image passed to the callbacks and its
+// pixel format.
+type DecodeOptions struct {
+	// Scale divides the width and height of the image by 1, 2, 4 or 8. The
+	// image is scaled while decoding the DCT blocks, which is much faster
+	// than decoding it at full size. Zero means 1.
+	Scale int
+
+	// Crop, if not empty, is the part of the scaled image to decode. The
+	// coordinates passed to the callback are relative to its top left
+	// corner, and the width and height are those of Crop.
+	Crop image.Rectangle
+
+	// Format is the pixel format of the output. RGB565, the default, is
+	// passed to the callback set by SetCallback, the other formats to the
+	// callback set by SetBytesCallback. Gray formats use the luma of the
+	// image without converting it to RGB.
+	Format pixel.Format
+
+	// Dither is the dithering used to convert the image to Format. Error
+	// diffusion needs the pixels in rows, so the MCUs of a row (16 pixels
+	// high at most) are collected in a buffer of 3 bytes per pixel, and the
+	// callbacks receive whole rows: their buffer must hold the width of the
+	// output.
+	Dither pixel.Dither
+}
+
+// DecodeWith reads a JPEG image from r like Decode, scaled, cropped and
+// converted as set by o. A nil o decodes the whole image in RGB565.
+func DecodeWith(r io.Reader, o *DecodeOptions) (image.Image, error) {
+	var d decoder
+	if o != nil {
+		d.opts = *o
+	}
+	switch d.opts.Scale {
+	case 0:
+		d.opts.Scale = 1
+	case 1, 2, 4, 8:
+	default:
+		return nil, UnsupportedError("scale")
+	}
+	if d.opts.Format.BitsPerPixel() == 0 {
+		return nil, UnsupportedError("output format")
+	}
+	if d.opts.Format == pixel.RGB565 && len(callbackBuf) == 0 ||
+		d.opts.Format != pixel.RGB565 && len(bytesCallbackBuf) < d.opts.Format.BufferSize(8) {
+		return nil, errNoBuffer
+	}
+	d.conv = pixel.Converter{Format: d.opts.Format, Dither: d.opts.Dither}
+	_, err := d.decode(r, false)
+	return nil, err
+}
+
+// reducedCos are the tables of the IDCTs giving n pixels per row or column
+// of a block: reducedCos[n][x*8+u] is 4096*C(u) times the average of
+// cos((2k+1)uπ/16) over the 8/n pixels k of output pixel x, with C(0) = 1/√2
+// and C(u) = 1 otherwise. The output pixels are the exact averages of the
+// pixels of the full IDCT.
+var reducedCos = [9][]int32{
+	1: {
+		2896, 0, 0, 0, 0, 0, 0, 0,
+	},
+	2: {
+		2896, 2624, 0, -922, 0, 616, 0, -522,
+		2896, -2624, 0, 922, 0, -616, 0, 522,
+	},
+	4: {
+		2896, 3711, 2676, 1303, 0, -871, -1108, -738,
+		2896, 1537, -2676, -3146, 0, 2102, 1108, -306,
+		2896, -1537, -2676, 3146, 0, -2102, 1108, 306,
+		2896, -3711, 2676, -1303, 0, 871, -1108, 738,
+	},
+	8: {
+		2896, 4017, 3784, 3406, 2896, 2276, 1567, 799,
+		2896, 3406, 1567, -799, -2896, -4017, -3784, -2276,
+		2896, 2276, -1567, -4017, -2896, 799, 3784, 3406,
+		2896, 799, -3784, -2276, 2896, 3406, -1567, -4017,
+		2896, -799, -3784, 2276, 2896, -3406, -1567, 4017,
+		2896, -2276, -1567, 4017, -2896, -799, 3784, -3406,
+		2896, -3406, 1567, 799, -2896, 4017, -3784, 2276,
+		2896, -4017, 3784, -3406, 2896, -2276, 1567, -799,
+	},
+}
+
+// idctReduced computes the nx x ny pixels of a block scaled down to that
+// size, level shifts and clips them, and stores them in dst.
+func idctReduced(b *block, nx, ny int, dst []byte, stride int) {
+	if nx == 1 && ny == 1 {
+		// The average of the block is its DC coefficient.
+		dst[0] = clip((b[0] + 4) >> 3)
+		return
+	}
+	tx, ty := reducedCos[nx], reducedCos[ny]
+	var tmp [8 * 8]int32
+	for v := 0; v < 8; v++ {
+		row := b[v*8 : v*8+8]
+		if row[0] == 0 && row[1] == 0 && row[2] == 0 && row[3] == 0 &&
+			row[4] == 0 && row[5] == 0 && row[6] == 0 && row[7] == 0 {
+			for x := 0; x < nx; x++ {
+				tmp[v*nx+x] = 0
+			}
+			continue
+		}
+		for x := 0; x < nx; x++ {
+			sum := int32(0)
+			for u := 0; u < 8; u++ {
+				sum += row[u] * tx[x*8+u]
+			}
+			tmp[v*nx+x] = (sum + 1<<11) >> 12
+		}
+	}
+	for y := 0; y < ny; y++ {
+		for x := 0; x < nx; x++ {
+			sum := int32(0)
+			for v := 0; v < 8; v++ {
+				sum += tmp[v*nx+x] * ty[y*8+v]
+			}
+			dst[y*stride+x] = clip((sum + 1<<13) >> 14)
+		}
+	}
+}
+
+// clip level shifts a sample by +128 and clips it to [0, 255].
+func clip(c int32) uint8 {
+	if c < -128 {
+		return 0
+	} else if c > 127 {
+		return 255
+	}
+	return uint8(c + 128)
+}
+
+// setupOutput allocates the samples of an MCU and computes the output
+// rectangle, when the first scan starts.
+func (d *decoder) setupOutput() error {
+	switch d.nComp {
+	case 1, 3:
+	default:
+		return UnsupportedError("CMYK output")
+	}
+	// Subsampled components are scaled down less, up to their full size,
+	// to keep the resolution of the luma.
+	s := 8 / d.opts.Scale
+	for i := 0; i < d.nComp; i++ {
+		c := &d.comp[i]
+		w, h := s*d.comp[0].h/c.h, s*d.comp[0].v/c.v
+		if w > 8 {
+			w = 8
+		}
+		if h > 8 {
+			h = 8
+		}
+		d.samples[i] = image.Pt(w, h)
+		d.planes[i] = make([]byte, w*c.h*h*c.v)
+	}
+	scale := d.opts.Scale
+	d.out = image.Rect(0, 0, (d.width+scale-1)/scale, (d.height+scale-1)/scale)
+	if !d.opts.Crop.Empty() {
+		d.out = d.out.Intersect(d.opts.Crop)
+	}
+	d.rgb = d.nComp == 3 && d.isRGB()
+	switch d.opts.Format {
+	case pixel.Mono, pixel.Gray2, pixel.Gray4, pixel.Gray8:
+		d.gray = !d.rgb
+	}
+	if d.opts.Dither.Diffuses() {
+		w := d.out.Dx()
+		if d.opts.Format == pixel.RGB565 && len(callbackBuf) < w ||
+			d.opts.Format != pixel.RGB565 && len(bytesCallbackBuf) < d.opts.Format.BufferSize(w) {
+			return errShortBuffer
+		}
+		d.band = make([]byte, 3*w*8/d.opts.Scale*d.comp[0].v)
+		d.row = make([]color.RGBA, w)
+		if d.opts.Format == pixel.RGB565 {
+			d.rowBytes = make([]byte, 2*w)
+		}
+	}
+	return nil
+}
+
+// mcuRect returns the rectangle of an MCU in the scaled image.
+func (d *decoder) mcuRect(mx, my int) image.Rectangle {
+	w := 8 / d.opts.Scale * d.comp[0].h
+	h := 8 / d.opts.Scale * d.comp[0].v
+	return image.Rect(mx*w, my*h, mx*w+w, my*h+h)
+}
+
+// visible reports whether an MCU has pixels in the output.
+func (d *decoder) visible(mx, my int) bool {
+	return d.mcuRect(mx, my).Overlaps(d.out)
+}
+
+// storeBlock dequantizes and transforms the block j of a component of the
+// current MCU, and stores its samples.
+func (d *decoder) storeBlock(b *block, compIndex, j int) {
+	qt := &d.quant[d.comp[compIndex].tq]
+	for zig := 0; zig < blockSize; zig++ {
+		b[unzig[zig]] *= qt[zig]
+	}
+	n := d.samples[compIndex]
+	hi := d.comp[compIndex].h
+	stride := n.X * hi
+	dst := d.planes[compIndex][j/hi*n.Y*stride+j%hi*n.X:]
+	if n.X < 8 || n.Y < 8 {
+		idctReduced(b, n.X, n.Y, dst, stride)
+		return
+	}
+	idct(b)
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			dst[y*stride+x] = clip(b[y*8+x])
+		}
+	}
+}
+
+// sample returns the sample of a component at a pixel of the current MCU.
+func (d *decoder) sample(compIndex, x, y int) uint8 {
+	c := &d.comp[compIndex]
+	n := d.samples[compIndex]
+	s := 8 / d.opts.Scale
+	x = x * c.h * n.X / (d.comp[0].h * s)
+	y = y * c.v * n.Y / (d.comp[0].v * s)
+	return d.planes[compIndex][y*n.X*c.h+x]
+}
+
+// color returns the color of a pixel of the current MCU.
+func (d *decoder) color(x, y int) color.RGBA {
+	if d.nComp == 1 || d.gray {
+		v := d.sample(0, x, y)
+		return color.RGBA{v, v, v, 0xff}
+	}
+	c0, c1, c2 := d.sample(0, x, y), d.sample(1, x, y), d.sample(2, x, y)
+	if d.rgb {
+		return color.RGBA{c0, c1, c2, 0xff}
+	}
+	r, g, b := color.YCbCrToRGB(c0, c1, c2)
+	return color.RGBA{r, g, b, 0xff}
+}
+
+// emitMCU passes the pixels of the current MCU inside the output to the
+// callback, in as few calls as the buffer allows. With error diffusion, they
+// are stored in the band instead.
+func (d *decoder) emitMCU(mx, my int) {
+	m := d.mcuRect(mx, my)
+	r := m.Intersect(d.out)
+	if r.Empty() {
+		return
+	}
+	if d.band != nil {
+		w := d.out.Dx()
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			i := 3 * ((y-m.Min.Y)*w + r.Min.X - d.out.Min.X)
+			for x := r.Min.X; x < r.Max.X; x++ {
+				c := d.color(x-m.Min.X, y-m.Min.Y)
+				d.band[i], d.band[i+1], d.band[i+2] = c.R, c.G, c.B
+				i += 3
+			}
+		}
+		return
+	}
+
+	// Rows of formats with less than 8 bits per pixel start on a byte
+	// boundary, so the buffer holds fewer rows than pixels.
+	f := d.opts.Format
+	w := r.Dx()
+	rows, pixels := len(callbackBuf)/w, len(callbackBuf)
+	if f != pixel.RGB565 {
+		rows = len(bytesCallbackBuf) / f.BufferSize(w)
+		pixels = len(bytesCallbackBuf) * 8 / f.BitsPerPixel() &^ 7
+	}
+	for y := r.Min.Y; y < r.Max.Y; {
+		if rows == 0 {
+			// The buffer is shorter than a row.
+			for x := r.Min.X; x < r.Max.X; x += pixels {
+				n := pixels
+				if n > r.Max.X-x {
+					n = r.Max.X - x
+				}
+				d.emit(image.Rect(x, y, x+n, y+1), m.Min)
+			}
+			y++
+			continue
+		}
+		n := rows
+		if n > r.Max.Y-y {
+			n = r.Max.Y - y
+		}
+		d.emit(image.Rect(r.Min.X, y, r.Max.X, y+n), m.Min)
+		y += n
+	}
+}
+
+// emit converts the pixels of rect and passes them to the callback. origin
+// is the top left corner of the current MCU.
+func (d *decoder) emit(rect image.Rectangle, origin image.Point) {
+	f := d.opts.Format
+	stride := f.BufferSize(rect.Dx())
+	x0, y0 := rect.Min.X-d.out.Min.X, rect.Min.Y-d.out.Min.Y
+	i := 0
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		row := bytesCallbackBuf
+		if f != pixel.RGB565 {
+			row = row[(y-rect.Min.Y)*stride:]
+			for j := range row[:stride] {
+				row[j] = 0
+			}
+		}
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			c := d.color(x-origin.X, y-origin.Y)
+			if f == pixel.RGB565 {
+				if d.opts.Dither == pixel.NoDither {
+					callbackBuf[i] = pixel.ToRGB565(c)
+				} else {
+					callbackBuf[i] = uint16(d.conv.ConvertPixel(c, x-d.out.Min.X, y-d.out.Min.Y))
+				}
+			} else {
+				f.Set(row, x-rect.Min.X, d.conv.ConvertPixel(c, x-d.out.Min.X, y-d.out.Min.Y))
+			}
+			i++
+		}
+	}
+	w, h := int16(rect.Dx()), int16(rect.Dy())
+	width, height := int16(d.out.Dx()), int16(d.out.Dy())
+	if f == pixel.RGB565 {
+		callback(callbackBuf[:i], int16(x0), int16(y0), w, h, width, height)
+	} else {
+		bytesCallback(bytesCallbackBuf[:stride*rect.Dy()], int16(x0), int16(y0), w, h, width, height)
+	}
+}
+
+// flushBand dithers the rows of an MCU row collected with error diffusion,
+// and passes them to the callback.
+func (d *decoder) flushBand(my int) {
+	if d.band == nil {
+		return
+	}
+	m := d.mcuRect(0, my)
+	r := image.Rect(d.out.Min.X, m.Min.Y, d.out.Max.X, m.Max.Y).Intersect(d.out)
+	w := d.out.Dx()
+	f := d.opts.Format
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		band := d.band[3*(y-m.Min.Y)*w:]
+		for x := range d.row {
+			d.row[x] = color.RGBA{band[3*x], band[3*x+1], band[3*x+2], 0xff}
+		}
+		oy := int16(y - d.out.Min.Y)
+		if f == pixel.RGB565 {
+			d.conv.ConvertRow(d.rowBytes, d.row, 0, int(oy))
+			for x := range d.row {
+				callbackBuf[x] = uint16(pixel.RGB565.Get(d.rowBytes, x))
+			}
+			callback(callbackBuf[:w], 0, oy, int16(w), 1, int16(w), int16(d.out.Dy()))
+		} else {
+			n := d.conv.ConvertRow(bytesCallbackBuf, d.row, 0, int(oy))
+			bytesCallback(bytesCallbackBuf[:n], 0, oy, int16(w), 1, int16(w), int16(d.out.Dy()))
+		}
+	}
+}
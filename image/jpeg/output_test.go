@@ -0,0 +1,234 @@
+package jpeg
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	stdjpeg "image/jpeg"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+// testImage returns a smooth 50x37 gradient, encoded as a JPEG, and as
+// decoded by the standard library.
+func testImage(c *qt.C, gray bool) ([]byte, image.Image) {
+	var m image.Image
+	rect := image.Rect(0, 0, 50, 37)
+	if gray {
+		g := image.NewGray(rect)
+		for y := 0; y < 37; y++ {
+			for x := 0; x < 50; x++ {
+				g.SetGray(x, y, color.Gray{uint8(x*4 + y)})
+			}
+		}
+		m = g
+	} else {
+		rgba := image.NewRGBA(rect)
+		for y := 0; y < 37; y++ {
+			for x := 0; x < 50; x++ {
+				rgba.SetRGBA(x, y, color.RGBA{uint8(x * 5), uint8(y * 6), uint8(200 - x - y), 255})
+			}
+		}
+		m = rgba
+	}
+	var buf bytes.Buffer
+	c.Assert(stdjpeg.Encode(&buf, m, &stdjpeg.Options{Quality: 95}), qt.IsNil)
+	want, err := stdjpeg.Decode(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, qt.IsNil)
+	return buf.Bytes(), want
+}
+
+// output collects the pixels passed to the callbacks.
+type output struct {
+	w, h  int
+	pix   []color.RGBA
+	calls int
+}
+
+func (o *output) set(x, y int, col color.RGBA) {
+	if o.pix == nil {
+		o.pix = make([]color.RGBA, o.w*o.h)
+	}
+	o.pix[y*o.w+x] = col
+}
+
+func decodeWith(c *qt.C, data []byte, opts *DecodeOptions) *output {
+	o := &output{}
+	// Later tests must not call back into this one.
+	buf, fn, bytesBuf, bytesFn := callbackBuf, callback, bytesCallbackBuf, bytesCallback
+	c.Cleanup(func() {
+		SetCallback(buf, fn)
+		SetBytesCallback(bytesBuf, bytesFn)
+	})
+	check := func(x, y, w, h, width, height int16) {
+		if o.w == 0 {
+			o.w, o.h = int(width), int(height)
+		}
+		c.Assert([]int{int(width), int(height)}, qt.DeepEquals, []int{o.w, o.h})
+		c.Assert(x >= 0 && y >= 0 && int(x+w) <= o.w && int(y+h) <= o.h, qt.IsTrue)
+		o.calls++
+	}
+	SetCallback(make([]uint16, 100), func(data []uint16, x, y, w, h, width, height int16) {
+		check(x, y, w, h, width, height)
+		c.Assert(data, qt.HasLen, int(w)*int(h))
+		for i, v := range data {
+			o.set(int(x)+i%int(w), int(y)+i/int(w), pixel.RGB565.Color(uint32(v)))
+		}
+	})
+	format := pixel.RGB565
+	if opts != nil {
+		format = opts.Format
+	}
+	SetBytesCallback(make([]byte, 300), func(data []byte, x, y, w, h, width, height int16) {
+		check(x, y, w, h, width, height)
+		// Rows start on a byte boundary.
+		stride := format.BufferSize(int(w))
+		c.Assert(data, qt.HasLen, stride*int(h))
+		for j := 0; j < int(h); j++ {
+			for i := 0; i < int(w); i++ {
+				o.set(int(x)+i, int(y)+j, format.Color(format.Get(data[j*stride:], i)))
+			}
+		}
+	})
+	_, err := DecodeWith(bytes.NewReader(data), opts)
+	c.Assert(err, qt.IsNil)
+	return o
+}
+
+// average returns the average color of a square of the standard decoder
+// output, clipped to the image.
+func average(m image.Image, x, y, size int) color.RGBA {
+	var r, g, b, n int
+	for j := y; j < y+size && j < m.Bounds().Dy(); j++ {
+		for i := x; i < x+size && i < m.Bounds().Dx(); i++ {
+			c := color.RGBAModel.Convert(m.At(i, j)).(color.RGBA)
+			r, g, b, n = r+int(c.R), g+int(c.G), b+int(c.B), n+1
+		}
+	}
+	return color.RGBA{uint8(r / n), uint8(g / n), uint8(b / n), 255}
+}
+
+// compare checks that o is the image decoded by the standard library, scaled
+// and cropped, within a tolerance.
+func compare(c *qt.C, o *output, want image.Image, scale int, crop image.Point, tolerance int) {
+	diff := func(a, b uint8) int {
+		if a > b {
+			return int(a - b)
+		}
+		return int(b - a)
+	}
+	for y := 0; y < o.h; y++ {
+		for x := 0; x < o.w; x++ {
+			got := o.pix[y*o.w+x]
+			w := average(want, (x+crop.X)*scale, (y+crop.Y)*scale, scale)
+			if diff(got.R, w.R) > tolerance || diff(got.G, w.G) > tolerance || diff(got.B, w.B) > tolerance {
+				c.Fatalf("pixel %d,%d at scale %d: got %v, want %v", x, y, scale, got, w)
+			}
+		}
+	}
+}
+
+func TestDecodeScaled(t *testing.T) {
+	c := qt.New(t)
+	for _, gray := range []bool{false, true} {
+		data, want := testImage(c, gray)
+
+		o := decodeWith(c, data, nil)
+		c.Assert([]int{o.w, o.h}, qt.DeepEquals, []int{50, 37})
+		compare(c, o, want, 1, image.Point{}, 8)
+
+		for _, tc := range []struct{ scale, w, h int }{{2, 25, 19}, {4, 13, 10}, {8, 7, 5}} {
+			o := decodeWith(c, data, &DecodeOptions{Scale: tc.scale})
+			c.Assert([]int{o.w, o.h}, qt.DeepEquals, []int{tc.w, tc.h})
+			compare(c, o, want, tc.scale, image.Point{}, 10)
+		}
+	}
+}
+
+func TestDecodeCrop(t *testing.T) {
+	c := qt.New(t)
+	data, want := testImage(c, false)
+
+	o := decodeWith(c, data, &DecodeOptions{Crop: image.Rect(10, 20, 30, 25)})
+	c.Assert([]int{o.w, o.h}, qt.DeepEquals, []int{20, 5})
+	compare(c, o, want, 1, image.Pt(10, 20), 8)
+
+	// The crop is clipped to the scaled image, and blocks outside of it are
+	// not decoded.
+	o = decodeWith(c, data, &DecodeOptions{Scale: 2, Crop: image.Rect(17, 16, 22, 40)})
+	c.Assert([]int{o.w, o.h}, qt.DeepEquals, []int{5, 3})
+	c.Assert(o.calls, qt.Equals, 1)
+	compare(c, o, want, 2, image.Pt(17, 16), 10)
+}
+
+func TestDecodeFormats(t *testing.T) {
+	c := qt.New(t)
+	data, want := testImage(c, false)
+
+	o := decodeWith(c, data, &DecodeOptions{Format: pixel.RGB888})
+	compare(c, o, want, 1, image.Point{}, 6)
+
+	o = decodeWith(c, data, &DecodeOptions{Format: pixel.Gray8, Scale: 8})
+	c.Assert([]int{o.w, o.h}, qt.DeepEquals, []int{7, 5})
+	for i, got := range o.pix {
+		w := average(want, i%o.w*8, i/o.w*8, 8)
+		g := int(pixel.Gray(w))
+		c.Assert(int(got.R) >= g-10 && int(got.R) <= g+10, qt.IsTrue, qt.Commentf("pixel %d: got %d, want %d", i, got.R, g))
+	}
+
+	_, err := DecodeWith(bytes.NewReader(data), &DecodeOptions{Scale: 3})
+	c.Assert(err, qt.ErrorMatches, "unsupported JPEG feature: scale")
+	_, err = DecodeWith(bytes.NewReader(data), &DecodeOptions{Format: 100})
+	c.Assert(err, qt.ErrorMatches, "unsupported JPEG feature: output format")
+}
+
+func TestDecodeDither(t *testing.T) {
+	c := qt.New(t)
+	data, want := testImage(c, true)
+	white := func(o *output) int {
+		n := 0
+		for _, p := range o.pix {
+			if p.R == 0xFF {
+				n++
+			}
+		}
+		return n
+	}
+	var brightness int
+	for y := 0; y < 37; y++ {
+		for x := 0; x < 50; x++ {
+			brightness += int(average(want, x, y, 1).R)
+		}
+	}
+	expected := brightness / 255
+
+	// Without dithering, the gradient is cut in two.
+	o := decodeWith(c, data, &DecodeOptions{Format: pixel.Mono})
+	c.Assert(o.pix[0], qt.Equals, color.RGBA{0, 0, 0, 255})
+	c.Assert(o.pix[49], qt.Equals, color.RGBA{255, 255, 255, 255})
+
+	for _, dither := range []pixel.Dither{pixel.OrderedDither, pixel.DiffusionDither, pixel.AtkinsonDither} {
+		o := decodeWith(c, data, &DecodeOptions{Format: pixel.Mono, Dither: dither})
+		got := white(o)
+		c.Assert(got > expected*90/100 && got < expected*110/100, qt.IsTrue,
+			qt.Commentf("dither %d: %d white pixels, want about %d", dither, got, expected))
+	}
+
+	// Diffusion passes whole rows, also when cropped and in RGB565.
+	data, want = testImage(c, false)
+	o = decodeWith(c, data, &DecodeOptions{Dither: pixel.DiffusionDither, Crop: image.Rect(5, 3, 45, 30)})
+	c.Assert(o.calls, qt.Equals, 27)
+	compare(c, o, want, 1, image.Pt(5, 3), 10)
+
+	SetBytesCallback(make([]byte, 4), func(data []byte, x, y, w, h, width, height int16) {})
+	_, err := DecodeWith(bytes.NewReader(data), &DecodeOptions{Format: pixel.Mono, Dither: pixel.DiffusionDither})
+	c.Assert(err, qt.Equals, errShortBuffer)
+
+	// Every format needs a callback buffer.
+	SetBytesCallback(nil, func(data []byte, x, y, w, h, width, height int16) {})
+	_, err = DecodeWith(bytes.NewReader(data), &DecodeOptions{Format: pixel.Gray4})
+	c.Assert(err, qt.Equals, errNoBuffer)
+}
@@ -8,11 +8,13 @@
 package jpeg
 
 import (
+	"errors"
 	"image"
 	"image/color"
 	"io"
 
 	"tinygo.org/x/drivers/image/internal/imageutil"
+	"tinygo.org/x/drivers/pixel"
 )
 
 // A FormatError reports that the input is not a valid JPEG.
@@ -27,6 +29,11 @@ func (e UnsupportedError) Error() string { return "unsupported JPEG feature: " +
 
 var errUnsupportedSubsamplingRatio = UnsupportedError("luma/chroma subsampling ratio")
 
+var (
+	errNoBuffer    = errors.New("jpeg: no callback buffer")
+	errShortBuffer = errors.New("jpeg: callback buffer shorter than a row")
+)
+
 // Component specification, specified in section B.2.2.
 type component struct {
 	h  int   // Horizontal sampling factor.
@@ -146,6 +153,19 @@ type decoder struct {
 	huff       [maxTc + 1][maxTh + 1]huffman
 	quant      [maxTq + 1]block // Quantization tables, in zig-zag order.
 	tmp        [2 * blockSize]byte
+
+	opts    DecodeOptions
+	conv    pixel.Converter
+	out     image.Rectangle // Output in the scaled image.
+	rgb     bool            // The components are R, G and B.
+	gray    bool            // Only the luma is needed.
+	planes  [3][]byte       // Samples of the current MCU.
+	samples [3]image.Point  // Samples per block of each component.
+
+	// With error diffusion, the pixels of an MCU row, and a row to dither.
+	band     []byte
+	row      []color.RGBA
+	rowBytes []byte
 }
 
 // fill fills up the d.bytes.buf buffer from the underlying io.Reader. It
@@ -774,9 +794,7 @@ func (d *decoder) convertToRGB() (image.Image, error) {
 // Decode reads a JPEG image from r. Different from the standard package, the
 // decoded result will be received by the callback set by SetCallback().
 func Decode(r io.Reader) (image.Image, error) {
-	var d decoder
-	_, err := d.decode(r, false)
-	return nil, err
+	return DecodeWith(r, nil)
 }
 
 // DecodeConfig returns the color model and dimensions of a JPEG image without
@@ -6,7 +6,6 @@ package jpeg
 
 import (
 	"image"
-	"image/color"
 )
 
 // makeImg allocates and initializes the destination image.
@@ -48,10 +47,6 @@ func (d *decoder) makeImg(mxx, myy int) {
 	}
 }
 
-// processSOSBuf is a Buffer for creating RGBBitmap in processSOS. It needs to
-// hold four 8 x 8 pix 24bit color images.
-var processSOSBuf [3 * 8 * 8 * 4]byte
-
 // Specified in section B.2.3.
 func (d *decoder) processSOS(n int) error {
 	if d.nComp == 0 {
@@ -156,6 +151,14 @@ func (d *decoder) processSOS(n int) error {
 		// the amount of code changes down, the image is created as a 1 x 1
 		// image at this point.
 		d.makeImg(1, 1)
+		if err := d.setupOutput(); err != nil {
+			return err
+		}
+	}
+	if !d.progressive && nComp != d.nComp {
+		// The components of an MCU are merged when it is decoded, so they
+		// must be in the same scan.
+		return UnsupportedError("non-interleaved scan")
 	}
 	if d.progressive {
 		for i := 0; i < nComp; i++ {
@@ -297,75 +300,23 @@ func (d *decoder) processSOS(n int) error {
 					if d.progressive {
 						// Save the coefficients.
 						d.progCoeffs[compIndex][by*mxx*hi+bx] = b
-						// At this point, we could call reconstructBlock to dequantize and perform the
+						// At this point, we could call storeBlock to dequantize and perform the
 						// inverse DCT, to save early stages of a progressive image to the *image.YCbCr
 						// buffers (the whole point of progressive encoding), but in Go, the jpeg.Decode
 						// function does not return until the entire image is decoded, so we "continue"
-						// here to avoid wasted computation. Instead, reconstructBlock is called on each
+						// here to avoid wasted computation. Instead, storeBlock is called on each
 						// accumulated block by the reconstructProgressiveImage method after all of the
 						// SOS markers are processed.
 						continue
 					}
-					if dst, err := d.reconstructBlock(&b, bx, by, int(compIndex)); err != nil {
-						return err
-					} else {
-						// Currently, only the YCbCr422 format is supported.
-						switch compIndex {
-						case 0: // Y
-							bx8 := bx * 8
-							by8 := by * 8
-							bx16 := bx8 % 16
-							by16 := by8 % 16
-							for cy := 0; cy < 8; cy++ {
-								for cx := 0; cx < 8; cx++ {
-									processSOSBuf[((cy+by16)*16+(cx+bx16))*3+0] = dst[cy*8+cx]
-								}
-							}
-						case 1: // Cb
-							bx8 := bx * 8 * 2
-							by8 := by * 8 * 2
-							bx16 := bx8 % 16
-							by16 := by8 % 16
-
-							for cy := 0; cy < 8; cy++ {
-								for cx := 0; cx < 8; cx++ {
-									processSOSBuf[((cy*2+0+by16)*16+(cx*2+0+bx16))*3+1] = dst[cy*8+cx]
-									processSOSBuf[((cy*2+0+by16)*16+(cx*2+1+bx16))*3+1] = dst[cy*8+cx]
-									processSOSBuf[((cy*2+1+by16)*16+(cx*2+0+bx16))*3+1] = dst[cy*8+cx]
-									processSOSBuf[((cy*2+1+by16)*16+(cx*2+1+bx16))*3+1] = dst[cy*8+cx]
-								}
-							}
-						case 2: // Cr
-							bx8 := bx * 8 * 2
-							by8 := by * 8 * 2
-							bx16 := bx8 % 16
-							by16 := by8 % 16
-
-							for cy := 0; cy < 8; cy++ {
-								for cx := 0; cx < 8; cx++ {
-									processSOSBuf[((cy*2+0+by16)*16+(cx*2+0+bx16))*3+2] = dst[cy*8+cx]
-									processSOSBuf[((cy*2+0+by16)*16+(cx*2+1+bx16))*3+2] = dst[cy*8+cx]
-									processSOSBuf[((cy*2+1+by16)*16+(cx*2+0+bx16))*3+2] = dst[cy*8+cx]
-									processSOSBuf[((cy*2+1+by16)*16+(cx*2+1+bx16))*3+2] = dst[cy*8+cx]
-								}
-							}
-
-							for cy := 0; cy < 16; cy++ {
-								for cx := 0; cx < 16; cx++ {
-									yy := processSOSBuf[(cy*16+cx)*3+0]
-									cb := processSOSBuf[(cy*16+cx)*3+1]
-									cr := processSOSBuf[(cy*16+cx)*3+2]
-									r, g, b := color.YCbCrToRGB(yy, cb, cr)
-									callbackBuf[cy*16+cx] = uint16(((uint16(r) << 8) & 0xF800) +
-										(((uint16(g) << 8) & 0xFC00) >> 5) +
-										(((uint16(b) << 8) & 0xF800) >> 11))
-								}
-							}
-							callback(callbackBuf[:8*8*4], int16(bx8-bx16), int16(by8-by16), 16, 16, int16(d.width), int16(d.height))
-						}
+					if d.visible(mx, my) {
+						d.storeBlock(&b, int(compIndex), j)
 					}
 				} // for j
 			} // for i
+			if !d.progressive {
+				d.emitMCU(mx, my)
+			}
 			mcu++
 			if d.ri > 0 && mcu%d.ri == 0 && mcu < mxx*myy {
 				// A more sophisticated decoder could use RST[0-7] markers to resynchronize from corrupt input,
@@ -407,6 +358,9 @@ func (d *decoder) processSOS(n int) error {
 				d.eobRun = 0
 			}
 		} // for mx
+		if !d.progressive {
+			d.flushBand(my)
+		}
 	} // for my
 
 	return nil
@@ -519,59 +473,29 @@ func (d *decoder) refineNonZeroes(b *block, zig, zigEnd, nz, delta int32) (int32
 }
 
 func (d *decoder) reconstructProgressiveImage() error {
-	// The h0, mxx, by and bx variables have the same meaning as in the
+	// The h0, mxx and myy variables have the same meaning as in the
 	// processSOS method.
-	h0 := d.comp[0].h
+	h0, v0 := d.comp[0].h, d.comp[0].v
 	mxx := (d.width + 8*h0 - 1) / (8 * h0)
-	for i := 0; i < d.nComp; i++ {
-		if d.progCoeffs[i] == nil {
-			continue
-		}
-		v := 8 * d.comp[0].v / d.comp[i].v
-		h := 8 * d.comp[0].h / d.comp[i].h
-		stride := mxx * d.comp[i].h
-		for by := 0; by*v < d.height; by++ {
-			for bx := 0; bx*h < d.width; bx++ {
-				if _, err := d.reconstructBlock(&d.progCoeffs[i][by*stride+bx], bx, by, i); err != nil {
-					return err
+	myy := (d.height + 8*v0 - 1) / (8 * v0)
+	for my := 0; my < myy; my++ {
+		for mx := 0; mx < mxx; mx++ {
+			if !d.visible(mx, my) {
+				continue
+			}
+			for i := 0; i < d.nComp; i++ {
+				hi, vi := d.comp[i].h, d.comp[i].v
+				for j := 0; j < hi*vi; j++ {
+					var b block
+					if d.progCoeffs[i] != nil {
+						b = d.progCoeffs[i][(vi*my+j/hi)*mxx*hi+hi*mx+j%hi]
+					}
+					d.storeBlock(&b, i, j)
 				}
 			}
+			d.emitMCU(mx, my)
 		}
+		d.flushBand(my)
 	}
 	return nil
 }
-
-// reconstructBlockBuf is a Buffer for the 8x8 pix data to be processed by
-// reconstructBlock. It is defined and used as a package variable to reduce
-// memory usage.
-var reconstructBlockBuf [64]byte
-
-// reconstructBlock dequantizes, performs the inverse DCT and stores the block
-// to the image.
-// In the original Go source, it was expanded to a position that matched the
-// coordinates of the original image. Note that TinyGo does not transform the
-// coordinate system, so the movement is different.
-func (d *decoder) reconstructBlock(b *block, bx, by, compIndex int) ([]byte, error) {
-	qt := &d.quant[d.comp[compIndex].tq]
-	for zig := 0; zig < blockSize; zig++ {
-		b[unzig[zig]] *= qt[zig]
-	}
-	idct(b)
-	// Level shift by +128, clip to [0, 255], and write to dst.
-	var buf = reconstructBlockBuf[:]
-	for y := 0; y < 8; y++ {
-		y8 := y * 8
-		for x := 0; x < 8; x++ {
-			c := b[y8+x]
-			if c < -128 {
-				c = 0
-			} else if c > 127 {
-				c = 255
-			} else {
-				c += 128
-			}
-			buf[y*8+x] = uint8(c)
-		}
-	}
-	return buf, nil
-}
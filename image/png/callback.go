@@ -15,3 +15,19 @@ func SetCallback(buf []uint16, fn Callback) {
 	callbackBuf = buf
 	callback = fn
 }
+
+var (
+	bytesCallback    BytesCallback = func(data []byte, x, y, w, h, width, height int16) {}
+	bytesCallbackBuf []byte
+)
+
+// BytesCallback is like Callback, for the output formats other than RGB565
+// selected with DecodeOptions. The pixels are encoded as pixel.Format.Encode
+// does.
+type BytesCallback func(data []byte, x, y, w, h, width, height int16)
+
+// SetBytesCallback registers the buffer and fn required for BytesCallback.
+func SetBytesCallback(buf []byte, fn BytesCallback) {
+	bytesCallbackBuf = buf
+	bytesCallback = fn
+}
@@ -0,0 +1,190 @@
+package png
+
+import (
+	"hash/crc32"
+	"image"
+	"image/color"
+	"io"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+// DecodeOptions select the pixel format passed to the callbacks.
+type DecodeOptions struct {
+	// Format is the pixel format of the output. RGB565, the default, is
+	// passed to the callback set by SetCallback, the other formats to the
+	// callback set by SetBytesCallback. The alpha channel is ignored.
+	Format pixel.Format
+
+	// Dither is the dithering used to convert the image to Format. With
+	// error diffusion, the callbacks receive whole rows: their buffer must
+	// hold the width of the image.
+	Dither pixel.Dither
+}
+
+// DecodeWith reads a PNG image from r like Decode, converted as set by o. A
+// nil o decodes the image in RGB565.
+func DecodeWith(r io.Reader, o *DecodeOptions) (image.Image, error) {
+	d := &decoder{
+		r:   r,
+		crc: crc32.NewIEEE(),
+	}
+	if o != nil {
+		d.opts = *o
+	}
+	f := d.opts.Format
+	if f.BitsPerPixel() == 0 {
+		return nil, UnsupportedError("output format")
+	}
+	if f == pixel.RGB565 && len(callbackBuf) == 0 ||
+		f != pixel.RGB565 && len(bytesCallbackBuf) < f.BufferSize(8) {
+		return nil, errNoBuffer
+	}
+	d.conv = pixel.Converter{Format: f, Dither: d.opts.Dither}
+	if err := d.checkHeader(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	for d.stage != dsSeenIEND {
+		if err := d.parseChunk(); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// setupOutput allocates the row buffers once the header and palette are
+// known.
+func (d *decoder) setupOutput() error {
+	f := d.opts.Format
+	if d.conv.Dither.Diffuses() {
+		if f == pixel.RGB565 && len(callbackBuf) < d.width ||
+			f != pixel.RGB565 && len(bytesCallbackBuf) < f.BufferSize(d.width) {
+			return errShortBuffer
+		}
+		if f == pixel.RGB565 {
+			d.rowBytes = make([]byte, f.BufferSize(d.width))
+		}
+	}
+	d.row = make([]color.RGBA, d.width)
+	switch d.cb {
+	case cbP1, cbP2, cbP4, cbP8:
+		// Out of range indexes are black, as in the standard package.
+		d.pal = make([]color.RGBA, 256)
+		for i := range d.pal {
+			d.pal[i] = color.RGBA{0, 0, 0, 0xff}
+		}
+		for i, c := range d.palette {
+			switch c := c.(type) {
+			case color.RGBA:
+				d.pal[i] = c
+			case color.NRGBA:
+				d.pal[i] = color.RGBA{c.R, c.G, c.B, 0xff}
+			}
+		}
+	}
+	return nil
+}
+
+// readRow converts a row of pixel data to colors in d.row.
+func (d *decoder) readRow(cdat []byte) {
+	row := d.row
+	switch d.cb {
+	case cbG1, cbG2, cbG4, cbG8:
+		bits := uint(d.depth)
+		mask := byte(1<<bits - 1)
+		for x := range row {
+			pos := uint(x) * bits
+			v := cdat[pos/8] >> (8 - bits - pos%8) & mask
+			g := uint8(int(v) * 0xff / int(mask))
+			row[x] = color.RGBA{g, g, g, 0xff}
+		}
+	case cbP1, cbP2, cbP4, cbP8:
+		bits := uint(d.depth)
+		mask := byte(1<<bits - 1)
+		for x := range row {
+			pos := uint(x) * bits
+			row[x] = d.pal[cdat[pos/8]>>(8-bits-pos%8)&mask]
+		}
+	case cbGA8, cbG16, cbGA16:
+		n := 2
+		if d.cb == cbGA16 {
+			n = 4
+		}
+		for x := range row {
+			g := cdat[n*x]
+			row[x] = color.RGBA{g, g, g, 0xff}
+		}
+	case cbTC8, cbTCA8, cbTC16, cbTCA16:
+		// The high byte of 16-bit samples comes first.
+		n, s := 3, 1
+		switch d.cb {
+		case cbTCA8:
+			n = 4
+		case cbTC16:
+			n, s = 6, 2
+		case cbTCA16:
+			n, s = 8, 2
+		}
+		for x := range row {
+			p := cdat[n*x:]
+			row[x] = color.RGBA{p[0], p[s], p[2*s], 0xff}
+		}
+	}
+}
+
+// emitRow converts row y of the image and passes it to the callback.
+func (d *decoder) emitRow(cdat []byte, y int) {
+	if d.row == nil {
+		return
+	}
+	d.readRow(cdat)
+	f := d.opts.Format
+	width, height := int16(d.width), int16(d.height)
+	if d.conv.Dither.Diffuses() {
+		// setupOutput checked that the buffers hold a whole row.
+		if f == pixel.RGB565 {
+			d.conv.ConvertRow(d.rowBytes, d.row, 0, y)
+			for x := range d.row {
+				callbackBuf[x] = uint16(pixel.RGB565.Get(d.rowBytes, x))
+			}
+			callback(callbackBuf[:d.width], 0, int16(y), width, 1, width, height)
+		} else {
+			n := d.conv.ConvertRow(bytesCallbackBuf, d.row, 0, y)
+			bytesCallback(bytesCallbackBuf[:n], 0, int16(y), width, 1, width, height)
+		}
+		return
+	}
+	if f == pixel.RGB565 {
+		for x0 := 0; x0 < d.width; x0 += len(callbackBuf) {
+			n := min(len(callbackBuf), d.width-x0)
+			for i, c := range d.row[x0 : x0+n] {
+				if d.conv.Dither == pixel.NoDither {
+					callbackBuf[i] = pixel.ToRGB565(c)
+				} else {
+					callbackBuf[i] = uint16(d.conv.ConvertPixel(c, x0+i, y))
+				}
+			}
+			callback(callbackBuf[:n], int16(x0), int16(y), int16(n), 1, width, height)
+		}
+		return
+	}
+	// Chunks start on a byte boundary.
+	step := len(bytesCallbackBuf) * 8 / f.BitsPerPixel() &^ 7
+	for x0 := 0; x0 < d.width; x0 += step {
+		n := min(step, d.width-x0)
+		buf := bytesCallbackBuf[:f.BufferSize(n)]
+		for i := range buf {
+			buf[i] = 0
+		}
+		for i, c := range d.row[x0 : x0+n] {
+			f.Set(buf, i, d.conv.ConvertPixel(c, x0+i, y))
+		}
+		bytesCallback(buf, int16(x0), int16(y), int16(n), 1, width, height)
+	}
+}
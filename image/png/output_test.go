@@ -0,0 +1,155 @@
+package png
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	stdpng "image/png"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+// testImages returns a 21x9 gradient in the color types written by the
+// standard encoder.
+func testImages() map[string]image.Image {
+	rect := image.Rect(0, 0, 21, 9)
+	gray := image.NewGray(rect)
+	gray16 := image.NewGray16(rect)
+	rgba := image.NewRGBA(rect)
+	rgba64 := image.NewRGBA64(rect)
+	nrgba := image.NewNRGBA(rect)
+	pal1 := image.NewPaletted(rect, color.Palette{color.Black, color.RGBA{0x10, 0x80, 0xF0, 0xFF}})
+	pal2 := image.NewPaletted(rect, color.Palette{color.Black, color.White, color.RGBA{0xFF, 0, 0, 0xFF}})
+	pal8 := image.NewPaletted(rect, make(color.Palette, 40))
+	for i := range pal8.Palette {
+		pal8.Palette[i] = color.RGBA{uint8(i * 6), 0x40, uint8(255 - i), 0xFF}
+	}
+	for y := 0; y < 9; y++ {
+		for x := 0; x < 21; x++ {
+			v := uint8(x*12 + y)
+			gray.SetGray(x, y, color.Gray{v})
+			gray16.SetGray16(x, y, color.Gray16{uint16(v)<<8 | 0x55})
+			rgba.SetRGBA(x, y, color.RGBA{v, uint8(y * 30), 255 - v, 0xFF})
+			rgba64.SetRGBA64(x, y, color.RGBA64{uint16(v) << 8, 0x1234, 0xFFFF, 0xFFFF})
+			nrgba.SetNRGBA(x, y, color.NRGBA{v, 0x80, 0x20, uint8(x * 10)})
+			pal1.SetColorIndex(x, y, uint8(x+y)%2)
+			pal2.SetColorIndex(x, y, uint8(x+y)%3)
+			pal8.SetColorIndex(x, y, uint8(x+y*2)%40)
+		}
+	}
+	return map[string]image.Image{
+		"gray": gray, "gray16": gray16, "rgba": rgba, "rgba64": rgba64, "nrgba": nrgba,
+		"pal1": pal1, "pal2": pal2, "pal8": pal8,
+	}
+}
+
+func encode(c *qt.C, m image.Image) []byte {
+	var buf bytes.Buffer
+	c.Assert(stdpng.Encode(&buf, m), qt.IsNil)
+	return buf.Bytes()
+}
+
+// decodeWith decodes data and returns the pixels passed to the callbacks.
+func decodeWith(c *qt.C, data []byte, opts *DecodeOptions, bufSize int) []color.RGBA {
+	// Later tests must not call back into this one.
+	buf, fn, bytesBuf, bytesFn := callbackBuf, callback, bytesCallbackBuf, bytesCallback
+	c.Cleanup(func() {
+		SetCallback(buf, fn)
+		SetBytesCallback(bytesBuf, bytesFn)
+	})
+	var pix []color.RGBA
+	var w int
+	set := func(x, y, width, height int16, col color.RGBA) {
+		if pix == nil {
+			w = int(width)
+			pix = make([]color.RGBA, w*int(height))
+		}
+		pix[int(y)*w+int(x)] = col
+	}
+	SetCallback(make([]uint16, bufSize), func(data []uint16, x, y, w, h, width, height int16) {
+		c.Assert(h, qt.Equals, int16(1))
+		c.Assert(data, qt.HasLen, int(w))
+		for i, v := range data {
+			set(x+int16(i), y, width, height, pixel.RGB565.Color(uint32(v)))
+		}
+	})
+	format := pixel.RGB565
+	if opts != nil {
+		format = opts.Format
+	}
+	SetBytesCallback(make([]byte, bufSize), func(data []byte, x, y, w, h, width, height int16) {
+		c.Assert(h, qt.Equals, int16(1))
+		c.Assert(data, qt.HasLen, format.BufferSize(int(w)))
+		for i := 0; i < int(w); i++ {
+			set(x+int16(i), y, width, height, format.Color(format.Get(data, i)))
+		}
+	})
+	_, err := DecodeWith(bytes.NewReader(data), opts)
+	c.Assert(err, qt.IsNil)
+	return pix
+}
+
+func TestDecodeFormats(t *testing.T) {
+	c := qt.New(t)
+	for name, m := range testImages() {
+		data := encode(c, m)
+		for _, bufSize := range []int{24, 100} {
+			pix := decodeWith(c, data, &DecodeOptions{Format: pixel.RGB888}, bufSize)
+			c.Assert(pix, qt.HasLen, 21*9)
+			for y := 0; y < 9; y++ {
+				for x := 0; x < 21; x++ {
+					// The alpha channel is ignored.
+					want := color.NRGBAModel.Convert(m.At(x, y)).(color.NRGBA)
+					got := pix[y*21+x]
+					c.Assert(got, qt.Equals, color.RGBA{want.R, want.G, want.B, 0xFF},
+						qt.Commentf("%s at %d,%d with %d bytes", name, x, y, bufSize))
+				}
+			}
+		}
+		pix := decodeWith(c, data, nil, 5)
+		want := color.NRGBAModel.Convert(m.At(20, 8)).(color.NRGBA)
+		v := pixel.RGB565.Convert(color.RGBA{want.R, want.G, want.B, 0xFF})
+		c.Assert(pix[len(pix)-1], qt.Equals, pixel.RGB565.Color(v), qt.Commentf(name))
+	}
+}
+
+func TestDecodeDither(t *testing.T) {
+	c := qt.New(t)
+	m := image.NewGray(image.Rect(0, 0, 64, 32))
+	for i := range m.Pix {
+		m.Pix[i] = 0x80
+	}
+	data := encode(c, m)
+	white := func(pix []color.RGBA) int {
+		n := 0
+		for _, p := range pix {
+			if p.R == 0xFF {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Without dithering, mid gray is all white.
+	c.Assert(white(decodeWith(c, data, &DecodeOptions{Format: pixel.Mono}, 3)), qt.Equals, 64*32)
+
+	// With dithering, about half of the pixels are white.
+	for _, dither := range []pixel.Dither{pixel.OrderedDither, pixel.DiffusionDither, pixel.AtkinsonDither} {
+		got := white(decodeWith(c, data, &DecodeOptions{Format: pixel.Mono, Dither: dither}, 8))
+		c.Assert(got > 64*32/2*90/100 && got < 64*32/2*110/100, qt.IsTrue,
+			qt.Commentf("dither %d: %d white pixels", dither, got))
+	}
+
+	// Error diffusion needs whole rows.
+	SetBytesCallback(make([]byte, 4), func(data []byte, x, y, w, h, width, height int16) {})
+	_, err := DecodeWith(bytes.NewReader(data), &DecodeOptions{Format: pixel.Mono, Dither: pixel.DiffusionDither})
+	c.Assert(err, qt.Equals, errShortBuffer)
+
+	// Every format needs a callback buffer.
+	SetBytesCallback(nil, func(data []byte, x, y, w, h, width, height int16) {})
+	_, err = DecodeWith(bytes.NewReader(data), &DecodeOptions{Format: pixel.Gray4})
+	c.Assert(err, qt.Equals, errNoBuffer)
+}
@@ -9,6 +9,7 @@ package png
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"hash"
 	"hash/crc32"
@@ -17,6 +18,7 @@ import (
 	"io"
 
 	"tinygo.org/x/drivers/image/internal/compress/zlib"
+	"tinygo.org/x/drivers/pixel"
 )
 
 // Color type, as per the PNG spec.
@@ -119,6 +121,12 @@ type decoder struct {
 	// transparency, as opposed to palette transparency.
 	useTransparent bool
 	transparent    [6]byte
+
+	opts     DecodeOptions
+	conv     pixel.Converter
+	pal      []color.RGBA
+	row      []color.RGBA
+	rowBytes []byte
 }
 
 // A FormatError reports that the input is not a valid PNG.
@@ -128,6 +136,11 @@ func (e FormatError) Error() string { return "png: invalid format: " + string(e)
 
 var chunkOrderError = FormatError("chunk out of order")
 
+var (
+	errNoBuffer    = errors.New("png: no callback buffer")
+	errShortBuffer = errors.New("png: callback buffer shorter than a row")
+)
+
 // An UnsupportedError reports that the input uses a valid but unimplemented PNG feature.
 type UnsupportedError string
 
@@ -368,6 +381,9 @@ func (d *decoder) decode() (image.Image, error) {
 		return nil, err
 	}
 	defer r.Close()
+	if err := d.setupOutput(); err != nil {
+		return nil, err
+	}
 	var img image.Image
 	if d.interlace == itNone {
 		img, err = d.readImagePass(r, 0, false)
@@ -375,20 +391,9 @@ func (d *decoder) decode() (image.Image, error) {
 			return nil, err
 		}
 	} else if d.interlace == itAdam7 {
-		// Allocate a blank image of the full size.
-		img, err = d.readImagePass(nil, 0, true)
-		if err != nil {
-			return nil, err
-		}
-		for pass := 0; pass < 7; pass++ {
-			imagePass, err := d.readImagePass(r, pass, false)
-			if err != nil {
-				return nil, err
-			}
-			if imagePass != nil {
-				d.mergePassInto(img, imagePass, pass)
-			}
-		}
+		// The passes would have to be merged in a full image, which is too
+		// big for the callbacks' buffers.
+		return nil, UnsupportedError("interlaced image")
 	}
 
 	// Check for EOF, to verify the zlib checksum.
@@ -412,7 +417,6 @@ func (d *decoder) decode() (image.Image, error) {
 // readImagePass reads a single image pass, sized according to the pass number.
 func (d *decoder) readImagePass(r io.Reader, pass int, allocateOnly bool) (image.Image, error) {
 	bitsPerPixel := 0
-	pixOffset := 0
 	var (
 		gray     *image.Gray
 		rgba     *image.RGBA
@@ -555,241 +559,7 @@ func (d *decoder) readImagePass(r io.Reader, pass int, allocateOnly bool) (image
 			return nil, FormatError("bad filter type")
 		}
 
-		// Convert from bytes to colors.
-		switch d.cb {
-		case cbG1:
-			if d.useTransparent {
-				ty := d.transparent[1]
-				for x := 0; x < width; x += 8 {
-					b := cdat[x/8]
-					for x2 := 0; x2 < 8 && x+x2 < width; x2++ {
-						ycol := (b >> 7) * 0xff
-						acol := uint8(0xff)
-						if ycol == ty {
-							acol = 0x00
-						}
-						nrgba.SetNRGBA(x+x2, y, color.NRGBA{ycol, ycol, ycol, acol})
-						b <<= 1
-					}
-				}
-			} else {
-				for x := 0; x < width; x += 8 {
-					b := cdat[x/8]
-					for x2 := 0; x2 < 8 && x+x2 < width; x2++ {
-						gray.SetGray(x+x2, y, color.Gray{(b >> 7) * 0xff})
-						b <<= 1
-					}
-				}
-			}
-		case cbG2:
-			if d.useTransparent {
-				ty := d.transparent[1]
-				for x := 0; x < width; x += 4 {
-					b := cdat[x/4]
-					for x2 := 0; x2 < 4 && x+x2 < width; x2++ {
-						ycol := (b >> 6) * 0x55
-						acol := uint8(0xff)
-						if ycol == ty {
-							acol = 0x00
-						}
-						nrgba.SetNRGBA(x+x2, y, color.NRGBA{ycol, ycol, ycol, acol})
-						b <<= 2
-					}
-				}
-			} else {
-				for x := 0; x < width; x += 4 {
-					b := cdat[x/4]
-					for x2 := 0; x2 < 4 && x+x2 < width; x2++ {
-						gray.SetGray(x+x2, y, color.Gray{(b >> 6) * 0x55})
-						b <<= 2
-					}
-				}
-			}
-		case cbG4:
-			if d.useTransparent {
-				ty := d.transparent[1]
-				for x := 0; x < width; x += 2 {
-					b := cdat[x/2]
-					for x2 := 0; x2 < 2 && x+x2 < width; x2++ {
-						ycol := (b >> 4) * 0x11
-						acol := uint8(0xff)
-						if ycol == ty {
-							acol = 0x00
-						}
-						nrgba.SetNRGBA(x+x2, y, color.NRGBA{ycol, ycol, ycol, acol})
-						b <<= 4
-					}
-				}
-			} else {
-				for x := 0; x < width; x += 2 {
-					b := cdat[x/2]
-					for x2 := 0; x2 < 2 && x+x2 < width; x2++ {
-						gray.SetGray(x+x2, y, color.Gray{(b >> 4) * 0x11})
-						b <<= 4
-					}
-				}
-			}
-		case cbG8:
-			if d.useTransparent {
-				ty := d.transparent[1]
-				for x := 0; x < width; x++ {
-					ycol := cdat[x]
-					acol := uint8(0xff)
-					if ycol == ty {
-						acol = 0x00
-					}
-					nrgba.SetNRGBA(x, y, color.NRGBA{ycol, ycol, ycol, acol})
-				}
-			} else {
-				copy(gray.Pix[pixOffset:], cdat)
-				pixOffset += gray.Stride
-			}
-		case cbGA8:
-			for x := 0; x < width; x++ {
-				ycol := cdat[2*x+0]
-				nrgba.SetNRGBA(x, y, color.NRGBA{ycol, ycol, ycol, cdat[2*x+1]})
-			}
-		case cbTC8:
-			if d.useTransparent {
-				pix, i, j := nrgba.Pix, pixOffset, 0
-				tr, tg, tb := d.transparent[1], d.transparent[3], d.transparent[5]
-				for x := 0; x < width; x++ {
-					r := cdat[j+0]
-					g := cdat[j+1]
-					b := cdat[j+2]
-					a := uint8(0xff)
-					if r == tr && g == tg && b == tb {
-						a = 0x00
-					}
-					pix[i+0] = r
-					pix[i+1] = g
-					pix[i+2] = b
-					pix[i+3] = a
-					i += 4
-					j += 3
-				}
-				pixOffset += nrgba.Stride
-			} else {
-				for x := 0; x < width; x++ {
-					r := uint16(cdat[x*3+0]) << 8
-					g := uint16(cdat[x*3+1]) << 8
-					b := uint16(cdat[x*3+2]) << 8
-					callbackBuf[x] = uint16((r & 0xF800) + ((g & 0xFC00) >> 5) + ((b & 0xF800) >> 11))
-				}
-				callback(callbackBuf[:width*1], 0, int16(y), int16(width), 1, int16(width), int16(height))
-				pixOffset += rgba.Stride
-			}
-		case cbP1:
-			for x := 0; x < width; x += 8 {
-				b := cdat[x/8]
-				for x2 := 0; x2 < 8 && x+x2 < width; x2++ {
-					idx := b >> 7
-					if len(paletted.Palette) <= int(idx) {
-						paletted.Palette = paletted.Palette[:int(idx)+1]
-					}
-					paletted.SetColorIndex(x+x2, y, idx)
-					b <<= 1
-				}
-			}
-		case cbP2:
-			for x := 0; x < width; x += 4 {
-				b := cdat[x/4]
-				for x2 := 0; x2 < 4 && x+x2 < width; x2++ {
-					idx := b >> 6
-					if len(paletted.Palette) <= int(idx) {
-						paletted.Palette = paletted.Palette[:int(idx)+1]
-					}
-					paletted.SetColorIndex(x+x2, y, idx)
-					b <<= 2
-				}
-			}
-		case cbP4:
-			for x := 0; x < width; x += 2 {
-				b := cdat[x/2]
-				for x2 := 0; x2 < 2 && x+x2 < width; x2++ {
-					idx := b >> 4
-					if len(paletted.Palette) <= int(idx) {
-						paletted.Palette = paletted.Palette[:int(idx)+1]
-					}
-					paletted.SetColorIndex(x+x2, y, idx)
-					b <<= 4
-				}
-			}
-		case cbP8:
-			if len(paletted.Palette) != 256 {
-				for x := 0; x < width; x++ {
-					if len(paletted.Palette) <= int(cdat[x]) {
-						paletted.Palette = paletted.Palette[:int(cdat[x])+1]
-					}
-				}
-			}
-			copy(paletted.Pix[pixOffset:], cdat)
-			pixOffset += paletted.Stride
-		case cbTCA8:
-			copy(nrgba.Pix[:], cdat)
-			for x := 0; x < width; x++ {
-				r := uint16(cdat[x*3+0]) << 8
-				g := uint16(cdat[x*3+1]) << 8
-				b := uint16(cdat[x*3+2]) << 8
-				callbackBuf[x] = uint16((r & 0xF800) + ((g & 0xFC00) >> 5) + ((b & 0xF800) >> 11))
-			}
-			callback(callbackBuf[:width*1], 0, int16(y), int16(width), 1, int16(width), int16(height))
-			pixOffset += nrgba.Stride
-		case cbG16:
-			if d.useTransparent {
-				ty := uint16(d.transparent[0])<<8 | uint16(d.transparent[1])
-				for x := 0; x < width; x++ {
-					ycol := uint16(cdat[2*x+0])<<8 | uint16(cdat[2*x+1])
-					acol := uint16(0xffff)
-					if ycol == ty {
-						acol = 0x0000
-					}
-					nrgba64.SetNRGBA64(x, y, color.NRGBA64{ycol, ycol, ycol, acol})
-				}
-			} else {
-				for x := 0; x < width; x++ {
-					ycol := uint16(cdat[2*x+0])<<8 | uint16(cdat[2*x+1])
-					gray16.SetGray16(x, y, color.Gray16{ycol})
-				}
-			}
-		case cbGA16:
-			for x := 0; x < width; x++ {
-				ycol := uint16(cdat[4*x+0])<<8 | uint16(cdat[4*x+1])
-				acol := uint16(cdat[4*x+2])<<8 | uint16(cdat[4*x+3])
-				nrgba64.SetNRGBA64(x, y, color.NRGBA64{ycol, ycol, ycol, acol})
-			}
-		case cbTC16:
-			if d.useTransparent {
-				tr := uint16(d.transparent[0])<<8 | uint16(d.transparent[1])
-				tg := uint16(d.transparent[2])<<8 | uint16(d.transparent[3])
-				tb := uint16(d.transparent[4])<<8 | uint16(d.transparent[5])
-				for x := 0; x < width; x++ {
-					rcol := uint16(cdat[6*x+0])<<8 | uint16(cdat[6*x+1])
-					gcol := uint16(cdat[6*x+2])<<8 | uint16(cdat[6*x+3])
-					bcol := uint16(cdat[6*x+4])<<8 | uint16(cdat[6*x+5])
-					acol := uint16(0xffff)
-					if rcol == tr && gcol == tg && bcol == tb {
-						acol = 0x0000
-					}
-					nrgba64.SetNRGBA64(x, y, color.NRGBA64{rcol, gcol, bcol, acol})
-				}
-			} else {
-				for x := 0; x < width; x++ {
-					rcol := uint16(cdat[6*x+0])<<8 | uint16(cdat[6*x+1])
-					gcol := uint16(cdat[6*x+2])<<8 | uint16(cdat[6*x+3])
-					bcol := uint16(cdat[6*x+4])<<8 | uint16(cdat[6*x+5])
-					rgba64.SetRGBA64(x, y, color.RGBA64{rcol, gcol, bcol, 0xffff})
-				}
-			}
-		case cbTCA16:
-			for x := 0; x < width; x++ {
-				rcol := uint16(cdat[8*x+0])<<8 | uint16(cdat[8*x+1])
-				gcol := uint16(cdat[8*x+2])<<8 | uint16(cdat[8*x+3])
-				bcol := uint16(cdat[8*x+4])<<8 | uint16(cdat[8*x+5])
-				acol := uint16(cdat[8*x+6])<<8 | uint16(cdat[8*x+7])
-				nrgba64.SetNRGBA64(x, y, color.NRGBA64{rcol, gcol, bcol, acol})
-			}
-		}
+		d.emitRow(cdat, y)
 
 		// The current row for y is the previous row for y+1.
 		pr, cr = cr, pr
@@ -966,25 +736,7 @@ func (d *decoder) checkHeader() error {
 // Decode reads a PNG image from r. Different from the standard package, the
 // decoded result will be received by the callback set by SetCallback().
 func Decode(r io.Reader) (image.Image, error) {
-	d := &decoder{
-		r:   r,
-		crc: crc32.NewIEEE(),
-	}
-	if err := d.checkHeader(); err != nil {
-		if err == io.EOF {
-			err = io.ErrUnexpectedEOF
-		}
-		return nil, err
-	}
-	for d.stage != dsSeenIEND {
-		if err := d.parseChunk(); err != nil {
-			if err == io.EOF {
-				err = io.ErrUnexpectedEOF
-			}
-			return nil, err
-		}
-	}
-	return nil, nil
+	return DecodeWith(r, nil)
 }
 
 // DecodeConfig returns the color model and dimensions of a PNG image without
@@ -0,0 +1,179 @@
+package is31fl3731
+
+import (
+	"fmt"
+	"image/color"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
+)
+
+// Layout is how the LEDs of a board are wired to the chip
+type Layout struct {
+	Width, Height int16
+
+	// Index returns the LED [0-143] at x, y, within the size of the board
+	Index func(x, y int16) uint8
+}
+
+var (
+	// LayoutMatrix16x9 is the raw matrix of the chip, with 16 columns wired
+	// to its A and B pins and 9 rows to its CA pins, such as the Adafruit 16x9
+	// CharliePlex LED Matrix breakouts: https://www.adafruit.com/product/2946
+	LayoutMatrix16x9 = Layout{Width: 16, Height: 9, Index: func(x, y int16) uint8 {
+		return uint8(16*y + x)
+	}}
+
+	// LayoutCharlieWing15x7 is the Adafruit 15x7 CharliePlex LED Matrix
+	// FeatherWing (CharlieWing): https://www.adafruit.com/product/3163
+	LayoutCharlieWing15x7 = Layout{Width: 15, Height: 7, Index: func(x, y int16) uint8 {
+		if x < 8 {
+			return uint8(16*x + y + 1)
+		}
+		return uint8(16*(16-x) - y - 2)
+	}}
+
+	// LayoutCharlieBonnet16x8 is the Adafruit 16x8 CharliePlex LED Matrix
+	// Bonnet (CharlieBonnet): https://www.adafruit.com/product/4127
+	LayoutCharlieBonnet16x8 = Layout{Width: 16, Height: 8, Index: func(x, y int16) uint8 {
+		if x >= 8 {
+			return uint8(16*(x-6) - y - 1)
+		}
+		return uint8(16*(x+1) + 7 - y)
+	}}
+
+	// LayoutScrollPhatHD17x7 is the Pimoroni Scroll pHAT HD:
+	// https://shop.pimoroni.com/products/scroll-phat-hd
+	LayoutScrollPhatHD17x7 = Layout{Width: 17, Height: 7, Index: func(x, y int16) uint8 {
+		if x <= 8 {
+			return uint8(16*(8-x) + 6 - y)
+		}
+		return uint8(16*(x-8) + y - 8)
+	}}
+)
+
+// Display implements drivers.Displayer for a board with an IS31FL3731. The
+// brightness of each LED is the gray level of its color [0-255]. Pixels are
+// drawn in memory and sent to a frame of the chip by Display.
+type Display struct {
+	Device
+	layout Layout
+
+	// Frame written by Display
+	frame  uint8
+	buffer [144]uint8
+}
+
+var _ drivers.Displayer = (*Display)(nil)
+
+// NewDisplay creates a new display on the board with the given layout
+func NewDisplay(bus drivers.I2C, address uint8, layout Layout) *Display {
+	return &Display{
+		Device: New(bus, address),
+		layout: layout,
+	}
+}
+
+// Configure chip and enable the LEDs of the board
+func (d *Display) Configure() (err error) {
+	err = d.Device.Configure()
+	if err != nil {
+		return err
+	}
+
+	// Turn off the LEDs that are not on the board, see enableLEDs
+	var control [18]byte
+	for y := int16(0); y < d.layout.Height; y++ {
+		for x := int16(0); x < d.layout.Width; x++ {
+			i := d.layout.Index(x, y)
+			control[i/8] |= 1 << (i % 8)
+		}
+	}
+
+	for frame := FRAME_0; frame <= FRAME_7; frame++ {
+		err = d.selectCommand(frame)
+		if err != nil {
+			return err
+		}
+
+		err = d.bus.WriteRegister(d.Address, LED_CONTROL_OFFSET, control[:])
+		if err != nil {
+			return fmt.Errorf("failed to enable LEDs: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Size returns the size of the board
+func (d *Display) Size() (x, y int16) {
+	return d.layout.Width, d.layout.Height
+}
+
+// SetPixel sets the brightness of a LED to the gray level of c
+func (d *Display) SetPixel(x, y int16, c color.RGBA) {
+	d.SetLevel(x, y, pixel.Gray(c))
+}
+
+// GetPixel returns the brightness of a LED as a gray color
+func (d *Display) GetPixel(x, y int16) color.RGBA {
+	v := d.Level(x, y)
+	return color.RGBA{v, v, v, 255}
+}
+
+// SetLevel sets the brightness of a LED [0-255]
+func (d *Display) SetLevel(x, y int16, level uint8) {
+	if x < 0 || y < 0 || x >= d.layout.Width || y >= d.layout.Height {
+		return
+	}
+
+	d.buffer[d.layout.Index(x, y)] = level
+}
+
+// Level returns the brightness of a LED [0-255]
+func (d *Display) Level(x, y int16) uint8 {
+	if x < 0 || y < 0 || x >= d.layout.Width || y >= d.layout.Height {
+		return 0
+	}
+
+	return d.buffer[d.layout.Index(x, y)]
+}
+
+// ClearBuffer turns off all the LEDs in memory
+func (d *Display) ClearBuffer() {
+	d.buffer = [144]uint8{}
+}
+
+// SetFrame sets the frame written by Display, FRAME_0 by default. Drawing on a
+// frame that is not shown and then showing it with SetActiveFrame avoids
+// flickering, and frames drawn in turn can be played with Autoplay.
+func (d *Display) SetFrame(frame uint8) (err error) {
+	if frame > FRAME_7 {
+		return fmt.Errorf("frame %d is out of valid range [0-7]", frame)
+	}
+
+	d.frame = frame
+	return nil
+}
+
+// Frame returns the frame written by Display
+func (d *Display) Frame() uint8 {
+	return d.frame
+}
+
+// Display sends the pixels to the frame set with SetFrame
+func (d *Display) Display() (err error) {
+	err = d.selectCommand(d.frame)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(d.buffer); i += 24 {
+		err = d.bus.WriteRegister(d.Address, LED_PWM_OFFSET+uint8(i), d.buffer[i:i+24])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,13 @@
 //   - any custom LED matrix layout
 //   - Adafruit 15x7 CharliePlex LED Matrix FeatherWing (CharlieWing)
 //     https://www.adafruit.com/product/3163
+//   - Adafruit 16x9 CharliePlex LED Matrix breakouts
+//   - Adafruit 16x8 CharliePlex LED Matrix Bonnet (CharlieBonnet)
+//   - Pimoroni Scroll pHAT HD (17x7)
+//
+// Display implements drivers.Displayer on any of these layouts, with 8-bit
+// grayscale. The 8 frames of the chip can also be played by the chip itself,
+// see Autoplay.
 //
 // Datasheet:
 //    https://www.lumissil.com/assets/pdf/core/IS31FL3731_DS.pdf
@@ -29,6 +36,9 @@ type Device struct {
 	// Currently selected command register (one of the frame registers or the
 	// function register)
 	selectedCommand uint8
+
+	// Whether the chip is in the auto or audio frame play mode
+	playing bool
 }
 
 // Configure chip for operating as a LED matrix display
@@ -47,12 +57,13 @@ func (d *Device) Configure() (err error) {
 		return fmt.Errorf("failed to wake up: %w", err)
 	}
 
-	// Set display to a picture mode ("auto frame play mode" and "audio frame play
-	// mode" are not supported in this version of the driver)
+	// Set display to a picture mode, see Autoplay and AudioPlay for the other
+	// modes
 	err = d.writeFunctionRegister(SET_DISPLAY_MODE, []byte{DISPLAY_MODE_PICTURE})
 	if err != nil {
 		return fmt.Errorf("failed to switch to a picture move: %w", err)
 	}
+	d.playing = false
 
 	// Enable LEDs that are present (soldered) on the board. From the datasheet:
 	// LEDs which are no connected must be off by LED Control Register (Frame
@@ -138,12 +149,21 @@ func (d *Device) setPixelPWD(frame, n, value uint8) (err error) {
 	return d.bus.WriteRegister(d.Address, LED_PWM_OFFSET+n, []byte{value})
 }
 
-// SetActiveFrame sets frame to display with LEDs
+// SetActiveFrame sets frame to display with LEDs, stopping the auto or audio
+// frame play
 func (d *Device) SetActiveFrame(frame uint8) (err error) {
 	if frame > FRAME_7 {
 		return fmt.Errorf("frame %d is out of valid range [0-7]", frame)
 	}
 
+	if d.playing {
+		err = d.writeFunctionRegister(SET_DISPLAY_MODE, []byte{DISPLAY_MODE_PICTURE})
+		if err != nil {
+			return err
+		}
+		d.playing = false
+	}
+
 	return d.writeFunctionRegister(SET_ACTIVE_FRAME, []byte{frame})
 }
 
@@ -0,0 +1,145 @@
+package is31fl3731
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/tester"
+)
+
+// fakeChip emulates the pages of registers of an IS31FL3731, selected with
+// the command register.
+type fakeChip struct {
+	c     *qt.C
+	addr  uint8
+	page  uint8
+	pages [FUNCTION + 1][256]byte
+}
+
+func newFakeChip(c *qt.C) *fakeChip {
+	return &fakeChip{c: c, addr: I2C_ADDRESS_74}
+}
+
+func (f *fakeChip) Addr() uint8 { return f.addr }
+
+func (f *fakeChip) Tx(w, r []byte) error { return nil }
+
+func (f *fakeChip) ReadRegister(r uint8, buf []byte) error {
+	copy(buf, f.pages[f.page][r:])
+	return nil
+}
+
+func (f *fakeChip) WriteRegister(r uint8, buf []byte) error {
+	if r == COMMAND {
+		f.page = buf[0]
+		f.c.Assert(f.page == FUNCTION || f.page <= FRAME_7, qt.IsTrue)
+		return nil
+	}
+	copy(f.pages[f.page][r:], buf)
+	return nil
+}
+
+func newDisplay(c *qt.C, layout Layout) (*Display, *fakeChip) {
+	bus := tester.NewI2CBus(c)
+	chip := newFakeChip(c)
+	bus.AddDevice(chip)
+	d := NewDisplay(bus, I2C_ADDRESS_74, layout)
+	c.Assert(d.Configure(), qt.IsNil)
+	return d, chip
+}
+
+func TestLayouts(t *testing.T) {
+	c := qt.New(t)
+	for name, layout := range map[string]Layout{
+		"16x9":          LayoutMatrix16x9,
+		"CharlieWing":   LayoutCharlieWing15x7,
+		"CharlieBonnet": LayoutCharlieBonnet16x8,
+		"ScrollPhatHD":  LayoutScrollPhatHD17x7,
+	} {
+		// Every pixel has its own LED.
+		seen := map[uint8]bool{}
+		for y := int16(0); y < layout.Height; y++ {
+			for x := int16(0); x < layout.Width; x++ {
+				i := layout.Index(x, y)
+				c.Assert(i < 144, qt.IsTrue, qt.Commentf("%s %d,%d", name, x, y))
+				c.Assert(seen[i], qt.IsFalse, qt.Commentf("%s %d,%d", name, x, y))
+				seen[i] = true
+			}
+		}
+	}
+
+	// Same mapping as DeviceAdafruitCharlieWing15x7.
+	c.Assert(LayoutCharlieWing15x7.Index(0, 0), qt.Equals, uint8(1))
+	c.Assert(LayoutCharlieWing15x7.Index(14, 6), qt.Equals, uint8(24))
+}
+
+func TestDisplay(t *testing.T) {
+	c := qt.New(t)
+	d, chip := newDisplay(c, LayoutCharlieWing15x7)
+	w, h := d.Size()
+	c.Assert([]int16{w, h}, qt.DeepEquals, []int16{15, 7})
+
+	// Only the LEDs of the board are enabled.
+	for frame := FRAME_0; frame <= FRAME_7; frame++ {
+		control := chip.pages[frame][:18]
+		c.Assert(control[0], qt.Equals, uint8(0b11111110))
+		c.Assert(control[1], qt.Equals, uint8(0))
+		c.Assert(control[15], qt.Equals, uint8(0b01111111))
+	}
+
+	d.SetPixel(0, 0, color.RGBA{255, 255, 255, 255})
+	d.SetLevel(14, 6, 100)
+	d.SetLevel(15, 0, 100) // out of range
+	c.Assert(d.Level(14, 6), qt.Equals, uint8(100))
+	c.Assert(d.GetPixel(0, 0), qt.Equals, color.RGBA{255, 255, 255, 255})
+	c.Assert(d.SetFrame(FRAME_2), qt.IsNil)
+	c.Assert(d.SetFrame(8), qt.Not(qt.IsNil))
+	c.Assert(d.Display(), qt.IsNil)
+	pwm := chip.pages[FRAME_2][LED_PWM_OFFSET:]
+	c.Assert(pwm[1], qt.Equals, uint8(255))
+	c.Assert(pwm[24], qt.Equals, uint8(100))
+	c.Assert(chip.pages[FRAME_0][LED_PWM_OFFSET+1], qt.Equals, uint8(0))
+}
+
+func TestPlay(t *testing.T) {
+	c := qt.New(t)
+	d, chip := newDisplay(c, LayoutMatrix16x9)
+	function := &chip.pages[FUNCTION]
+
+	c.Assert(d.Autoplay(FRAME_1, 3, 0, 100*time.Millisecond), qt.IsNil)
+	c.Assert(function[SET_AUTOPLAY_1], qt.Equals, uint8(0x03))
+	c.Assert(function[SET_AUTOPLAY_2], qt.Equals, uint8(9))
+	c.Assert(function[SET_DISPLAY_MODE], qt.Equals, DISPLAY_MODE_AUTOPLAY|FRAME_1)
+
+	// All 8 frames, twice, with the longest delay.
+	c.Assert(d.Autoplay(FRAME_0, 8, 2, time.Second), qt.IsNil)
+	c.Assert(function[SET_AUTOPLAY_1], qt.Equals, uint8(0x20))
+	c.Assert(function[SET_AUTOPLAY_2], qt.Equals, uint8(0))
+	c.Assert(d.Autoplay(FRAME_0, 9, 0, 0), qt.Not(qt.IsNil))
+
+	function[FRAME_STATE] = FRAME_STATE_DONE | FRAME_7
+	frame, done, err := d.FrameState()
+	c.Assert(err, qt.IsNil)
+	c.Assert(frame, qt.Equals, FRAME_7)
+	c.Assert(done, qt.IsTrue)
+
+	// Showing a frame goes back to the picture mode.
+	c.Assert(d.SetActiveFrame(FRAME_3), qt.IsNil)
+	c.Assert(function[SET_DISPLAY_MODE], qt.Equals, DISPLAY_MODE_PICTURE)
+	c.Assert(function[SET_ACTIVE_FRAME], qt.Equals, FRAME_3)
+
+	c.Assert(d.SetBreath(100*time.Millisecond, 26*time.Millisecond, 0), qt.IsNil)
+	c.Assert(function[SET_BREATH_1], qt.Equals, uint8(0x02))
+	c.Assert(function[SET_BREATH_2], qt.Equals, BREATH_ON)
+	c.Assert(d.SetBreath(0, 0, 0), qt.IsNil)
+	c.Assert(function[SET_BREATH_2], qt.Equals, uint8(0))
+
+	c.Assert(d.AudioPlay(FRAME_0, 0), qt.IsNil)
+	c.Assert(function[SET_ADC_RATE], qt.Equals, uint8(1))
+	c.Assert(function[SET_DISPLAY_MODE], qt.Equals, DISPLAY_MODE_AUDIOPLAY)
+	c.Assert(d.SetAudioGain(9, true, false), qt.IsNil)
+	c.Assert(function[SET_AGC], qt.Equals, AGC_ON|3)
+}
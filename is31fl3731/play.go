@@ -0,0 +1,155 @@
+package is31fl3731
+
+import (
+	"fmt"
+	"time"
+)
+
+// Time units of the play and breath registers, from the datasheet
+const (
+	frameDelayUnit = 11 * time.Millisecond
+	fadeUnit       = 26 * time.Millisecond
+	extinguishUnit = 3500 * time.Microsecond
+	adcRateUnit    = 46 * time.Microsecond
+)
+
+// Autoplay makes the chip show count frames in turn by itself, from the start
+// frame, each for delay (from 11ms to 704ms in 11ms steps). Frames are played
+// loops times (up to 7), or endlessly when loops is 0. Count 0 plays all the
+// 8 frames. SetActiveFrame goes back to showing a single frame.
+func (d *Device) Autoplay(start, count, loops uint8, delay time.Duration) (err error) {
+	if start > FRAME_7 {
+		return fmt.Errorf("frame %d is out of valid range [0-7]", start)
+	}
+	if count > 8 {
+		return fmt.Errorf("count %d is out of valid range [0-8]", count)
+	}
+	if loops > 7 {
+		return fmt.Errorf("loops %d is out of valid range [0-7]", loops)
+	}
+
+	// 8 frames and a 64 steps delay are written as 0
+	steps := (delay + frameDelayUnit/2) / frameDelayUnit
+	if steps < 1 {
+		steps = 1
+	} else if steps > 64 {
+		steps = 64
+	}
+
+	err = d.writeFunctionRegister(SET_AUTOPLAY_1, []byte{loops<<4 | count&0x07})
+	if err != nil {
+		return err
+	}
+	err = d.writeFunctionRegister(SET_AUTOPLAY_2, []byte{uint8(steps) & 0x3F})
+	if err != nil {
+		return err
+	}
+	err = d.writeFunctionRegister(SET_DISPLAY_MODE, []byte{DISPLAY_MODE_AUTOPLAY | start})
+	if err != nil {
+		return err
+	}
+
+	d.playing = true
+	return nil
+}
+
+// AudioPlay makes the chip show the frame picked by the level of the audio
+// input, from the start frame. The input is sampled every sample period, from
+// 46us to 11.8ms in 46us steps. SetActiveFrame goes back to showing a single
+// frame.
+func (d *Device) AudioPlay(start uint8, sample time.Duration) (err error) {
+	if start > FRAME_7 {
+		return fmt.Errorf("frame %d is out of valid range [0-7]", start)
+	}
+
+	// 256 steps are written as 0
+	steps := (sample + adcRateUnit/2) / adcRateUnit
+	if steps < 1 {
+		steps = 1
+	} else if steps > 256 {
+		steps = 256
+	}
+
+	err = d.writeFunctionRegister(SET_ADC_RATE, []byte{uint8(steps)})
+	if err != nil {
+		return err
+	}
+	err = d.writeFunctionRegister(SET_DISPLAY_MODE, []byte{DISPLAY_MODE_AUDIOPLAY | start})
+	if err != nil {
+		return err
+	}
+
+	d.playing = true
+	return nil
+}
+
+// FrameState returns the frame being shown, and whether the auto play has
+// ended
+func (d *Device) FrameState() (frame uint8, done bool, err error) {
+	err = d.selectCommand(FUNCTION)
+	if err != nil {
+		return 0, false, err
+	}
+
+	data := []byte{0}
+	err = d.bus.ReadRegister(d.Address, FRAME_STATE, data)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return data[0] & 0x07, data[0]&FRAME_STATE_DONE != 0, nil
+}
+
+// SetBreath makes the LEDs fade in over fadeIn and out over fadeOut (from
+// 26ms to 3.3s) when the shown frame changes, and stay off for extinguish
+// (from 3.5ms to 448ms) in between. Times are rounded up to powers of two of
+// the smallest ones. Breath is disabled when both fade times are 0.
+func (d *Device) SetBreath(fadeIn, fadeOut, extinguish time.Duration) (err error) {
+	if fadeIn == 0 && fadeOut == 0 {
+		return d.writeFunctionRegister(SET_BREATH_2, []byte{0})
+	}
+
+	err = d.writeFunctionRegister(SET_BREATH_1, []byte{breathSteps(fadeOut, fadeUnit)<<4 | breathSteps(fadeIn, fadeUnit)})
+	if err != nil {
+		return err
+	}
+
+	return d.writeFunctionRegister(SET_BREATH_2, []byte{BREATH_ON | breathSteps(extinguish, extinguishUnit)})
+}
+
+// breathSteps returns the smallest n for which unit*2^n is at least t, up to 7
+func breathSteps(t, unit time.Duration) uint8 {
+	n := uint8(0)
+	for n < 7 && unit<<n < t {
+		n++
+	}
+	return n
+}
+
+// SetAudioSync enables the audio input to modulate the intensity of the LEDs
+func (d *Device) SetAudioSync(on bool) (err error) {
+	value := AUDIOSYNC_OFF
+	if on {
+		value = AUDIOSYNC_ON
+	}
+
+	return d.writeFunctionRegister(SET_AUDIOSYNC, []byte{value})
+}
+
+// SetAudioGain sets the gain of the audio input, from 0dB to 21dB in 3dB
+// steps, and whether it is automatically controlled, in the slow or fast mode
+func (d *Device) SetAudioGain(gainDB uint8, agc, fast bool) (err error) {
+	if gainDB > 21 {
+		return fmt.Errorf("gain %ddB is out of valid range [0-21]", gainDB)
+	}
+
+	value := gainDB / 3
+	if agc {
+		value |= AGC_ON
+	}
+	if fast {
+		value |= AGC_FAST
+	}
+
+	return d.writeFunctionRegister(SET_AGC, []byte{value})
+}
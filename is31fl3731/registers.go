@@ -31,11 +31,31 @@ const (
 	// Configuration:
 	SET_DISPLAY_MODE uint8 = 0x00
 	SET_ACTIVE_FRAME uint8 = 0x01
+	SET_AUTOPLAY_1   uint8 = 0x02 // loops and number of frames
+	SET_AUTOPLAY_2   uint8 = 0x03 // frame delay
 	SET_AUDIOSYNC    uint8 = 0x06
+	FRAME_STATE      uint8 = 0x07
+	SET_BREATH_1     uint8 = 0x08 // fade in and fade out times
+	SET_BREATH_2     uint8 = 0x09 // enable and extinguish time
 	SET_SHUTDOWN     uint8 = 0x0A
+	SET_AGC          uint8 = 0x0B
+	SET_ADC_RATE     uint8 = 0x0C
 
-	// Configuration: display mode
-	DISPLAY_MODE_PICTURE uint8 = 0x00
+	// Configuration: display mode, ORed with the start frame in the auto and
+	// audio frame play modes
+	DISPLAY_MODE_PICTURE   uint8 = 0x00
+	DISPLAY_MODE_AUTOPLAY  uint8 = 0x08
+	DISPLAY_MODE_AUDIOPLAY uint8 = 0x10
+
+	// Configuration: frame state
+	FRAME_STATE_DONE uint8 = 0x10 // the auto play has ended
+
+	// Configuration: breath
+	BREATH_ON uint8 = 0x10
+
+	// Configuration: audio gain control, ORed with the gain in 3dB steps
+	AGC_ON   uint8 = 0x08
+	AGC_FAST uint8 = 0x10
 
 	// Configuration: audiosync (enable audio signal to modulate the intensity of
 	// the matrix)
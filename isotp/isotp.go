@@ -0,0 +1,296 @@
+// Package isotp implements the ISO 15765-2 (ISO-TP) transport protocol, used
+// to send messages of up to 4095 bytes over a classic CAN bus, for example
+// for vehicle diagnostics.
+//
+// The package does not depend on a specific CAN controller. Any
+// drivers.CANBus can be used, such as *mcp2515.Device.
+package isotp // import "tinygo.org/x/drivers/isotp"
+
+import (
+	"errors"
+	"runtime"
+	"time"
+
+	"tinygo.org/x/drivers"
+)
+
+var (
+	ErrTimeout         = errors.New("isotp: timeout")
+	ErrMessageTooLong  = errors.New("isotp: message too long")
+	ErrBufferTooSmall  = errors.New("isotp: receive buffer too small")
+	ErrOverflow        = errors.New("isotp: receiver reported overflow")
+	ErrUnexpectedFrame = errors.New("isotp: unexpected frame")
+	ErrSequence        = errors.New("isotp: wrong consecutive frame sequence number")
+)
+
+// MaxMessageSize is the maximum length of an ISO-TP message on classic CAN.
+const MaxMessageSize = 4095
+
+// Protocol control information frame types.
+const (
+	singleFrame      = 0x00
+	firstFrame       = 0x10
+	consecutiveFrame = 0x20
+	flowControlFrame = 0x30
+)
+
+// Flow control status.
+const (
+	flowContinue = 0x00
+	flowWait     = 0x01
+	flowOverflow = 0x02
+)
+
+// maxWait is the number of flow control wait frames accepted in a row.
+const maxWait = 10
+
+// Config holds the ISO-TP connection parameters.
+type Config struct {
+	// TxID is the CAN ID used to send frames.
+	TxID uint32
+
+	// RxID is the CAN ID of the frames to receive. Frames with other IDs are
+	// ignored.
+	RxID uint32
+
+	// FlowControlID is the CAN ID used to send flow control frames. It
+	// defaults to TxID, but differs when requests are sent to a functional
+	// (broadcast) address.
+	FlowControlID uint32
+
+	// Extended selects 29-bit identifiers instead of 11-bit ones.
+	Extended bool
+
+	// Padding fills all frames to 8 bytes with PadByte, as required by
+	// most vehicles.
+	Padding bool
+	PadByte byte
+
+	// BlockSize is the number of consecutive frames the peer may send
+	// before waiting for flow control. 0 means no limit.
+	BlockSize uint8
+
+	// SeparationTime is the minimum time the peer should wait between
+	// consecutive frames.
+	SeparationTime time.Duration
+
+	// Timeout is how long to wait for the next frame from the peer. It
+	// defaults to one second.
+	Timeout time.Duration
+}
+
+// Conn is an ISO-TP connection between two CAN IDs.
+type Conn struct {
+	bus drivers.CANBus
+	cfg Config
+	tx  drivers.CANFrame
+	rx  drivers.CANFrame
+}
+
+// New returns a new ISO-TP connection on the bus. Frames with other IDs than
+// RxID are received and dropped, so acceptance filters may be set on the bus
+// to reduce the load.
+func New(bus drivers.CANBus, cfg Config) *Conn {
+	if cfg.FlowControlID == 0 {
+		cfg.FlowControlID = cfg.TxID
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = time.Second
+	}
+	return &Conn{bus: bus, cfg: cfg}
+}
+
+// Send sends a message, segmenting it into multiple frames when it does not
+// fit in a single frame.
+func (c *Conn) Send(msg []byte) error {
+	if len(msg) > MaxMessageSize {
+		return ErrMessageTooLong
+	}
+	if len(msg) <= 7 {
+		c.tx.Data[0] = singleFrame | byte(len(msg))
+		return c.sendFrame(c.cfg.TxID, 1+copy(c.tx.Data[1:], msg))
+	}
+
+	c.tx.Data[0] = firstFrame | byte(len(msg)>>8)
+	c.tx.Data[1] = byte(len(msg))
+	n := copy(c.tx.Data[2:], msg)
+	if err := c.sendFrame(c.cfg.TxID, 8); err != nil {
+		return err
+	}
+	msg = msg[n:]
+
+	seq := byte(1)
+	for len(msg) > 0 {
+		blockSize, stmin, err := c.waitFlowControl()
+		if err != nil {
+			return err
+		}
+		for i := 0; len(msg) > 0 && (blockSize == 0 || i < int(blockSize)); i++ {
+			if stmin > 0 {
+				time.Sleep(stmin)
+			}
+			c.tx.Data[0] = consecutiveFrame | seq&0x0f
+			n = copy(c.tx.Data[1:], msg)
+			if err := c.sendFrame(c.cfg.TxID, 1+n); err != nil {
+				return err
+			}
+			msg = msg[n:]
+			seq++
+		}
+	}
+	return nil
+}
+
+// waitFlowControl waits for a clear to send flow control frame and returns
+// the block size and separation time requested by the receiver.
+func (c *Conn) waitFlowControl() (uint8, time.Duration, error) {
+	for waits := 0; waits < maxWait; waits++ {
+		data, err := c.receiveFrame()
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(data) < 3 || data[0]&0xf0 != flowControlFrame {
+			return 0, 0, ErrUnexpectedFrame
+		}
+		switch data[0] & 0x0f {
+		case flowContinue:
+			return data[1], decodeSeparationTime(data[2]), nil
+		case flowWait:
+			continue
+		default:
+			return 0, 0, ErrOverflow
+		}
+	}
+	return 0, 0, ErrTimeout
+}
+
+// Receive waits for a message and stores it in buf, returning its length.
+// Flow control frames are sent as needed.
+func (c *Conn) Receive(buf []byte) (int, error) {
+	data, err := c.receiveFrame()
+	if err != nil {
+		return 0, err
+	}
+	if len(data) == 0 {
+		return 0, ErrUnexpectedFrame
+	}
+
+	switch data[0] & 0xf0 {
+	case singleFrame:
+		n := int(data[0] & 0x0f)
+		if n == 0 || n > len(data)-1 {
+			return 0, ErrUnexpectedFrame
+		}
+		if n > len(buf) {
+			return 0, ErrBufferTooSmall
+		}
+		return copy(buf, data[1:1+n]), nil
+	case firstFrame:
+		if len(data) < 8 {
+			return 0, ErrUnexpectedFrame
+		}
+		size := int(data[0]&0x0f)<<8 | int(data[1])
+		if size > len(buf) {
+			c.sendFlowControl(flowOverflow)
+			return 0, ErrBufferTooSmall
+		}
+		n := copy(buf[:size], data[2:])
+		return c.receiveConsecutive(buf[:size], n)
+	default:
+		return 0, ErrUnexpectedFrame
+	}
+}
+
+// receiveConsecutive receives the consecutive frames of a segmented message
+// of which the first n bytes have been received.
+func (c *Conn) receiveConsecutive(buf []byte, n int) (int, error) {
+	seq := byte(1)
+	for n < len(buf) {
+		if err := c.sendFlowControl(flowContinue); err != nil {
+			return 0, err
+		}
+		for i := 0; n < len(buf) && (c.cfg.BlockSize == 0 || i < int(c.cfg.BlockSize)); i++ {
+			data, err := c.receiveFrame()
+			if err != nil {
+				return 0, err
+			}
+			if len(data) < 2 || data[0]&0xf0 != consecutiveFrame {
+				return 0, ErrUnexpectedFrame
+			}
+			if data[0]&0x0f != seq&0x0f {
+				return 0, ErrSequence
+			}
+			n += copy(buf[n:], data[1:])
+			seq++
+		}
+	}
+	return n, nil
+}
+
+// sendFlowControl sends a flow control frame with the given status.
+func (c *Conn) sendFlowControl(status byte) error {
+	c.tx.Data[0] = flowControlFrame | status
+	c.tx.Data[1] = c.cfg.BlockSize
+	c.tx.Data[2] = encodeSeparationTime(c.cfg.SeparationTime)
+	return c.sendFrame(c.cfg.FlowControlID, 3)
+}
+
+// sendFrame sends the first n bytes of c.tx.Data, padded if configured.
+func (c *Conn) sendFrame(id uint32, n int) error {
+	if c.cfg.Padding {
+		for i := n; i < len(c.tx.Data); i++ {
+			c.tx.Data[i] = c.cfg.PadByte
+		}
+		n = len(c.tx.Data)
+	}
+	c.tx.ID = id
+	c.tx.Extended = c.cfg.Extended
+	c.tx.DLC = uint8(n)
+	return c.bus.Send(&c.tx)
+}
+
+// receiveFrame waits for the next frame with the receive ID.
+func (c *Conn) receiveFrame() ([]byte, error) {
+	deadline := time.Now().Add(c.cfg.Timeout)
+	for time.Now().Before(deadline) {
+		ok, err := c.bus.Receive(&c.rx)
+		if err != nil {
+			return nil, err
+		}
+		if ok && c.rx.ID == c.cfg.RxID && c.rx.Extended == c.cfg.Extended && !c.rx.Remote {
+			return c.rx.Payload(), nil
+		}
+		if !ok {
+			// Let other goroutines run while polling.
+			runtime.Gosched()
+		}
+	}
+	return nil, ErrTimeout
+}
+
+// decodeSeparationTime decodes the STmin flow control parameter.
+func decodeSeparationTime(st byte) time.Duration {
+	switch {
+	case st <= 0x7f:
+		return time.Duration(st) * time.Millisecond
+	case st >= 0xf1 && st <= 0xf9:
+		return time.Duration(st-0xf0) * 100 * time.Microsecond
+	default:
+		// Reserved values must be treated as the maximum.
+		return 127 * time.Millisecond
+	}
+}
+
+// encodeSeparationTime encodes the STmin flow control parameter, rounding up.
+func encodeSeparationTime(d time.Duration) byte {
+	switch {
+	case d <= 0:
+		return 0
+	case d <= 900*time.Microsecond:
+		return 0xf0 + byte((d+100*time.Microsecond-1)/(100*time.Microsecond))
+	case d >= 127*time.Millisecond:
+		return 0x7f
+	default:
+		return byte((d + time.Millisecond - 1) / time.Millisecond)
+	}
+}
@@ -0,0 +1,119 @@
+package isotp
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/tester"
+)
+
+func TestSingleFrame(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewCANBus()
+	a, b, sniffer := bus.NewNode(), bus.NewNode(), bus.NewNode()
+	tx := New(a, Config{TxID: 0x7E0, RxID: 0x7E8, Padding: true, PadByte: 0xAA})
+	rx := New(b, Config{TxID: 0x7E8, RxID: 0x7E0})
+
+	c.Assert(tx.Send([]byte{0x01, 0x0C}), qt.IsNil)
+	var f drivers.CANFrame
+	ok, err := sniffer.Receive(&f)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(f.ID, qt.Equals, uint32(0x7E0))
+	c.Assert(f.Payload(), qt.DeepEquals, []byte{0x02, 0x01, 0x0C, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA})
+
+	buf := make([]byte, 16)
+	n, err := rx.Receive(buf)
+	c.Assert(err, qt.IsNil)
+	c.Assert(buf[:n], qt.DeepEquals, []byte{0x01, 0x0C})
+}
+
+func TestMultiFrame(t *testing.T) {
+	for _, blockSize := range []uint8{0, 1, 3} {
+		c := qt.New(t)
+		bus := tester.NewCANBus()
+		a, b := bus.NewNode(), bus.NewNode()
+		tx := New(a, Config{TxID: 0x7E0, RxID: 0x7E8})
+		rx := New(b, Config{TxID: 0x7E8, RxID: 0x7E0, BlockSize: blockSize, SeparationTime: 500 * time.Microsecond})
+
+		msg := make([]byte, 300)
+		for i := range msg {
+			msg[i] = byte(i)
+		}
+		done := make(chan error)
+		go func() {
+			done <- tx.Send(msg)
+		}()
+
+		buf := make([]byte, MaxMessageSize)
+		n, err := rx.Receive(buf)
+		c.Assert(err, qt.IsNil)
+		c.Assert(<-done, qt.IsNil)
+		c.Assert(buf[:n], qt.DeepEquals, msg)
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewCANBus()
+	a, b := bus.NewNode(), bus.NewNode()
+	tx := New(a, Config{TxID: 0x7E0, RxID: 0x7E8})
+	rx := New(b, Config{TxID: 0x7E8, RxID: 0x7E0})
+
+	done := make(chan error)
+	go func() {
+		done <- tx.Send(make([]byte, 100))
+	}()
+	_, err := rx.Receive(make([]byte, 50))
+	c.Assert(err, qt.Equals, ErrBufferTooSmall)
+	c.Assert(<-done, qt.Equals, ErrOverflow)
+
+	c.Assert(tx.Send(make([]byte, MaxMessageSize+1)), qt.Equals, ErrMessageTooLong)
+}
+
+func TestExtended(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewCANBus()
+	a, b := bus.NewNode(), bus.NewNode()
+	tx := New(a, Config{TxID: 0x18DA10F1, RxID: 0x18DAF110, Extended: true})
+	rx := New(b, Config{TxID: 0x18DAF110, RxID: 0x18DA10F1, Extended: true})
+
+	// Frames with other IDs are ignored.
+	c.Assert(a.Send(&drivers.CANFrame{ID: 0x18DA10F1 & 0x7FF, DLC: 2, Data: [8]byte{0x01, 0xFF}}), qt.IsNil)
+	c.Assert(tx.Send([]byte{0x22, 0xF1, 0x90}), qt.IsNil)
+	buf := make([]byte, 8)
+	n, err := rx.Receive(buf)
+	c.Assert(err, qt.IsNil)
+	c.Assert(buf[:n], qt.DeepEquals, []byte{0x22, 0xF1, 0x90})
+}
+
+func TestTimeout(t *testing.T) {
+	c := qt.New(t)
+	a := tester.NewCANBus().NewNode()
+	conn := New(a, Config{TxID: 0x7E0, RxID: 0x7E8, Timeout: 10 * time.Millisecond})
+	_, err := conn.Receive(make([]byte, 8))
+	c.Assert(err, qt.Equals, ErrTimeout)
+	c.Assert(conn.Send(make([]byte, 20)), qt.Equals, ErrTimeout)
+}
+
+func TestSeparationTime(t *testing.T) {
+	c := qt.New(t)
+	for _, tc := range []struct {
+		st byte
+		d  time.Duration
+	}{
+		{0x00, 0},
+		{0x14, 20 * time.Millisecond},
+		{0x7f, 127 * time.Millisecond},
+		{0xf1, 100 * time.Microsecond},
+		{0xf9, 900 * time.Microsecond},
+	} {
+		c.Assert(decodeSeparationTime(tc.st), qt.Equals, tc.d)
+		c.Assert(encodeSeparationTime(tc.d), qt.Equals, tc.st)
+	}
+	c.Assert(decodeSeparationTime(0x80), qt.Equals, 127*time.Millisecond)
+	c.Assert(encodeSeparationTime(950*time.Microsecond), qt.Equals, byte(0x01))
+}
@@ -0,0 +1,41 @@
+package ledstrip
+
+import "image/color"
+
+// HSV returns the color of hue h, saturation s and value v. Hues go from
+// red (0) to green (85), blue (170) and back to red.
+func HSV(h, s, v uint8) color.RGBA {
+	// Each of the 6 sectors of the hue circle is 43 wide, roughly.
+	sector := uint16(h) * 6
+	f := uint16(sector & 0xFF) // position in the sector
+	p := uint8(uint16(v) * (255 - uint16(s)) / 255)
+	q := uint8(uint16(v) * (255 - uint16(s)*f/255) / 255)
+	t := uint8(uint16(v) * (255 - uint16(s)*(255-f)/255) / 255)
+	switch sector >> 8 {
+	case 0:
+		return color.RGBA{v, t, p, 255}
+	case 1:
+		return color.RGBA{q, v, p, 255}
+	case 2:
+		return color.RGBA{p, v, t, 255}
+	case 3:
+		return color.RGBA{p, q, v, 255}
+	case 4:
+		return color.RGBA{t, p, v, 255}
+	default:
+		return color.RGBA{v, p, q, 255}
+	}
+}
+
+// Blend returns the color between a (amount 0) and b (amount 255).
+func Blend(a, b color.RGBA, amount uint8) color.RGBA {
+	m := func(x, y uint8) uint8 {
+		return uint8((uint16(x)*uint16(255-amount) + uint16(y)*uint16(amount) + 127) / 255)
+	}
+	return color.RGBA{m(a.R, b.R), m(a.G, b.G), m(a.B, b.B), 255}
+}
+
+// Scale returns c dimmed to amount/255.
+func Scale(c color.RGBA, amount uint8) color.RGBA {
+	return Blend(color.RGBA{}, c, amount)
+}
@@ -0,0 +1,218 @@
+package ledstrip
+
+import (
+	"image/color"
+	"time"
+)
+
+// Effect is an animation drawn on the LEDs of a strip.
+type Effect interface {
+	// Draw draws the frame shown at time t from the start of the effect.
+	Draw(pixels []color.RGBA, t time.Duration)
+}
+
+// Fade fades all the LEDs from a color to another.
+type Fade struct {
+	From, To color.RGBA
+	Duration time.Duration
+}
+
+func (e *Fade) Draw(pixels []color.RGBA, t time.Duration) {
+	c := e.To
+	if t < e.Duration {
+		c = Blend(e.From, e.To, uint8(t*255/e.Duration))
+	}
+	for i := range pixels {
+		pixels[i] = c
+	}
+}
+
+// Rainbow shows all the hues along the strip, moving by a whole cycle every
+// Period.
+type Rainbow struct {
+	Period time.Duration
+
+	// Value is the brightness of the colors, 255 if zero.
+	Value uint8
+}
+
+func (e *Rainbow) Draw(pixels []color.RGBA, t time.Duration) {
+	v := e.Value
+	if v == 0 {
+		v = 255
+	}
+	var shift uint32
+	if e.Period > 0 {
+		shift = uint32(t % e.Period * 256 / e.Period)
+	}
+	for i := range pixels {
+		h := uint32(i)*256/uint32(len(pixels)) + shift
+		pixels[i] = HSV(uint8(h), 255, v)
+	}
+}
+
+// Comet moves a LED with a fading tail along the strip, from one end to the
+// other every Period.
+type Comet struct {
+	Color  color.RGBA
+	Length int // of the tail
+	Period time.Duration
+}
+
+func (e *Comet) Draw(pixels []color.RGBA, t time.Duration) {
+	n := len(pixels)
+	if n == 0 || e.Period <= 0 {
+		return
+	}
+	// The head runs past the end until the tail has left.
+	span := n + e.Length
+	head := int(t % e.Period * time.Duration(span) / e.Period)
+	for i := range pixels {
+		d := head - i
+		if d < 0 || d > e.Length {
+			pixels[i] = color.RGBA{}
+			continue
+		}
+		pixels[i] = Scale(e.Color, uint8(255-d*255/(e.Length+1)))
+	}
+}
+
+// Fire simulates flames rising from the start of the strip. The higher
+// Cooling, the shorter the flames, and the higher Sparking, the more active
+// the fire. It is animated at the frame rate it is drawn at.
+type Fire struct {
+	Cooling, Sparking uint8
+	heat              []uint8
+	seed              uint32
+}
+
+// NewFire returns a fire with usual settings.
+func NewFire() *Fire {
+	return &Fire{Cooling: 55, Sparking: 120}
+}
+
+// random returns a pseudo-random number below n.
+func (e *Fire) random(n uint32) uint32 {
+	if e.seed == 0 {
+		e.seed = 2463534242
+	}
+	e.seed ^= e.seed << 13
+	e.seed ^= e.seed >> 17
+	e.seed ^= e.seed << 5
+	return e.seed % n
+}
+
+func (e *Fire) Draw(pixels []color.RGBA, t time.Duration) {
+	n := len(pixels)
+	if len(e.heat) != n {
+		e.heat = make([]uint8, n)
+	}
+	if n == 0 {
+		return
+	}
+	// Cool down every cell.
+	for i := range e.heat {
+		cool := e.random(uint32(e.Cooling)*10/uint32(n) + 2)
+		if uint32(e.heat[i]) > cool {
+			e.heat[i] -= uint8(cool)
+		} else {
+			e.heat[i] = 0
+		}
+	}
+	// Heat drifts up.
+	for i := n - 1; i >= 2; i-- {
+		e.heat[i] = uint8((uint16(e.heat[i-1]) + 2*uint16(e.heat[i-2])) / 3)
+	}
+	// Ignite new sparks near the bottom.
+	if e.random(255) < uint32(e.Sparking) {
+		i := int(e.random(7))
+		if i >= n {
+			i = n - 1
+		}
+		h := uint16(e.heat[i]) + 160 + uint16(e.random(95))
+		if h > 255 {
+			h = 255
+		}
+		e.heat[i] = uint8(h)
+	}
+	for i, h := range e.heat {
+		pixels[i] = heatColor(h)
+	}
+}
+
+// heatColor returns the color of a temperature, from black to red, yellow
+// and white.
+func heatColor(h uint8) color.RGBA {
+	t := uint16(h) * 191 / 255
+	ramp := uint8(t&0x3F) << 2
+	switch {
+	case t > 0x80:
+		return color.RGBA{255, 255, ramp, 255}
+	case t > 0x40:
+		return color.RGBA{255, ramp, 0, 255}
+	default:
+		return color.RGBA{ramp, 0, 0, 255}
+	}
+}
+
+// Animator shows an effect on a strip at a constant frame rate.
+type Animator struct {
+	strip  *Strip
+	effect Effect
+	frame  time.Duration
+	start  time.Time
+	next   time.Time
+}
+
+// now is replaced in tests.
+var now = time.Now
+
+// NewAnimator returns an animator showing fps frames per second on s.
+func NewAnimator(s *Strip, fps int) *Animator {
+	if fps <= 0 {
+		fps = 60
+	}
+	return &Animator{strip: s, frame: time.Second / time.Duration(fps)}
+}
+
+// Play starts showing e from its beginning.
+func (a *Animator) Play(e Effect) {
+	a.effect = e
+	a.start = now()
+	a.next = a.start
+}
+
+// Elapsed returns the time since the current effect started.
+func (a *Animator) Elapsed() time.Duration {
+	return now().Sub(a.start)
+}
+
+// Update shows the next frame if it is due, and returns whether it did. Call
+// it in the main loop of the program.
+func (a *Animator) Update() (bool, error) {
+	t := now()
+	if a.effect == nil || t.Before(a.next) {
+		return false, nil
+	}
+	a.next = a.next.Add(a.frame)
+	if a.next.Before(t) {
+		// Skip the frames that are late.
+		a.next = t.Add(a.frame)
+	}
+	a.effect.Draw(a.strip.pixels, t.Sub(a.start))
+	return true, a.strip.Show()
+}
+
+// Run shows the effect for d, sleeping between the frames.
+func (a *Animator) Run(d time.Duration) error {
+	end := now().Add(d)
+	for now().Before(end) {
+		if _, err := a.Update(); err != nil {
+			return err
+		}
+		if wait := a.next.Sub(now()); wait > 0 {
+			time.Sleep(wait)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Package ledstrip drives addressable LED strips, such as WS2812, SK6812 or
+// APA102 strips, with gamma correction, brightness and power limiting.
+//
+// A Strip holds the colors of its LEDs and writes them to the driver of the
+// strip, which is any io.Writer sending raw bytes: ws2812.Device,
+// ws2812spi.Device or apa102.Device. Matrix maps a strip folded into a 2D
+// matrix to a drivers.Displayer, and Animator shows effects at a constant
+// frame rate.
+package ledstrip // import "tinygo.org/x/drivers/ledstrip"
+
+import (
+	"image/color"
+	"io"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+// Protocol is how the colors of a LED are framed.
+type Protocol uint8
+
+const (
+	// WS2812 strips, and others taking a byte per channel, such as SK6812.
+	WS2812 Protocol = iota
+
+	// APA102 strips, which take a brightness byte before the channels.
+	APA102
+)
+
+// Order is the order of the color channels sent to each LED.
+type Order uint8
+
+const (
+	// DefaultOrder is GRB for WS2812 strips and BGR for APA102 strips.
+	DefaultOrder Order = iota
+	RGB
+	RBG
+	GRB
+	GBR
+	BRG
+	BGR
+
+	// RGBW and GRBW are for strips with a white LED, such as SK6812 RGBW
+	// strips. The white LED shows the white part of the colors.
+	RGBW
+	GRBW
+)
+
+// channels holds the index of the channels of each order in R, G, B, W.
+var channels = [...][]uint8{
+	RGB:  {0, 1, 2},
+	RBG:  {0, 2, 1},
+	GRB:  {1, 0, 2},
+	GBR:  {1, 2, 0},
+	BRG:  {2, 0, 1},
+	BGR:  {2, 1, 0},
+	RGBW: {0, 1, 2, 3},
+	GRBW: {1, 0, 2, 3},
+}
+
+// Config is the configuration of a strip.
+type Config struct {
+	// Protocol of the LEDs, WS2812 by default.
+	Protocol Protocol
+
+	// Order of the channels.
+	Order Order
+
+	// Gamma corrects the colors if set, such as with pixel.NewGamma(2.6).
+	Gamma *pixel.Gamma
+
+	// Brightness scales all the colors, 255 by default.
+	Brightness uint8
+
+	// MaxMilliamps limits the current drawn by the strip by dimming it, if
+	// set.
+	MaxMilliamps uint32
+
+	// MilliampsPerChannel is the current of a channel at full brightness,
+	// 20mA by default, and IdleMilliamps the current of a LED when it is
+	// off, 1mA by default. They are used to estimate the current of the
+	// strip.
+	MilliampsPerChannel uint32
+	IdleMilliamps       uint32
+}
+
+// Strip is a LED strip.
+type Strip struct {
+	w          io.Writer
+	protocol   Protocol
+	channels   []uint8
+	gamma      *pixel.Gamma
+	brightness uint8
+	maxMA      uint32
+	channelMA  uint32
+	idleMA     uint32
+	pixels     []color.RGBA
+	buf        []byte
+	ledSize    int // bytes per LED in buf
+}
+
+// New returns a strip of count LEDs written to w.
+func New(w io.Writer, count int, cfg Config) *Strip {
+	s := &Strip{
+		w:          w,
+		protocol:   cfg.Protocol,
+		gamma:      cfg.Gamma,
+		brightness: cfg.Brightness,
+		maxMA:      cfg.MaxMilliamps,
+		channelMA:  cfg.MilliampsPerChannel,
+		idleMA:     cfg.IdleMilliamps,
+		pixels:     make([]color.RGBA, count),
+	}
+	order := cfg.Order
+	if order == DefaultOrder || int(order) >= len(channels) {
+		order = GRB
+		if s.protocol == APA102 {
+			order = BGR
+		}
+	}
+	s.channels = channels[order]
+	if s.brightness == 0 {
+		s.brightness = 255
+	}
+	if s.channelMA == 0 {
+		s.channelMA = 20
+	}
+	if s.idleMA == 0 {
+		s.idleMA = 1
+	}
+	s.ledSize = len(s.channels)
+	if s.protocol == APA102 {
+		s.ledSize++
+	}
+	s.buf = make([]byte, s.ledSize*count)
+	return s
+}
+
+// Len returns the number of LEDs.
+func (s *Strip) Len() int {
+	return len(s.pixels)
+}
+
+// Pixels returns the colors of the LEDs, to change them before Show.
+func (s *Strip) Pixels() []color.RGBA {
+	return s.pixels
+}
+
+// Set changes the color of LED i.
+func (s *Strip) Set(i int, c color.RGBA) {
+	if i >= 0 && i < len(s.pixels) {
+		s.pixels[i] = c
+	}
+}
+
+// Get returns the color of LED i.
+func (s *Strip) Get(i int) color.RGBA {
+	if i < 0 || i >= len(s.pixels) {
+		return color.RGBA{}
+	}
+	return s.pixels[i]
+}
+
+// Fill sets all the LEDs to c.
+func (s *Strip) Fill(c color.RGBA) {
+	for i := range s.pixels {
+		s.pixels[i] = c
+	}
+}
+
+// SetBrightness changes the brightness, from 0 (off) to 255.
+func (s *Strip) SetBrightness(brightness uint8) {
+	s.brightness = brightness
+}
+
+// Brightness returns the brightness.
+func (s *Strip) Brightness() uint8 {
+	return s.brightness
+}
+
+// Show writes the colors to the strip.
+func (s *Strip) Show() error {
+	var sum uint32 // of the channels sent
+	for i, c := range s.pixels {
+		v := [4]uint8{c.R, c.G, c.B}
+		if len(s.channels) == 4 {
+			// Show the white part with the white LED.
+			w := v[0]
+			if v[1] < w {
+				w = v[1]
+			}
+			if v[2] < w {
+				w = v[2]
+			}
+			v = [4]uint8{v[0] - w, v[1] - w, v[2] - w, w}
+		}
+		led := s.buf[i*s.ledSize : (i+1)*s.ledSize]
+		if s.protocol == APA102 {
+			led[0] = 0xFF // full global brightness
+			led = led[1:]
+		}
+		for j, ch := range s.channels {
+			b := v[ch]
+			if s.gamma != nil {
+				b = s.gamma[b]
+			}
+			b = uint8((uint16(b)*uint16(s.brightness) + 254) / 255)
+			led[j] = b
+			sum += uint32(b)
+		}
+	}
+	s.limitPower(sum)
+	_, err := s.w.Write(s.buf)
+	return err
+}
+
+// Milliamps returns the estimated current drawn by the strip with the
+// colors last shown.
+func (s *Strip) Milliamps() uint32 {
+	var sum uint32
+	for i := 0; i < len(s.pixels); i++ {
+		led := s.buf[i*s.ledSize : (i+1)*s.ledSize]
+		if s.protocol == APA102 {
+			led = led[1:]
+		}
+		for _, b := range led {
+			sum += uint32(b)
+		}
+	}
+	return s.current(sum)
+}
+
+func (s *Strip) current(sum uint32) uint32 {
+	return (sum*s.channelMA+254)/255 + s.idleMA*uint32(len(s.pixels))
+}
+
+// limitPower dims the colors in buf if they draw more than the maximum
+// current. sum is the sum of their channels.
+func (s *Strip) limitPower(sum uint32) {
+	if s.maxMA == 0 || s.current(sum) <= s.maxMA {
+		return
+	}
+	var scale uint32 // in 1/65536
+	idle := s.idleMA * uint32(len(s.pixels))
+	if s.maxMA > idle {
+		scale = uint32(uint64(s.maxMA-idle) * 255 * 65536 / (uint64(sum) * uint64(s.channelMA)))
+	}
+	for i := 0; i < len(s.pixels); i++ {
+		led := s.buf[i*s.ledSize : (i+1)*s.ledSize]
+		if s.protocol == APA102 {
+			led = led[1:]
+		}
+		for j, b := range led {
+			led[j] = uint8(uint32(b) * scale >> 16)
+		}
+	}
+}
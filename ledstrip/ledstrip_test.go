@@ -0,0 +1,168 @@
+package ledstrip
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/pixel"
+)
+
+var (
+	red   = color.RGBA{255, 0, 0, 255}
+	white = color.RGBA{255, 255, 255, 255}
+)
+
+func TestShow(t *testing.T) {
+	c := qt.New(t)
+	var buf bytes.Buffer
+	s := New(&buf, 2, Config{})
+	s.Set(0, color.RGBA{1, 2, 3, 255})
+	s.Set(1, red)
+	s.Set(2, red) // out of range
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(buf.Bytes(), qt.DeepEquals, []byte{2, 1, 3, 0, 255, 0})
+
+	buf.Reset()
+	s = New(&buf, 1, Config{Protocol: APA102})
+	s.Fill(color.RGBA{1, 2, 3, 255})
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(buf.Bytes(), qt.DeepEquals, []byte{0xFF, 3, 2, 1})
+
+	// The white part of the colors goes to the white LED.
+	buf.Reset()
+	s = New(&buf, 1, Config{Order: RGBW})
+	s.Fill(color.RGBA{200, 100, 50, 255})
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(buf.Bytes(), qt.DeepEquals, []byte{150, 50, 0, 50})
+}
+
+func TestBrightness(t *testing.T) {
+	c := qt.New(t)
+	var buf bytes.Buffer
+	s := New(&buf, 1, Config{Order: RGB, Gamma: pixel.NewGamma(2), Brightness: 128})
+	s.Fill(color.RGBA{255, 128, 0, 255})
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(buf.Bytes(), qt.DeepEquals, []byte{128, 33, 0})
+
+	buf.Reset()
+	s.SetBrightness(0)
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(buf.Bytes(), qt.DeepEquals, []byte{0, 0, 0})
+}
+
+func TestPowerLimit(t *testing.T) {
+	c := qt.New(t)
+	var buf bytes.Buffer
+	// 10 white LEDs draw 10*(3*20+1) = 610mA.
+	s := New(&buf, 10, Config{})
+	s.Fill(white)
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(s.Milliamps(), qt.Equals, uint32(610))
+
+	s = New(&buf, 10, Config{MaxMilliamps: 310})
+	s.Fill(white)
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(s.Milliamps() <= 310, qt.IsTrue)
+	c.Assert(s.Milliamps() > 300, qt.IsTrue, qt.Commentf("%d mA", s.Milliamps()))
+
+	// Below the limit, colors are untouched.
+	s.Fill(color.RGBA{10, 0, 0, 255})
+	buf.Reset()
+	c.Assert(s.Show(), qt.IsNil)
+	c.Assert(buf.Bytes()[:3], qt.DeepEquals, []byte{0, 10, 0})
+}
+
+func TestMatrix(t *testing.T) {
+	c := qt.New(t)
+	s := New(&bytes.Buffer{}, 12, Config{})
+	m := NewMatrix(s, MatrixConfig{Width: 4, Height: 3, Serpentine: true})
+	c.Assert(m.Index(0, 0), qt.Equals, 0)
+	c.Assert(m.Index(3, 0), qt.Equals, 3)
+	c.Assert(m.Index(3, 1), qt.Equals, 4)
+	c.Assert(m.Index(0, 1), qt.Equals, 7)
+	c.Assert(m.Index(0, 2), qt.Equals, 8)
+	c.Assert(m.Index(4, 0), qt.Equals, -1)
+
+	m = NewMatrix(s, MatrixConfig{Width: 4, Height: 3, Vertical: true})
+	c.Assert(m.Index(1, 0), qt.Equals, 3)
+	c.Assert(m.Index(1, 2), qt.Equals, 5)
+	m.SetPixel(1, 2, red)
+	c.Assert(s.Get(5), qt.Equals, red)
+	c.Assert(m.GetPixel(1, 2), qt.Equals, red)
+}
+
+func TestHSV(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(HSV(0, 255, 255), qt.Equals, red)
+	c.Assert(HSV(0, 0, 255), qt.Equals, white)
+	for _, h := range []uint8{85, 170} {
+		col := HSV(h, 255, 255)
+		max := map[uint8]uint8{85: col.G, 170: col.B}[h]
+		c.Assert(max, qt.Equals, uint8(255))
+		c.Assert(int(col.R)+int(col.G)+int(col.B) < 255+8, qt.IsTrue, qt.Commentf("%v", col))
+	}
+	c.Assert(Blend(red, white, 255), qt.Equals, white)
+	c.Assert(Scale(white, 0), qt.Equals, color.RGBA{0, 0, 0, 255})
+}
+
+func TestEffects(t *testing.T) {
+	c := qt.New(t)
+	pixels := make([]color.RGBA, 10)
+
+	f := &Fade{From: red, To: white, Duration: time.Second}
+	f.Draw(pixels, 0)
+	c.Assert(pixels[9], qt.Equals, red)
+	f.Draw(pixels, 2*time.Second)
+	c.Assert(pixels[0], qt.Equals, white)
+
+	r := &Rainbow{Period: time.Second}
+	r.Draw(pixels, 0)
+	c.Assert(pixels[0], qt.Equals, red)
+	r.Draw(pixels, time.Second/2)
+	c.Assert(pixels[0], qt.Equals, HSV(128, 255, 255))
+
+	// The head of the comet is at the middle after half its period.
+	co := &Comet{Color: white, Length: 2, Period: 12 * time.Second}
+	co.Draw(pixels, 6*time.Second)
+	c.Assert(pixels[6], qt.Equals, white)
+	c.Assert(pixels[5].R < 255 && pixels[5].R > pixels[4].R, qt.IsTrue)
+	c.Assert(pixels[3], qt.Equals, color.RGBA{})
+	c.Assert(pixels[7], qt.Equals, color.RGBA{})
+
+	fire := NewFire()
+	for i := 0; i < 50; i++ {
+		fire.Draw(pixels, 0)
+	}
+	c.Assert(pixels[0].R > 0 || pixels[1].R > 0, qt.IsTrue)
+}
+
+func TestAnimator(t *testing.T) {
+	c := qt.New(t)
+	clock := time.Unix(0, 0)
+	now = func() time.Time { return clock }
+	defer func() { now = time.Now }()
+
+	var buf bytes.Buffer
+	s := New(&buf, 3, Config{Order: RGB})
+	a := NewAnimator(s, 10)
+	shown, err := a.Update()
+	c.Assert(shown, qt.IsFalse)
+
+	a.Play(&Fade{From: color.RGBA{}, To: white, Duration: time.Second})
+	shown, err = a.Update()
+	c.Assert(err, qt.IsNil)
+	c.Assert(shown, qt.IsTrue)
+	shown, _ = a.Update()
+	c.Assert(shown, qt.IsFalse)
+
+	clock = clock.Add(100 * time.Millisecond)
+	buf.Reset()
+	shown, _ = a.Update()
+	c.Assert(shown, qt.IsTrue)
+	c.Assert(buf.Bytes()[0], qt.Equals, uint8(25))
+	c.Assert(a.Elapsed(), qt.Equals, 100*time.Millisecond)
+}
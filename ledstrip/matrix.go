@@ -0,0 +1,69 @@
+package ledstrip
+
+import "image/color"
+
+// MatrixConfig is how a strip is laid out in a matrix. The strip starts at
+// the top left corner and runs along the rows, from left to right.
+type MatrixConfig struct {
+	Width, Height int16
+
+	// Serpentine strips run back from right to left on odd rows.
+	Serpentine bool
+
+	// Vertical strips run along the columns instead, from top to bottom.
+	Vertical bool
+}
+
+// Matrix is a strip laid out in a matrix, such as a 16x16 LED panel. It
+// implements drivers.Displayer.
+type Matrix struct {
+	*Strip
+	cfg MatrixConfig
+}
+
+// NewMatrix returns the strip s laid out in a matrix.
+func NewMatrix(s *Strip, cfg MatrixConfig) *Matrix {
+	return &Matrix{Strip: s, cfg: cfg}
+}
+
+// Size returns the size of the matrix.
+func (m *Matrix) Size() (x, y int16) {
+	return m.cfg.Width, m.cfg.Height
+}
+
+// Index returns the index in the strip of the LED at x, y, or -1 if there is
+// none.
+func (m *Matrix) Index(x, y int16) int {
+	if x < 0 || x >= m.cfg.Width || y < 0 || y >= m.cfg.Height {
+		return -1
+	}
+	line, pos, length := int(y), int(x), int(m.cfg.Width)
+	if m.cfg.Vertical {
+		line, pos, length = int(x), int(y), int(m.cfg.Height)
+	}
+	if m.cfg.Serpentine && line%2 == 1 {
+		pos = length - 1 - pos
+	}
+	i := line*length + pos
+	if i >= m.Len() {
+		return -1
+	}
+	return i
+}
+
+// SetPixel changes the color of the LED at x, y.
+func (m *Matrix) SetPixel(x, y int16, c color.RGBA) {
+	if i := m.Index(x, y); i >= 0 {
+		m.pixels[i] = c
+	}
+}
+
+// GetPixel returns the color of the LED at x, y.
+func (m *Matrix) GetPixel(x, y int16) color.RGBA {
+	return m.Get(m.Index(x, y))
+}
+
+// Display shows the colors on the strip.
+func (m *Matrix) Display() error {
+	return m.Show()
+}
@@ -0,0 +1,136 @@
+package max72xx
+
+// Segments of a digit, in no decode mode.
+const (
+	SegmentDP byte = 0x80
+	SegmentA  byte = 0x40
+	SegmentB  byte = 0x20
+	SegmentC  byte = 0x10
+	SegmentD  byte = 0x08
+	SegmentE  byte = 0x04
+	SegmentF  byte = 0x02
+	SegmentG  byte = 0x01
+)
+
+// Digits is a display of 7-segment digits driven by the chips of a chain,
+// such as 8-digit modules. Digits are numbered from the left, across all
+// the chips: module 0 shows the rightmost ones, and digit register 0 of each
+// chip is its rightmost digit.
+type Digits struct {
+	dev      *Device
+	perChip  int
+	segments []byte // from the left
+	digits   []byte // sent to the chips
+	dirty    uint8
+}
+
+// NewDigits returns a display of the digits of the chain of dev, with
+// perChip digits on each chip, 8 at most.
+func NewDigits(dev *Device, perChip int) *Digits {
+	if perChip < 1 || perChip > 8 {
+		perChip = 8
+	}
+	return &Digits{
+		dev:      dev,
+		perChip:  perChip,
+		segments: make([]byte, perChip*dev.count),
+		digits:   make([]byte, dev.count),
+		dirty:    0xFF,
+	}
+}
+
+// Configure sets up the chips to show the digits and clears them.
+func (d *Digits) Configure() {
+	d.dev.StopDisplayTest()
+	d.dev.SetDecodeMode(0)
+	d.dev.SetScanLimit(uint8(d.perChip))
+	d.dev.StopShutdownMode()
+	d.Clear()
+	d.dirty = 0xFF
+	d.Display()
+}
+
+// Len returns the number of digits.
+func (d *Digits) Len() int {
+	return len(d.segments)
+}
+
+// SetSegments sets the segments lit on digit i, see SegmentA and others.
+func (d *Digits) SetSegments(i int, segments byte) {
+	if i < 0 || i >= len(d.segments) || d.segments[i] == segments {
+		return
+	}
+	d.segments[i] = segments
+	d.dirty |= 1 << ((len(d.segments) - 1 - i) % d.perChip)
+}
+
+// Clear turns all the digits off.
+func (d *Digits) Clear() {
+	for i := range d.segments {
+		d.SetSegments(i, 0)
+	}
+}
+
+// WriteString shows s from the left digit, and clears the digits after it.
+// A dot lights the decimal point of the digit before it.
+func (d *Digits) WriteString(s string) {
+	i := 0
+	for _, r := range s {
+		if r == '.' && i > 0 && d.segments[i-1]&SegmentDP == 0 {
+			d.SetSegments(i-1, d.segments[i-1]|SegmentDP)
+			continue
+		}
+		d.SetSegments(i, Segments(r))
+		i++
+	}
+	for ; i < len(d.segments); i++ {
+		d.SetSegments(i, 0)
+	}
+}
+
+// Display sends the digits which changed since the previous call.
+func (d *Digits) Display() error {
+	n := len(d.segments)
+	for k := 0; k < d.perChip; k++ {
+		if d.dirty&(1<<k) == 0 {
+			continue
+		}
+		for m := range d.digits {
+			d.digits[m] = d.segments[n-1-m*d.perChip-k]
+		}
+		if err := d.dev.WriteCommands(REG_DIGIT0+byte(k), d.digits); err != nil {
+			return err
+		}
+		d.dirty &^= 1 << k
+	}
+	return nil
+}
+
+// segmentFont holds the segments of the ASCII characters from ' ' to 'z'.
+// Letters are drawn in the most readable case.
+var segmentFont = [...]byte{
+	' ': 0x00, '!': 0xA0, '"': 0x22, '\'': 0x02, '(': 0x4E, ')': 0x78,
+	',': 0x80, '-': 0x01, '.': 0x80, '/': 0x25,
+	'0': 0x7E, '1': 0x30, '2': 0x6D, '3': 0x79, '4': 0x33,
+	'5': 0x5B, '6': 0x5F, '7': 0x70, '8': 0x7F, '9': 0x7B,
+	'=': 0x09, '?': 0x65, '[': 0x4E, ']': 0x78, '_': 0x08,
+	'A': 0x77, 'B': 0x1F, 'C': 0x4E, 'D': 0x3D, 'E': 0x4F, 'F': 0x47,
+	'G': 0x5E, 'H': 0x37, 'I': 0x06, 'J': 0x3C, 'K': 0x57, 'L': 0x0E,
+	'M': 0x54, 'N': 0x15, 'O': 0x7E, 'P': 0x67, 'Q': 0x73, 'R': 0x05,
+	'S': 0x5B, 'T': 0x0F, 'U': 0x3E, 'V': 0x1C, 'W': 0x2A, 'X': 0x37,
+	'Y': 0x3B, 'Z': 0x6D,
+	'c': 0x0D, 'h': 0x17, 'o': 0x1D, 'u': 0x1C,
+}
+
+// Segments returns the segments showing r on a digit. Lowercase letters are
+// shown in uppercase, but for c, h, o and u, and other characters as a
+// blank.
+func Segments(r rune) byte {
+	if r >= 'a' && r <= 'z' && (int(r) >= len(segmentFont) || segmentFont[r] == 0) {
+		r -= 'a' - 'A'
+	}
+	if r < 0 || int(r) >= len(segmentFont) {
+		return 0
+	}
+	return segmentFont[r]
+}
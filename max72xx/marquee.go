@@ -0,0 +1,47 @@
+package max72xx
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers/font"
+)
+
+// Marquee scrolls a line of text from right to left on a Matrix.
+type Marquee struct {
+	m     *Matrix
+	f     *font.Font
+	text  string
+	width int16
+	x     int16
+}
+
+// NewMarquee returns a marquee scrolling text in f, which enters from the
+// right of m.
+func NewMarquee(m *Matrix, f *font.Font, text string) *Marquee {
+	s := &Marquee{m: m, f: f}
+	s.SetText(text)
+	return s
+}
+
+// SetText changes the text, which starts again from the right.
+func (s *Marquee) SetText(text string) {
+	s.text = text
+	s.width, _ = font.Measure(s.f, text)
+	s.x = s.m.width
+}
+
+// Step shows the text one column further to the left. It returns true once
+// the text has left the display, and starts again from the right.
+func (s *Marquee) Step() (bool, error) {
+	s.m.ClearDisplay()
+	// Center the line vertically.
+	y := (s.m.height-s.f.Ascent-s.f.Descent)/2 + s.f.Ascent
+	font.Draw(s.m, s.f, s.x, y, s.text, color.RGBA{255, 255, 255, 255})
+	err := s.m.Display()
+	s.x--
+	if s.x < -s.width {
+		s.x = s.m.width
+		return true, err
+	}
+	return false, err
+}
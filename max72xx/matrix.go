@@ -0,0 +1,149 @@
+package max72xx
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+// Matrix is a display made of the 8x8 LED matrices of a chain, such as a
+// 4-in-1 module. It implements drivers.Displayer.
+//
+// Seen from the front, module 0 is at the right end of the top row, and the
+// chain goes on to the left, then on the right end of the next row, as on
+// 4-in-1 modules with DIN on the right. In each module, digit register 0 is
+// the top row and bit 7 the left column, unless the module is rotated.
+type Matrix struct {
+	dev      *Device
+	columns  int16
+	width    int16
+	height   int16
+	rotation []drivers.Rotation
+	buffer   []byte // [module*8 + row], bit 7 on the left
+	rows     []byte
+	dirty    uint8 // rows to send
+}
+
+// MatrixConfig is the layout of the modules of a Matrix.
+type MatrixConfig struct {
+	// Columns is the number of modules in a row of the display, all of them
+	// by default.
+	Columns int16
+
+	// Rotation is the clockwise rotation of each module, to compensate how
+	// it is mounted. A single rotation applies to all the modules.
+	Rotation []drivers.Rotation
+}
+
+// NewMatrix returns a display showing the matrices of the chain of dev.
+func NewMatrix(dev *Device, cfg MatrixConfig) *Matrix {
+	columns := cfg.Columns
+	if columns < 1 || int(columns) > dev.count {
+		columns = int16(dev.count)
+	}
+	rows := (int16(dev.count) + columns - 1) / columns
+	return &Matrix{
+		dev:      dev,
+		columns:  columns,
+		width:    8 * columns,
+		height:   8 * rows,
+		rotation: cfg.Rotation,
+		buffer:   make([]byte, 8*dev.count),
+		rows:     make([]byte, dev.count),
+		dirty:    0xFF,
+	}
+}
+
+// Configure sets up the chips to show the matrices and clears them.
+func (m *Matrix) Configure() {
+	m.dev.StopDisplayTest()
+	m.dev.SetDecodeMode(0)
+	m.dev.SetScanLimit(8)
+	m.dev.StopShutdownMode()
+	m.ClearDisplay()
+	m.dirty = 0xFF
+	m.Display()
+}
+
+// Size returns the size of the display in pixels.
+func (m *Matrix) Size() (x, y int16) {
+	return m.width, m.height
+}
+
+// locate returns the index in the buffer and the bit of a pixel.
+func (m *Matrix) locate(x, y int16) (i int, row uint8, bit byte, ok bool) {
+	if x < 0 || x >= m.width || y < 0 || y >= m.height {
+		return 0, 0, 0, false
+	}
+	module := int(y/8)*int(m.columns) + int(m.columns-1-x/8)
+	if module >= m.dev.count {
+		return 0, 0, 0, false
+	}
+	c, r := uint8(x%8), uint8(y%8)
+	rotation := drivers.Rotation0
+	if len(m.rotation) == 1 {
+		rotation = m.rotation[0]
+	} else if module < len(m.rotation) {
+		rotation = m.rotation[module]
+	}
+	switch rotation {
+	case drivers.Rotation90:
+		c, r = r, 7-c
+	case drivers.Rotation180:
+		c, r = 7-c, 7-r
+	case drivers.Rotation270:
+		c, r = 7-r, c
+	}
+	return module*8 + int(r), r, 0x80 >> c, true
+}
+
+// SetPixel turns a LED on for any color but black.
+func (m *Matrix) SetPixel(x, y int16, c color.RGBA) {
+	i, row, bit, ok := m.locate(x, y)
+	if !ok {
+		return
+	}
+	b := m.buffer[i]
+	if c.R|c.G|c.B != 0 {
+		b |= bit
+	} else {
+		b &^= bit
+	}
+	if b != m.buffer[i] {
+		m.buffer[i] = b
+		m.dirty |= 1 << row
+	}
+}
+
+// GetPixel returns whether a LED is on.
+func (m *Matrix) GetPixel(x, y int16) bool {
+	i, _, bit, ok := m.locate(x, y)
+	return ok && m.buffer[i]&bit != 0
+}
+
+// ClearDisplay turns all the LEDs off, at the next call to Display.
+func (m *Matrix) ClearDisplay() {
+	for i, b := range m.buffer {
+		if b != 0 {
+			m.buffer[i] = 0
+			m.dirty |= 1 << (i % 8)
+		}
+	}
+}
+
+// Display sends the rows which changed since the previous call.
+func (m *Matrix) Display() error {
+	for r := 0; r < 8; r++ {
+		if m.dirty&(1<<r) == 0 {
+			continue
+		}
+		for i := range m.rows {
+			m.rows[i] = m.buffer[i*8+r]
+		}
+		if err := m.dev.WriteCommands(REG_DIGIT0+byte(r), m.rows); err != nil {
+			return err
+		}
+		m.dirty &^= 1 << r
+	}
+	return nil
+}
@@ -1,31 +1,45 @@
 // Driver works for max7219 and 7221
 // Datasheet: https://datasheets.maximintegrated.com/en/ds/MAX7219-MAX7221.pdf
+//
+// Several chips can be daisy-chained, the DOUT pin of each one connected to
+// the DIN pin of the next one, such as on 4-in-1 8x8 LED matrix modules. The
+// chip connected to the microcontroller is module 0. Commands are sent to all
+// the chips at once, and Matrix and Digits show pixels or digits on all of
+// them.
 package max72xx
 
 import (
-	"machine"
+	"tinygo.org/x/drivers"
 )
 
+// pin is the load pin, implemented by machine.Pin.
+type pin interface {
+	High()
+	Low()
+}
+
 type Device struct {
-	bus machine.SPI
-	cs  machine.Pin
+	bus   drivers.SPI
+	cs    pin
+	count int
+	frame []byte
 }
 
-// NewDriver creates a new max7219 connection. The SPI wire must already be configured
-// The SPI frequency must not be higher than 10MHz.
-// parameter cs: the datasheet also refers to this pin as "load" pin.
-func NewDevice(bus machine.SPI, cs machine.Pin) *Device {
+func newDevice(bus drivers.SPI, cs pin, count int) *Device {
+	if count < 1 {
+		count = 1
+	}
 	return &Device{
-		bus: bus,
-		cs:  cs,
+		bus:   bus,
+		cs:    cs,
+		count: count,
+		frame: make([]byte, 2*count),
 	}
 }
 
-// Configure setups the pins.
-func (driver *Device) Configure() {
-	outPutConfig := machine.PinConfig{Mode: machine.PinOutput}
-
-	driver.cs.Configure(outPutConfig)
+// Count returns the number of chips in the chain.
+func (driver *Device) Count() int {
+	return driver.count
 }
 
 // SetScanLimit sets the scan limit. Maximum is 8.
@@ -81,14 +95,36 @@ func (driver *Device) StopDisplayTest() {
 	driver.WriteCommand(REG_DISPLAY_TEST, 0x00)
 }
 
-func (driver *Device) writeByte(data byte) {
-	driver.bus.Transfer(data)
+// WriteCommand write data to a given register of all the chips.
+func (driver *Device) WriteCommand(register, data byte) {
+	for i := 0; i < driver.count; i++ {
+		driver.frame[2*i] = register
+		driver.frame[2*i+1] = data
+	}
+	driver.tx()
+}
+
+// WriteCommands writes data[i] to a given register of chip i. Chips beyond
+// the length of data are sent a no-op.
+func (driver *Device) WriteCommands(register byte, data []byte) error {
+	// The first bytes shifted end up in the last chip.
+	for i := 0; i < driver.count; i++ {
+		j := 2 * (driver.count - 1 - i)
+		if i < len(data) {
+			driver.frame[j] = register
+			driver.frame[j+1] = data[i]
+		} else {
+			driver.frame[j] = REG_NOOP
+			driver.frame[j+1] = 0
+		}
+	}
+	return driver.tx()
 }
 
-// WriteCommand write data to a given register.
-func (driver *Device) WriteCommand(register, data byte) {
+// tx sends the frame, which the chips load when cs goes high.
+func (driver *Device) tx() error {
 	driver.cs.Low()
-	driver.writeByte(register)
-	driver.writeByte(data)
+	err := driver.bus.Tx(driver.frame, nil)
 	driver.cs.High()
+	return err
 }
@@ -0,0 +1,166 @@
+package max72xx
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/font"
+	"tinygo.org/x/drivers/font/fonts"
+)
+
+// bus records the frames loaded by the chips.
+type bus struct {
+	c      *qt.C
+	low    bool
+	frame  []byte
+	Frames [][]byte
+}
+
+func (b *bus) Tx(w, r []byte) error {
+	b.c.Assert(b.low, qt.IsTrue, qt.Commentf("cs is high"))
+	b.frame = append(b.frame, w...)
+	return nil
+}
+
+func (b *bus) Transfer(w byte) (byte, error) {
+	b.Tx([]byte{w}, nil)
+	return 0, nil
+}
+
+func (b *bus) Low() { b.low = true }
+
+func (b *bus) High() {
+	if b.low && len(b.frame) > 0 {
+		b.Frames = append(b.Frames, b.frame)
+	}
+	b.low, b.frame = false, nil
+}
+
+func newChain(c *qt.C, count int) (*Device, *bus) {
+	b := &bus{c: c}
+	return newDevice(b, b, count), b
+}
+
+func TestWriteCommand(t *testing.T) {
+	c := qt.New(t)
+	d, b := newChain(c, 3)
+	d.SetIntensity(20)
+	c.Assert(b.Frames, qt.DeepEquals, [][]byte{{REG_INTENSITY, 15, REG_INTENSITY, 15, REG_INTENSITY, 15}})
+
+	// The last chip comes first.
+	b.Frames = nil
+	c.Assert(d.WriteCommands(REG_DIGIT2, []byte{1, 2}), qt.IsNil)
+	c.Assert(b.Frames, qt.DeepEquals, [][]byte{{REG_NOOP, 0, REG_DIGIT2, 2, REG_DIGIT2, 1}})
+}
+
+func TestMatrix(t *testing.T) {
+	c := qt.New(t)
+	d, b := newChain(c, 4)
+	m := NewMatrix(d, MatrixConfig{})
+	m.Configure()
+	w, h := m.Size()
+	c.Assert([]int16{w, h}, qt.DeepEquals, []int16{32, 8})
+	c.Assert(b.Frames, qt.HasLen, 4+8)
+
+	// Module 0 is on the right, only the changed rows are sent.
+	b.Frames = nil
+	on := color.RGBA{255, 255, 255, 255}
+	m.SetPixel(0, 0, on)
+	m.SetPixel(31, 5, on)
+	c.Assert(m.Display(), qt.IsNil)
+	c.Assert(b.Frames, qt.DeepEquals, [][]byte{
+		{REG_DIGIT0, 0x80, REG_DIGIT0, 0, REG_DIGIT0, 0, REG_DIGIT0, 0},
+		{REG_DIGIT5, 0, REG_DIGIT5, 0, REG_DIGIT5, 0, REG_DIGIT5, 1},
+	})
+	c.Assert(m.GetPixel(31, 5), qt.IsTrue)
+	c.Assert(m.GetPixel(30, 5), qt.IsFalse)
+
+	// Any color but black turns a LED on.
+	m.SetPixel(1, 0, color.RGBA{255, 0, 0, 255})
+	m.SetPixel(2, 0, color.RGBA{0, 0, 1, 255})
+	c.Assert(m.GetPixel(1, 0), qt.IsTrue)
+	c.Assert(m.GetPixel(2, 0), qt.IsTrue)
+	m.SetPixel(1, 0, color.RGBA{0, 0, 0, 255})
+	c.Assert(m.GetPixel(1, 0), qt.IsFalse)
+	m.SetPixel(2, 0, color.RGBA{})
+	c.Assert(m.Display(), qt.IsNil)
+
+	b.Frames = nil
+	m.SetPixel(0, 0, on)
+	c.Assert(m.Display(), qt.IsNil)
+	c.Assert(b.Frames, qt.HasLen, 0)
+
+	m.ClearDisplay()
+	c.Assert(m.Display(), qt.IsNil)
+	c.Assert(b.Frames, qt.HasLen, 2)
+}
+
+func TestMatrixLayout(t *testing.T) {
+	c := qt.New(t)
+	d, _ := newChain(c, 4)
+	rotations := []drivers.Rotation{drivers.Rotation0, drivers.Rotation90, drivers.Rotation180, drivers.Rotation270}
+	m := NewMatrix(d, MatrixConfig{Columns: 2, Rotation: rotations})
+	w, h := m.Size()
+	c.Assert([]int16{w, h}, qt.DeepEquals, []int16{16, 16})
+
+	// The top left pixel of each module.
+	for _, p := range [][2]int16{{8, 0}, {0, 0}, {8, 8}, {0, 8}} {
+		m.SetPixel(p[0], p[1], color.RGBA{255, 255, 255, 255})
+	}
+	c.Assert(m.buffer[0], qt.Equals, byte(0x80))     // module 0, row 0
+	c.Assert(m.buffer[8+7], qt.Equals, byte(0x80))   // module 1, row 7
+	c.Assert(m.buffer[2*8+7], qt.Equals, byte(0x01)) // module 2, row 7
+	c.Assert(m.buffer[3*8+0], qt.Equals, byte(0x01)) // module 3, row 0
+}
+
+func TestDigits(t *testing.T) {
+	c := qt.New(t)
+	d, b := newChain(c, 2)
+	digits := NewDigits(d, 4)
+	digits.Configure()
+	c.Assert(b.Frames[2], qt.DeepEquals, []byte{REG_SCANLIMIT, 3, REG_SCANLIMIT, 3})
+	c.Assert(digits.Len(), qt.Equals, 8)
+
+	b.Frames = nil
+	digits.WriteString("1.5-Ho")
+	c.Assert(digits.Display(), qt.IsNil)
+	// Digits 0-3 are on chip 1, from its register 3.
+	c.Assert(b.Frames, qt.DeepEquals, [][]byte{
+		{REG_DIGIT0, 0x37, REG_DIGIT0, 0},
+		{REG_DIGIT1, 0x01, REG_DIGIT1, 0},
+		{REG_DIGIT2, 0x5B, REG_DIGIT2, 0},
+		{REG_DIGIT3, 0x30 | SegmentDP, REG_DIGIT3, 0x1D},
+	})
+}
+
+func TestSegments(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(Segments('8'), qt.Equals, byte(0x7F))
+	c.Assert(Segments('a'), qt.Equals, Segments('A'))
+	c.Assert(Segments('o'), qt.Not(qt.Equals), Segments('O'))
+	c.Assert(Segments('~'), qt.Equals, byte(0))
+	c.Assert(Segments('é'), qt.Equals, byte(0))
+}
+
+func TestMarquee(t *testing.T) {
+	c := qt.New(t)
+	d, _ := newChain(c, 2)
+	m := NewMatrix(d, MatrixConfig{})
+	s := NewMarquee(m, &fonts.GoRegular12, "Hi")
+	steps := 0
+	for {
+		done, err := s.Step()
+		c.Assert(err, qt.IsNil)
+		steps++
+		if done {
+			break
+		}
+		c.Assert(steps < 100, qt.IsTrue)
+	}
+	w, _ := m.Size()
+	width, _ := font.Measure(&fonts.GoRegular12, "Hi")
+	c.Assert(steps, qt.Equals, int(w+width+1))
+}
@@ -0,0 +1,31 @@
+//go:build tinygo
+// +build tinygo
+
+package max72xx
+
+import (
+	"machine"
+
+	"tinygo.org/x/drivers"
+)
+
+// NewDriver creates a new max7219 connection. The SPI wire must already be configured
+// The SPI frequency must not be higher than 10MHz.
+// parameter cs: the datasheet also refers to this pin as "load" pin.
+func NewDevice(bus drivers.SPI, cs machine.Pin) *Device {
+	return newDevice(bus, cs, 1)
+}
+
+// NewChain creates a connection to count daisy-chained chips, sharing the
+// cs pin.
+func NewChain(bus drivers.SPI, cs machine.Pin, count int) *Device {
+	return newDevice(bus, cs, count)
+}
+
+// Configure setups the pins.
+func (driver *Device) Configure() {
+	outPutConfig := machine.PinConfig{Mode: machine.PinOutput}
+
+	driver.cs.(machine.Pin).Configure(outPutConfig)
+	driver.cs.High()
+}
@@ -0,0 +1,94 @@
+package mcp2515
+
+import "tinygo.org/x/drivers"
+
+// Error flag register bits.
+const (
+	eflgTXBO = 0x20
+	eflgTXEP = 0x10
+	eflgRXEP = 0x08
+)
+
+// Send implements drivers.CANBus.
+func (d *Device) Send(frame *drivers.CANFrame) error {
+	var ext, rtr uint8
+	data := frame.Payload()
+	dlc := uint8(len(data))
+	if frame.Extended {
+		ext = 1
+	}
+	if frame.Remote {
+		// Remote frames request DLC bytes but carry no data.
+		rtr = 1
+		data = nil
+		dlc = frame.DLC
+	}
+	return d.transmit(frame.ID, ext, rtr, dlc, data)
+}
+
+// Receive implements drivers.CANBus. Frames that do not pass the filters set
+// with SetFilters are dropped.
+func (d *Device) Receive(frame *drivers.CANFrame) (bool, error) {
+	for {
+		status, err := d.readStatus()
+		if err != nil || status&mcpStatRxifMask == 0 {
+			return false, err
+		}
+		msg, err := d.Rx()
+		if err != nil {
+			return false, err
+		}
+		frame.ID = msg.ID
+		frame.Extended = msg.Ext
+		frame.Remote = msg.Rtr
+		frame.DLC = msg.Dlc
+		copy(frame.Data[:], msg.Data)
+		if d.accepts(frame) {
+			return true, nil
+		}
+	}
+}
+
+// SetFilters implements drivers.CANBus. The filters are applied in software,
+// the acceptance filters of the MCP2515 are left open.
+func (d *Device) SetFilters(filters ...drivers.CANFilter) error {
+	d.filters = append(d.filters[:0], filters...)
+	return nil
+}
+
+func (d *Device) accepts(frame *drivers.CANFrame) bool {
+	if len(d.filters) == 0 {
+		return true
+	}
+	for _, f := range d.filters {
+		if f.Match(frame) {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrorState implements drivers.CANBus.
+func (d *Device) ErrorState() (state drivers.CANErrorState, txErrors, rxErrors uint8, err error) {
+	eflg, err := d.readRegister(mcpEFLG)
+	if err != nil {
+		return
+	}
+	txErrors, err = d.readRegister(mcpTEC)
+	if err != nil {
+		return
+	}
+	rxErrors, err = d.readRegister(mcpREC)
+	if err != nil {
+		return
+	}
+	switch {
+	case eflg&eflgTXBO != 0:
+		state = drivers.CANBusOff
+	case eflg&(eflgTXEP|eflgRXEP) != 0:
+		state = drivers.CANErrorPassive
+	default:
+		state = drivers.CANErrorActive
+	}
+	return
+}
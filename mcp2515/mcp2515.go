@@ -20,6 +20,7 @@ type Device struct {
 	cs      machine.Pin
 	msg     *CANMsg
 	mcpMode byte
+	filters []drivers.CANFilter
 }
 
 // CANMsg stores CAN message fields.
@@ -92,7 +93,11 @@ func (d *Device) Rx() (*CANMsg, error) {
 
 // Tx transmits CAN Message.
 func (d *Device) Tx(canid uint32, dlc uint8, data []byte) error {
-	// TODO: add ext, rtrBit, waitSent
+	return d.transmit(canid, 0, 0, dlc, data)
+}
+
+func (d *Device) transmit(canid uint32, ext, rtrBit, dlc uint8, data []byte) error {
+	// TODO: add waitSent
 	timeoutCount := 0
 
 	var bufNum, res uint8
@@ -111,7 +116,7 @@ func (d *Device) Tx(canid uint32, dlc uint8, data []byte) error {
 	if timeoutCount == timeoutvalue {
 		return fmt.Errorf("Tx: Tx timeout")
 	}
-	err = d.writeCANMsg(bufNum, canid, 0, 0, dlc, data)
+	err = d.writeCANMsg(bufNum, canid, ext, rtrBit, dlc, data)
 	if err != nil {
 		return err
 	}
@@ -437,26 +442,26 @@ func (d *Device) writeCANMsg(bufNum uint8, canid uint32, ext, rtrBit, dlc uint8,
 }
 
 func (s *SPI) setTxBufData(canid uint32, ext, rtrBit, dlc uint8, data []byte) error {
-	canid = canid & 0x0FFFF
 	if ext == 1 {
-		// TODO: add Extended ID
-		err := s.setTxData(0)
+		canid = canid & 0x1FFFFFFF
+		err := s.setTxData(byte(canid >> 21))
 		if err != nil {
 			return err
 		}
-		err = s.setTxData(0)
+		err = s.setTxData(byte((canid>>13)&0xE0) | mcpTxbExideM | byte((canid>>16)&0x03))
 		if err != nil {
 			return err
 		}
-		err = s.setTxData(0)
+		err = s.setTxData(byte(canid >> 8))
 		if err != nil {
 			return err
 		}
-		err = s.setTxData(0)
+		err = s.setTxData(byte(canid))
 		if err != nil {
 			return err
 		}
 	} else {
+		canid = canid & 0x7FF
 		err := s.setTxData(byte(canid >> 3))
 		if err != nil {
 			return err
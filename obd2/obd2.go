@@ -0,0 +1,316 @@
+// Package obd2 implements OBD-II (SAE J1979) vehicle diagnostics over
+// ISO-TP, for reading live data, vehicle information and trouble codes.
+//
+// Requests are sent to the functional (broadcast) address 0x7DF and the
+// answer of the first ECU, usually the engine control module at 0x7E8, is
+// returned.
+package obd2 // import "tinygo.org/x/drivers/obd2"
+
+import (
+	"errors"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/isotp"
+)
+
+var (
+	ErrNegativeResponse   = errors.New("obd2: negative response")
+	ErrUnexpectedResponse = errors.New("obd2: unexpected response")
+	ErrUnsupportedPID     = errors.New("obd2: PID cannot be decoded")
+)
+
+// CAN IDs used by OBD-II with 11-bit identifiers.
+const (
+	FunctionalID = 0x7DF
+	ECUResponse  = 0x7E8
+	ECURequest   = 0x7E0
+)
+
+// Service (mode) numbers.
+const (
+	ServiceCurrentData   = 0x01
+	ServiceFreezeFrame   = 0x02
+	ServiceStoredDTCs    = 0x03
+	ServiceClearDTCs     = 0x04
+	ServicePendingDTCs   = 0x07
+	ServiceVehicleInfo   = 0x09
+	ServicePermanentDTCs = 0x0A
+)
+
+const (
+	negativeResponse       = 0x7F
+	positiveResponseOffset = 0x40
+)
+
+// Service 01 PIDs.
+const (
+	PIDSupported01_20     = 0x00
+	PIDMonitorStatus      = 0x01
+	PIDEngineLoad         = 0x04
+	PIDCoolantTemperature = 0x05
+	PIDFuelPressure       = 0x0A
+	PIDIntakePressure     = 0x0B
+	PIDEngineRPM          = 0x0C
+	PIDVehicleSpeed       = 0x0D
+	PIDTimingAdvance      = 0x0E
+	PIDIntakeTemperature  = 0x0F
+	PIDMAFAirFlow         = 0x10
+	PIDThrottlePosition   = 0x11
+	PIDRunTime            = 0x1F
+	PIDSupported21_40     = 0x20
+	PIDFuelLevel          = 0x2F
+	PIDBarometricPressure = 0x33
+	PIDSupported41_60     = 0x40
+	PIDModuleVoltage      = 0x42
+	PIDAmbientTemperature = 0x46
+	PIDOilTemperature     = 0x5C
+	PIDEngineFuelRate     = 0x5E
+	PIDSupported61_80     = 0x60
+)
+
+// Service 09 PIDs.
+const (
+	InfoSupported = 0x00
+	InfoVIN       = 0x02
+	InfoCalID     = 0x04
+	InfoECUName   = 0x0A
+)
+
+// Client sends OBD-II requests over an ISO-TP connection.
+type Client struct {
+	conn *isotp.Conn
+	req  [8]byte
+	buf  []byte
+}
+
+// New returns a client using the standard OBD-II CAN IDs on the bus. Frames
+// are padded to 8 bytes, as most vehicles require.
+func New(bus drivers.CANBus) *Client {
+	return NewConn(isotp.New(bus, isotp.Config{
+		TxID:          FunctionalID,
+		RxID:          ECUResponse,
+		FlowControlID: ECURequest,
+		Padding:       true,
+		PadByte:       0x55,
+	}))
+}
+
+// NewConn returns a client using an existing ISO-TP connection, for example
+// to talk to a specific ECU.
+func NewConn(conn *isotp.Conn) *Client {
+	return &Client{
+		conn: conn,
+		buf:  make([]byte, 256),
+	}
+}
+
+// Request sends a request for the given service and returns the response
+// data following the service byte and the echoed parameters. The returned
+// slice is only valid until the next request.
+func (c *Client) Request(service byte, params ...byte) ([]byte, error) {
+	c.req[0] = service
+	n := 1 + copy(c.req[1:], params)
+	if err := c.conn.Send(c.req[:n]); err != nil {
+		return nil, err
+	}
+	m, err := c.conn.Receive(c.buf)
+	if err != nil {
+		return nil, err
+	}
+	resp := c.buf[:m]
+	if len(resp) >= 3 && resp[0] == negativeResponse && resp[1] == service {
+		return nil, ErrNegativeResponse
+	}
+	if len(resp) < n || resp[0] != service+positiveResponseOffset {
+		return nil, ErrUnexpectedResponse
+	}
+	for i, p := range c.req[1:n] {
+		if resp[1+i] != p {
+			return nil, ErrUnexpectedResponse
+		}
+	}
+	return resp[n:], nil
+}
+
+// ReadPID returns the raw data of a service 01 PID.
+func (c *Client) ReadPID(pid byte) ([]byte, error) {
+	return c.Request(ServiceCurrentData, pid)
+}
+
+// Value reads a service 01 PID and decodes it, see Decode.
+func (c *Client) Value(pid byte) (float32, error) {
+	data, err := c.ReadPID(pid)
+	if err != nil {
+		return 0, err
+	}
+	return Decode(pid, data)
+}
+
+// SupportedPIDs returns the bitmap of supported service 01 PIDs following
+// base, which must be 0x00, 0x20, 0x40 and so on. Bit 31 is PID base+1.
+func (c *Client) SupportedPIDs(base byte) (uint32, error) {
+	data, err := c.ReadPID(base)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) < 4 {
+		return 0, ErrUnexpectedResponse
+	}
+	return uint32(data[0])<<24 | uint32(data[1])<<16 | uint32(data[2])<<8 | uint32(data[3]), nil
+}
+
+// Supported reports whether the vehicle supports the service 01 PID.
+func (c *Client) Supported(pid byte) (bool, error) {
+	if pid == 0 {
+		return true, nil
+	}
+	base := (pid - 1) &^ 0x1f
+	bits, err := c.SupportedPIDs(base)
+	if err != nil {
+		return false, err
+	}
+	return bits&(1<<(31-(pid-1-base))) != 0, nil
+}
+
+// MonitorStatus returns whether the malfunction indicator lamp (check engine
+// light) is on and the number of stored trouble codes.
+func (c *Client) MonitorStatus() (mil bool, dtcs int, err error) {
+	data, err := c.ReadPID(PIDMonitorStatus)
+	if err != nil {
+		return false, 0, err
+	}
+	if len(data) < 1 {
+		return false, 0, ErrUnexpectedResponse
+	}
+	return data[0]&0x80 != 0, int(data[0] & 0x7f), nil
+}
+
+// EngineRPM returns the engine speed in revolutions per minute.
+func (c *Client) EngineRPM() (float32, error) {
+	return c.Value(PIDEngineRPM)
+}
+
+// VehicleSpeed returns the vehicle speed in km/h.
+func (c *Client) VehicleSpeed() (float32, error) {
+	return c.Value(PIDVehicleSpeed)
+}
+
+// CoolantTemperature returns the engine coolant temperature in °C.
+func (c *Client) CoolantTemperature() (float32, error) {
+	return c.Value(PIDCoolantTemperature)
+}
+
+// VIN returns the vehicle identification number.
+func (c *Client) VIN() (string, error) {
+	data, err := c.Request(ServiceVehicleInfo, InfoVIN)
+	if err != nil {
+		return "", err
+	}
+	// The first byte is the number of data items.
+	if len(data) < 18 {
+		return "", ErrUnexpectedResponse
+	}
+	return string(data[1:18]), nil
+}
+
+// DTCs returns the stored diagnostic trouble codes.
+func (c *Client) DTCs() ([]DTC, error) {
+	return c.readDTCs(ServiceStoredDTCs)
+}
+
+// PendingDTCs returns the trouble codes detected during the current or last
+// driving cycle, which are not yet confirmed.
+func (c *Client) PendingDTCs() ([]DTC, error) {
+	return c.readDTCs(ServicePendingDTCs)
+}
+
+// PermanentDTCs returns the trouble codes that cannot be cleared with
+// ClearDTCs.
+func (c *Client) PermanentDTCs() ([]DTC, error) {
+	return c.readDTCs(ServicePermanentDTCs)
+}
+
+// ClearDTCs clears the stored trouble codes and turns off the malfunction
+// indicator lamp.
+func (c *Client) ClearDTCs() error {
+	_, err := c.Request(ServiceClearDTCs)
+	return err
+}
+
+func (c *Client) readDTCs(service byte) ([]DTC, error) {
+	data, err := c.Request(service)
+	if err != nil {
+		return nil, err
+	}
+	// On CAN, the first byte is the number of codes.
+	if len(data) < 1 || len(data) < 1+2*int(data[0]) {
+		return nil, ErrUnexpectedResponse
+	}
+	dtcs := make([]DTC, data[0])
+	for i := range dtcs {
+		dtcs[i] = DTC(data[1+2*i])<<8 | DTC(data[2+2*i])
+	}
+	return dtcs, nil
+}
+
+// DTC is a diagnostic trouble code, such as P0301.
+type DTC uint16
+
+// String returns the code in the usual notation, such as P0301.
+func (d DTC) String() string {
+	const hex = "0123456789ABCDEF"
+	return string([]byte{
+		"PCBU"[d>>14],
+		hex[d>>12&0x3],
+		hex[d>>8&0xf],
+		hex[d>>4&0xf],
+		hex[d&0xf],
+	})
+}
+
+// Decode converts the data of a service 01 PID to its value, in the units
+// used by SAE J1979: percent, °C, kPa, rpm, km/h, g/s, s, V or L/h.
+func Decode(pid byte, data []byte) (float32, error) {
+	var a, b float32
+	if len(data) > 0 {
+		a = float32(data[0])
+	}
+	if len(data) > 1 {
+		b = float32(data[1])
+	}
+	size := 1
+	var v float32
+	switch pid {
+	case PIDEngineLoad, PIDThrottlePosition, PIDFuelLevel:
+		v = a * 100 / 255
+	case PIDCoolantTemperature, PIDIntakeTemperature, PIDAmbientTemperature, PIDOilTemperature:
+		v = a - 40
+	case PIDFuelPressure:
+		v = a * 3
+	case PIDIntakePressure, PIDBarometricPressure, PIDVehicleSpeed:
+		v = a
+	case PIDTimingAdvance:
+		v = a/2 - 64
+	case PIDEngineRPM:
+		size = 2
+		v = (a*256 + b) / 4
+	case PIDMAFAirFlow:
+		size = 2
+		v = (a*256 + b) / 100
+	case PIDRunTime:
+		size = 2
+		v = a*256 + b
+	case PIDModuleVoltage:
+		size = 2
+		v = (a*256 + b) / 1000
+	case PIDEngineFuelRate:
+		size = 2
+		v = (a*256 + b) / 20
+	default:
+		return 0, ErrUnsupportedPID
+	}
+	if len(data) < size {
+		return 0, ErrUnexpectedResponse
+	}
+	return v, nil
+}
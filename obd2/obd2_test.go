@@ -0,0 +1,139 @@
+package obd2
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/isotp"
+	"tinygo.org/x/drivers/tester"
+)
+
+// responses maps requests to the answers of the simulated ECU.
+var responses = map[string][]byte{
+	"\x01\x00": {0x41, 0x00, 0xBE, 0x1F, 0xA8, 0x13},
+	"\x01\x01": {0x41, 0x01, 0x83, 0x07, 0x65, 0x04},
+	"\x01\x05": {0x41, 0x05, 0x7B},
+	"\x01\x0C": {0x41, 0x0C, 0x1A, 0xF8},
+	"\x01\x0D": {0x41, 0x0D, 0x32},
+	"\x03":     {0x43, 0x03, 0x01, 0x43, 0x41, 0x96, 0xC1, 0x23},
+	"\x04":     {0x44},
+	"\x09\x02": append([]byte{0x49, 0x02, 0x01}, "1G1JC5444R7252367"...),
+}
+
+func TestClient(t *testing.T) {
+	c := qt.New(t)
+	client := newTestClient(c)
+
+	rpm, err := client.EngineRPM()
+	c.Assert(err, qt.IsNil)
+	c.Assert(rpm, qt.Equals, float32(1726))
+
+	speed, err := client.VehicleSpeed()
+	c.Assert(err, qt.IsNil)
+	c.Assert(speed, qt.Equals, float32(50))
+
+	temp, err := client.CoolantTemperature()
+	c.Assert(err, qt.IsNil)
+	c.Assert(temp, qt.Equals, float32(83))
+
+	mil, n, err := client.MonitorStatus()
+	c.Assert(err, qt.IsNil)
+	c.Assert(mil, qt.IsTrue)
+	c.Assert(n, qt.Equals, 3)
+
+	ok, err := client.Supported(PIDEngineRPM)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ok, qt.IsTrue)
+	ok, err = client.Supported(PIDFuelPressure)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ok, qt.IsFalse)
+
+	vin, err := client.VIN()
+	c.Assert(err, qt.IsNil)
+	c.Assert(vin, qt.Equals, "1G1JC5444R7252367")
+
+	dtcs, err := client.DTCs()
+	c.Assert(err, qt.IsNil)
+	c.Assert(dtcs, qt.DeepEquals, []DTC{0x0143, 0x4196, 0xC123})
+	c.Assert(dtcs[0].String(), qt.Equals, "P0143")
+	c.Assert(dtcs[1].String(), qt.Equals, "C0196")
+	c.Assert(dtcs[2].String(), qt.Equals, "U0123")
+
+	c.Assert(client.ClearDTCs(), qt.IsNil)
+
+	_, err = client.ReadPID(PIDFuelLevel)
+	c.Assert(err, qt.Equals, ErrNegativeResponse)
+}
+
+func TestDecode(t *testing.T) {
+	c := qt.New(t)
+	for _, tc := range []struct {
+		pid  byte
+		data []byte
+		want float32
+	}{
+		{PIDEngineLoad, []byte{0xFF}, 100},
+		{PIDIntakeTemperature, []byte{0x00}, -40},
+		{PIDTimingAdvance, []byte{0x80}, 0},
+		{PIDMAFAirFlow, []byte{0x01, 0x2C}, 3},
+		{PIDRunTime, []byte{0x01, 0x00}, 256},
+		{PIDModuleVoltage, []byte{0x30, 0xD4}, 12.5},
+	} {
+		v, err := Decode(tc.pid, tc.data)
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.Equals, tc.want, qt.Commentf("PID %#x", tc.pid))
+	}
+	_, err := Decode(PIDEngineRPM, []byte{0x1A})
+	c.Assert(err, qt.Equals, ErrUnexpectedResponse)
+	_, err = Decode(0xFF, []byte{0x00})
+	c.Assert(err, qt.Equals, ErrUnsupportedPID)
+}
+
+// newTestClient returns a client connected to a simulated ECU, which answers
+// from responses and rejects other requests.
+func newTestClient(c *qt.C) *Client {
+	bus := tester.NewCANBus()
+	client := bus.NewNode()
+	// The ECU accepts requests on the functional and the physical address.
+	ecu := aliasNode{bus.NewNode(), map[uint32]uint32{ECURequest: FunctionalID}}
+	conn := isotp.New(ecu, isotp.Config{TxID: ECUResponse, RxID: FunctionalID, Padding: true})
+	done := make(chan struct{})
+	c.Cleanup(func() { close(done) })
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			n, err := conn.Receive(buf)
+			if err != nil {
+				continue
+			}
+			resp, ok := responses[string(buf[:n])]
+			if !ok {
+				resp = []byte{0x7F, buf[0], 0x12}
+			}
+			conn.Send(resp)
+		}
+	}()
+	return New(client)
+}
+
+// aliasNode is a CAN node which receives frames sent to one ID as if they
+// were sent to another.
+type aliasNode struct {
+	*tester.CANNode
+	alias map[uint32]uint32
+}
+
+func (n aliasNode) Receive(frame *drivers.CANFrame) (bool, error) {
+	ok, err := n.CANNode.Receive(frame)
+	if id, found := n.alias[frame.ID]; ok && found {
+		frame.ID = id
+	}
+	return ok, err
+}
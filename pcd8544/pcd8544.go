@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
 )
 
 // Device wraps an SPI connection.
@@ -23,11 +24,18 @@ type Device struct {
 	width      int16
 	height     int16
 	bufferSize int16
+	tracking   bool
+	dirty      display.DirtyRows
+	stats      display.Stats
 }
 
 type Config struct {
 	Width  int16
 	Height int16
+
+	// DirtyTracking makes Display only send the parts of the buffer that
+	// changed since the last call.
+	DirtyTracking bool
 }
 
 // New creates a new PCD8544 connection. The SPI bus must already be configured.
@@ -54,6 +62,8 @@ func (d *Device) Configure(cfg Config) {
 	}
 	d.bufferSize = d.width * d.height / 8
 	d.buffer = make([]byte, d.bufferSize)
+	d.tracking = cfg.DirtyTracking
+	d.dirty.Init(int(d.height/8), d.width)
 
 	d.rstPin.Low()
 	time.Sleep(100 * time.Nanosecond)
@@ -69,6 +79,7 @@ func (d *Device) Configure(cfg Config) {
 // ClearBuffer clears the image buffer
 func (d *Device) ClearBuffer() {
 	d.buffer = make([]byte, d.bufferSize)
+	d.dirty.MarkAll()
 }
 
 // ClearDisplay clears the image buffer and clear the display
@@ -77,8 +88,14 @@ func (d *Device) ClearDisplay() {
 	d.Display()
 }
 
-// Display sends the whole buffer to the screen
+// Display sends the whole buffer to the screen, or only the changed parts
+// if dirty tracking is enabled
 func (d *Device) Display() error {
+	if d.tracking && !d.dirty.Full() {
+		return d.displayDirty()
+	}
+	d.stats.Updates++
+
 	d.SendCommand(FUNCTIONSET) // H = 0
 	d.SendCommand(SETXADDR)
 	d.SendCommand(SETYADDR)
@@ -86,9 +103,41 @@ func (d *Device) Display() error {
 	for i := int16(0); i < d.bufferSize; i++ {
 		d.SendData(d.buffer[i])
 	}
+	d.dirty.Clear()
 	return nil
 }
 
+// displayDirty sends the changed columns of every bank
+func (d *Device) displayDirty() error {
+	sent := false
+	for bank := 0; bank < int(d.height/8); bank++ {
+		start, end := d.dirty.Span(bank)
+		if start >= end {
+			continue
+		}
+		if !sent {
+			d.SendCommand(FUNCTIONSET) // H = 0
+		}
+		d.SendCommand(SETYADDR | uint8(bank))
+		d.SendCommand(SETXADDR | uint8(start))
+		offset := int16(bank) * d.width
+		for i := offset + start; i < offset+end; i++ {
+			d.SendData(d.buffer[i])
+		}
+		sent = true
+	}
+	if sent {
+		d.stats.Updates++
+	}
+	d.dirty.Clear()
+	return nil
+}
+
+// Stats returns the number of updates and bytes sent to the display
+func (d *Device) Stats() display.Stats {
+	return d.stats
+}
+
 // sendDataCommand sends image data or a command to the screen
 func (d *Device) sendDataCommand(isCommand bool, data uint8) {
 	if isCommand {
@@ -96,6 +145,7 @@ func (d *Device) sendDataCommand(isCommand bool, data uint8) {
 	} else {
 		d.dcPin.High()
 	}
+	d.stats.Bytes++
 	d.scePin.Low()
 	d.bus.Transfer(data)
 	d.scePin.High()
@@ -109,10 +159,15 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 		return
 	}
 	byteIndex := x + (y/8)*d.width
+	b := d.buffer[byteIndex]
 	if c.R != 0 || c.G != 0 || c.B != 0 {
-		d.buffer[byteIndex] |= 1 << uint8(y%8)
+		b |= 1 << uint8(y%8)
 	} else {
-		d.buffer[byteIndex] &^= 1 << uint8(y%8)
+		b &^= 1 << uint8(y%8)
+	}
+	if b != d.buffer[byteIndex] {
+		d.buffer[byteIndex] = b
+		d.dirty.Mark(int(y/8), x)
 	}
 }
 
@@ -125,6 +180,12 @@ func (d *Device) GetPixel(x int16, y int16) bool {
 	return (d.buffer[byteIndex] >> uint8(y%8) & 0x1) == 1
 }
 
+// Ink returns true, as the pixels on (true) of GetPixel are black on this
+// LCD. It is used by the screenshot package.
+func (d *Device) Ink() bool {
+	return true
+}
+
 // SetBuffer changes the whole buffer at once
 func (d *Device) SetBuffer(buffer []byte) error {
 	if int16(len(buffer)) != d.bufferSize {
@@ -134,6 +195,7 @@ func (d *Device) SetBuffer(buffer []byte) error {
 	for i := int16(0); i < d.bufferSize; i++ {
 		d.buffer[i] = buffer[i]
 	}
+	d.dirty.MarkAll()
 	return nil
 }
 
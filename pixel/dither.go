@@ -0,0 +1,212 @@
+package pixel
+
+import (
+	"image/color"
+	"math"
+)
+
+// Gamma is a lookup table applying gamma correction to color channels.
+type Gamma [256]uint8
+
+// NewGamma returns a gamma correction table for the given exponent. An
+// exponent above 1 darkens the mid tones, which is usually needed for LEDs.
+func NewGamma(exponent float32) *Gamma {
+	g := &Gamma{}
+	for i := range g {
+		g[i] = uint8(math.Pow(float64(i)/255, float64(exponent))*255 + 0.5)
+	}
+	return g
+}
+
+// Apply returns the gamma corrected color. Alpha is left unchanged.
+func (g *Gamma) Apply(c color.RGBA) color.RGBA {
+	return color.RGBA{g[c.R], g[c.G], g[c.B], c.A}
+}
+
+// Dither is a dithering method, which simulates missing colors with patterns
+// of the colors a format has.
+type Dither uint8
+
+const (
+	// NoDither truncates each color to the format.
+	NoDither Dither = iota
+
+	// OrderedDither adds a 4x4 Bayer threshold pattern. It is fast, needs no
+	// memory and gives stable results for animations.
+	OrderedDither
+
+	// DiffusionDither spreads the quantization error over the neighbouring
+	// pixels (Floyd-Steinberg). It gives the best quality for photos.
+	DiffusionDither
+
+	// AtkinsonDither spreads 3/4 of the quantization error over six
+	// neighbouring pixels. It keeps more contrast than DiffusionDither,
+	// which suits monochrome displays.
+	AtkinsonDither
+)
+
+// Diffuses reports whether the dithering method carries the quantization
+// error to the next pixels, which needs the rows to be converted in order.
+func (d Dither) Diffuses() bool {
+	return d == DiffusionDither || d == AtkinsonDither
+}
+
+// bayer is the 4x4 ordered dithering matrix.
+var bayer = [16]int16{
+	0, 8, 2, 10,
+	12, 4, 14, 6,
+	3, 11, 1, 9,
+	15, 7, 13, 5,
+}
+
+// Converter converts rows of pixels to a format, with optional gamma
+// correction and dithering.
+//
+// Error diffusion carries state from one row to the next, so rows must be
+// converted from top to bottom, starting with y = 0.
+type Converter struct {
+	Format Format
+	Dither Dither
+
+	// Gamma, if set, is applied before dithering.
+	Gamma *Gamma
+
+	// errors of the current and the next two rows, 3 channels per pixel
+	// with two pixels of margin on both sides, 16 times their value.
+	errs [3][]int16
+}
+
+// ConvertRow converts a row of pixels starting at the given coordinates and
+// stores it in dst, as Format.Encode does. It returns the number of bytes
+// written.
+func (cv *Converter) ConvertRow(dst []byte, src []color.RGBA, x, y int) int {
+	n := cv.Format.BufferSize(len(src))
+	if cv.Dither == NoDither && cv.Gamma == nil {
+		return cv.Format.Encode(dst, src)
+	}
+	if cv.Dither.Diffuses() {
+		size := 3 * (len(src) + 4)
+		if y == 0 || len(cv.errs[0]) != size {
+			for i := range cv.errs {
+				cv.errs[i] = resize(cv.errs[i], size)
+			}
+		}
+	}
+	for i := range dst[:n] {
+		dst[i] = 0
+	}
+	for i, c := range src {
+		if cv.Gamma != nil {
+			c = cv.Gamma.Apply(c)
+		}
+		switch cv.Dither {
+		case OrderedDither:
+			c = cv.ordered(c, x+i, y)
+		case DiffusionDither, AtkinsonDither:
+			c = cv.diffuse(c, i)
+		}
+		cv.Format.Set(dst, i, cv.Format.Convert(c))
+	}
+	if cv.Dither.Diffuses() {
+		cv.errs[0], cv.errs[1], cv.errs[2] = cv.errs[1], cv.errs[2], cv.errs[0]
+		for i := range cv.errs[2] {
+			cv.errs[2][i] = 0
+		}
+	}
+	return n
+}
+
+// ConvertPixel returns the pixel value of a color at the given coordinates.
+// Error diffusion needs whole rows, so it is not applied: use ConvertRow
+// for it.
+func (cv *Converter) ConvertPixel(c color.RGBA, x, y int) uint32 {
+	if cv.Gamma != nil {
+		c = cv.Gamma.Apply(c)
+	}
+	if cv.Dither == OrderedDither {
+		c = cv.ordered(c, x, y)
+	}
+	return cv.Format.Convert(c)
+}
+
+// ordered applies the Bayer threshold at (x, y) and quantizes the color.
+func (cv *Converter) ordered(c color.RGBA, x, y int) color.RGBA {
+	t := 2*bayer[(y&3)*4+x&3] + 1 - 16
+	r, g, b := cv.steps()
+	c.R = clamp(int16(c.R) + t*r/32)
+	c.G = clamp(int16(c.G) + t*g/32)
+	c.B = clamp(int16(c.B) + t*b/32)
+	return cv.Format.Quantize(c)
+}
+
+// diffuse adds the error carried to pixel i, quantizes the color and spreads
+// the new error.
+func (cv *Converter) diffuse(c color.RGBA, i int) color.RGBA {
+	cur, next, after := cv.errs[0], cv.errs[1], cv.errs[2]
+	j := 3 * (i + 2)
+	want := [3]int16{
+		int16(c.R) + cur[j]/16,
+		int16(c.G) + cur[j+1]/16,
+		int16(c.B) + cur[j+2]/16,
+	}
+	q := cv.Format.Quantize(color.RGBA{clamp(want[0]), clamp(want[1]), clamp(want[2]), 255})
+	got := [3]int16{int16(q.R), int16(q.G), int16(q.B)}
+	for ch := 0; ch < 3; ch++ {
+		e := want[ch] - got[ch]
+		k := j + ch
+		if cv.Dither == AtkinsonDither {
+			// 1/8 of the error to each of six pixels.
+			e *= 2
+			cur[k+3] += e
+			cur[k+6] += e
+			next[k-3] += e
+			next[k] += e
+			next[k+3] += e
+			after[k] += e
+			continue
+		}
+		cur[k+3] += 7 * e
+		next[k-3] += 3 * e
+		next[k] += 5 * e
+		next[k+3] += e
+	}
+	return q
+}
+
+// steps returns the difference between two neighbouring levels of each
+// channel.
+func (cv *Converter) steps() (r, g, b int16) {
+	switch cv.Format {
+	case Mono, TriColor:
+		return 255, 255, 255
+	case Gray2:
+		return 85, 85, 85
+	case Gray4:
+		return 17, 17, 17
+	case Gray8:
+		return 1, 1, 1
+	}
+	rb, gb, bb := cv.Format.channelBits()
+	return 255 / (1<<rb - 1), 255 / (1<<gb - 1), 255 / (1<<bb - 1)
+}
+
+func resize(s []int16, n int) []int16 {
+	if cap(s) < n {
+		return make([]int16, n)
+	}
+	s = s[:n]
+	for i := range s {
+		s[i] = 0
+	}
+	return s
+}
+
+func clamp(v int16) uint8 {
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
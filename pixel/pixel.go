@@ -0,0 +1,302 @@
+// Package pixel converts colors to the pixel formats used by displays.
+//
+// Color display drivers use this package to convert a color.RGBA, so a
+// color looks the same on every panel. Formats with few colors pick the
+// closest one: in Mono, light colors are white and dark colors are black.
+//
+// Drivers of monochrome displays keep their own rule in SetPixel instead,
+// usually that any color but black turns a pixel on. display.Mono draws on
+// them by brightness, with ToMono and optional dithering.
+package pixel // import "tinygo.org/x/drivers/pixel"
+
+import "image/color"
+
+// Format is a pixel format as stored in display memory.
+type Format uint8
+
+const (
+	// RGB565 is 16-bit color with 5 bits red, 6 bits green and 5 bits blue.
+	RGB565 Format = iota
+
+	// BGR565 is RGB565 with red and blue swapped.
+	BGR565
+
+	// RGB444 is 12-bit color with 4 bits per channel. Two pixels are packed
+	// in three bytes.
+	RGB444
+
+	// BGR444 is RGB444 with red and blue swapped.
+	BGR444
+
+	// RGB888 is 24-bit color with 8 bits per channel.
+	RGB888
+
+	// BGR888 is RGB888 with red and blue swapped.
+	BGR888
+
+	// Mono is 1-bit black (0) and white (1). Eight pixels are packed in a
+	// byte, the first pixel in the most significant bit.
+	Mono
+
+	// Gray2 is 2-bit grayscale, from black (0) to white (3).
+	Gray2
+
+	// Gray4 is 4-bit grayscale, from black (0) to white (15).
+	Gray4
+
+	// TriColor is the black, white and red (or yellow) of 3-color e-paper
+	// displays, with the values TriWhite, TriBlack and TriRed. Four pixels
+	// are packed in a byte.
+	TriColor
+
+	// Gray8 is 8-bit grayscale, from black (0) to white (255).
+	Gray8
+)
+
+// Values of the TriColor format.
+const (
+	TriWhite = 0
+	TriBlack = 1
+	TriRed   = 2
+)
+
+// BitsPerPixel returns the number of bits used by a pixel.
+func (f Format) BitsPerPixel() int {
+	switch f {
+	case RGB565, BGR565:
+		return 16
+	case RGB444, BGR444:
+		return 12
+	case RGB888, BGR888:
+		return 24
+	case Mono:
+		return 1
+	case Gray2, TriColor:
+		return 2
+	case Gray4:
+		return 4
+	case Gray8:
+		return 8
+	}
+	return 0
+}
+
+// BufferSize returns the number of bytes needed to store the given number of
+// pixels.
+func (f Format) BufferSize(pixels int) int {
+	return (pixels*f.BitsPerPixel() + 7) / 8
+}
+
+// Convert returns the pixel value of the color. The color channels are
+// truncated to the number of bits of the format; see Quantize to round them
+// instead.
+func (f Format) Convert(c color.RGBA) uint32 {
+	switch f {
+	case RGB565:
+		return uint32(ToRGB565(c))
+	case BGR565:
+		return uint32(c.B&0xF8)<<8 | uint32(c.G&0xFC)<<3 | uint32(c.R>>3)
+	case RGB444:
+		return uint32(c.R>>4)<<8 | uint32(c.G>>4)<<4 | uint32(c.B>>4)
+	case BGR444:
+		return uint32(c.B>>4)<<8 | uint32(c.G>>4)<<4 | uint32(c.R>>4)
+	case RGB888:
+		return uint32(c.R)<<16 | uint32(c.G)<<8 | uint32(c.B)
+	case BGR888:
+		return uint32(c.B)<<16 | uint32(c.G)<<8 | uint32(c.R)
+	case Mono:
+		return uint32(Gray(c) >> 7)
+	case Gray2:
+		return uint32(Gray(c) >> 6)
+	case Gray4:
+		return uint32(Gray(c) >> 4)
+	case Gray8:
+		return uint32(Gray(c))
+	case TriColor:
+		return uint32(ToTriColor(c))
+	}
+	return 0
+}
+
+// Color returns the color of a pixel value.
+func (f Format) Color(v uint32) color.RGBA {
+	switch f {
+	case RGB565:
+		return color.RGBA{expand(v>>11, 5), expand(v>>5, 6), expand(v, 5), 255}
+	case BGR565:
+		return color.RGBA{expand(v, 5), expand(v>>5, 6), expand(v>>11, 5), 255}
+	case RGB444:
+		return color.RGBA{expand(v>>8, 4), expand(v>>4, 4), expand(v, 4), 255}
+	case BGR444:
+		return color.RGBA{expand(v, 4), expand(v>>4, 4), expand(v>>8, 4), 255}
+	case RGB888:
+		return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 255}
+	case BGR888:
+		return color.RGBA{uint8(v), uint8(v >> 8), uint8(v >> 16), 255}
+	case Mono, Gray2, Gray4, Gray8:
+		g := expand(v, uint(f.BitsPerPixel()))
+		return color.RGBA{g, g, g, 255}
+	case TriColor:
+		switch v {
+		case TriBlack:
+			return color.RGBA{0, 0, 0, 255}
+		case TriRed:
+			return color.RGBA{255, 0, 0, 255}
+		}
+		return color.RGBA{255, 255, 255, 255}
+	}
+	return color.RGBA{}
+}
+
+// Quantize returns the representable color of the format closest to c.
+func (f Format) Quantize(c color.RGBA) color.RGBA {
+	switch f {
+	case Mono, Gray2, Gray4, Gray8:
+		g := round(Gray(c), uint(f.BitsPerPixel()))
+		return color.RGBA{g, g, g, 255}
+	case TriColor:
+		return f.Color(f.Convert(c))
+	}
+	r, g, b := f.channelBits()
+	return color.RGBA{round(c.R, r), round(c.G, g), round(c.B, b), 255}
+}
+
+// channelBits returns the number of bits of each color channel.
+func (f Format) channelBits() (r, g, b uint) {
+	switch f {
+	case RGB565, BGR565:
+		return 5, 6, 5
+	case RGB444, BGR444:
+		return 4, 4, 4
+	}
+	return 8, 8, 8
+}
+
+// Encode converts the colors in src and stores them in dst as the display
+// expects them: multi-byte pixels in big endian order and sub-byte pixels
+// packed from the most significant bit. It returns the number of bytes
+// written, which is BufferSize(len(src)). It panics if dst is too short.
+func (f Format) Encode(dst []byte, src []color.RGBA) int {
+	if len(src) == 0 {
+		return 0
+	}
+	switch f.BitsPerPixel() {
+	case 16:
+		_ = dst[2*len(src)-1]
+		for i, c := range src {
+			v := f.Convert(c)
+			dst[2*i] = byte(v >> 8)
+			dst[2*i+1] = byte(v)
+		}
+	case 24:
+		_ = dst[3*len(src)-1]
+		for i, c := range src {
+			v := f.Convert(c)
+			dst[3*i] = byte(v >> 16)
+			dst[3*i+1] = byte(v >> 8)
+			dst[3*i+2] = byte(v)
+		}
+	default:
+		n := f.BufferSize(len(src))
+		for i := range dst[:n] {
+			dst[i] = 0
+		}
+		for i, c := range src {
+			f.Set(dst, i, f.Convert(c))
+		}
+	}
+	return f.BufferSize(len(src))
+}
+
+// Set stores the pixel value v at index i of an encoded buffer.
+func (f Format) Set(buf []byte, i int, v uint32) {
+	bits := f.BitsPerPixel()
+	switch bits {
+	case 16:
+		buf[2*i] = byte(v >> 8)
+		buf[2*i+1] = byte(v)
+	case 24:
+		buf[3*i] = byte(v >> 16)
+		buf[3*i+1] = byte(v >> 8)
+		buf[3*i+2] = byte(v)
+	case 12:
+		if i%2 == 0 {
+			buf[3*i/2] = byte(v >> 4)
+			buf[3*i/2+1] = buf[3*i/2+1]&0x0F | byte(v<<4)
+		} else {
+			buf[3*i/2] = buf[3*i/2]&0xF0 | byte(v>>8)&0x0F
+			buf[3*i/2+1] = byte(v)
+		}
+	default:
+		pos := i * bits
+		shift := 8 - bits - pos%8
+		mask := byte(1<<bits-1) << shift
+		buf[pos/8] = buf[pos/8]&^mask | byte(v)<<shift&mask
+	}
+}
+
+// Get returns the pixel value at index i of an encoded buffer.
+func (f Format) Get(buf []byte, i int) uint32 {
+	bits := f.BitsPerPixel()
+	switch bits {
+	case 16:
+		return uint32(buf[2*i])<<8 | uint32(buf[2*i+1])
+	case 24:
+		return uint32(buf[3*i])<<16 | uint32(buf[3*i+1])<<8 | uint32(buf[3*i+2])
+	case 12:
+		if i%2 == 0 {
+			return uint32(buf[3*i/2])<<4 | uint32(buf[3*i/2+1]>>4)
+		}
+		return uint32(buf[3*i/2]&0x0F)<<8 | uint32(buf[3*i/2+1])
+	default:
+		pos := i * bits
+		shift := 8 - bits - pos%8
+		return uint32(buf[pos/8]>>shift) & (1<<bits - 1)
+	}
+}
+
+// ToRGB565 converts a color to the RGB565 format used by most color TFT
+// displays.
+func ToRGB565(c color.RGBA) uint16 {
+	return uint16(c.R&0xF8)<<8 | uint16(c.G&0xFC)<<3 | uint16(c.B>>3)
+}
+
+// ToMono reports whether c is white, rather than black, on a monochrome
+// display.
+func ToMono(c color.RGBA) bool {
+	return Gray(c) >= 0x80
+}
+
+// ToTriColor returns the TriColor value closest to c.
+func ToTriColor(c color.RGBA) uint8 {
+	// The third color is picked for clearly red colors, otherwise the
+	// brightness decides.
+	if int(c.R)-int(c.G) >= 0x60 && int(c.R)-int(c.B) >= 0x60 {
+		return TriRed
+	}
+	if ToMono(c) {
+		return TriWhite
+	}
+	return TriBlack
+}
+
+// Gray returns the luminance of c, using the same weights as color.GrayModel.
+func Gray(c color.RGBA) uint8 {
+	return uint8((19595*uint32(c.R) + 38470*uint32(c.G) + 7471*uint32(c.B) + 1<<15) >> 16)
+}
+
+// expand scales a value of the given number of bits to 8 bits.
+func expand(v uint32, bits uint) uint8 {
+	v &= 1<<bits - 1
+	return uint8(v * 255 / (1<<bits - 1))
+}
+
+// round rounds an 8-bit value to the nearest value representable in the
+// given number of bits, scaled back to 8 bits.
+func round(v uint8, bits uint) uint8 {
+	if bits >= 8 {
+		return v
+	}
+	max := uint32(1<<bits - 1)
+	return uint8((uint32(v)*max + 127) / 255 * 255 / max)
+}
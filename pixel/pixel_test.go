@@ -0,0 +1,142 @@
+package pixel
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+var formats = []Format{RGB565, BGR565, RGB444, BGR444, RGB888, BGR888, Mono, Gray2, Gray4, TriColor, Gray8}
+
+func TestConvert(t *testing.T) {
+	c := qt.New(t)
+	orange := color.RGBA{0xFF, 0x80, 0x10, 0xFF}
+	for _, tc := range []struct {
+		f    Format
+		want uint32
+	}{
+		{RGB565, 0xFC02},
+		{BGR565, 0x141F},
+		{RGB444, 0xF81},
+		{BGR444, 0x18F},
+		{RGB888, 0xFF8010},
+		{BGR888, 0x1080FF},
+		{Mono, 1},
+		{Gray2, 2},
+		{Gray4, 9},
+		{TriColor, TriRed},
+		{Gray8, 0x99},
+	} {
+		c.Assert(tc.f.Convert(orange), qt.Equals, tc.want, qt.Commentf("format %d", tc.f))
+	}
+
+	c.Assert(ToMono(color.RGBA{1, 1, 1, 255}), qt.IsFalse)
+	c.Assert(ToMono(color.RGBA{0xC0, 0xC0, 0xC0, 255}), qt.IsTrue)
+	c.Assert(ToTriColor(color.RGBA{0, 0, 0xFF, 255}), qt.Equals, uint8(TriBlack))
+	c.Assert(ToTriColor(color.RGBA{0xFF, 0xFF, 0xFF, 255}), qt.Equals, uint8(TriWhite))
+}
+
+func TestRoundTrip(t *testing.T) {
+	c := qt.New(t)
+	for _, f := range formats {
+		for v := 0; v < 256; v += 5 {
+			col := color.RGBA{uint8(v), uint8(255 - v), uint8(v * 7), 255}
+			q := f.Quantize(col)
+			c.Assert(f.Quantize(q), qt.Equals, q, qt.Commentf("format %d", f))
+			c.Assert(f.Color(f.Convert(q)), qt.Equals, q, qt.Commentf("format %d", f))
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	c := qt.New(t)
+	white := color.RGBA{255, 255, 255, 255}
+	black := color.RGBA{0, 0, 0, 255}
+	red := color.RGBA{255, 0, 0, 255}
+	src := []color.RGBA{white, black, red, white, white}
+
+	buf := make([]byte, 16)
+	for i := range buf {
+		buf[i] = 0xAA
+	}
+	c.Assert(Mono.Encode(buf, src), qt.Equals, 1)
+	c.Assert(buf[0], qt.Equals, byte(0b10011000))
+	c.Assert(TriColor.Encode(buf, src), qt.Equals, 2)
+	c.Assert(buf[:2], qt.DeepEquals, []byte{0b00011000, 0b00000000})
+	c.Assert(RGB565.Encode(buf, src[:2]), qt.Equals, 4)
+	c.Assert(buf[:4], qt.DeepEquals, []byte{0xFF, 0xFF, 0x00, 0x00})
+	c.Assert(RGB444.Encode(buf, src[1:4]), qt.Equals, 5)
+	c.Assert(buf[:5], qt.DeepEquals, []byte{0x00, 0x0F, 0x00, 0xFF, 0xF0})
+
+	for _, f := range formats {
+		n := f.Encode(buf, src)
+		c.Assert(n, qt.Equals, f.BufferSize(len(src)))
+		for i, col := range src {
+			c.Assert(f.Get(buf, i), qt.Equals, f.Convert(col), qt.Commentf("format %d pixel %d", f, i))
+		}
+	}
+}
+
+func TestGamma(t *testing.T) {
+	c := qt.New(t)
+	g := NewGamma(2.2)
+	c.Assert(g[0], qt.Equals, uint8(0))
+	c.Assert(g[128], qt.Equals, uint8(56))
+	c.Assert(g[255], qt.Equals, uint8(255))
+	c.Assert(g.Apply(color.RGBA{128, 0, 255, 100}), qt.Equals, color.RGBA{56, 0, 255, 100})
+}
+
+func TestDither(t *testing.T) {
+	c := qt.New(t)
+	gray := color.RGBA{0x80, 0x80, 0x80, 255}
+	row := make([]color.RGBA, 64)
+	for i := range row {
+		row[i] = gray
+	}
+	buf := make([]byte, 8)
+
+	// Without dithering, mid gray is all white.
+	cv := &Converter{Format: Mono}
+	cv.ConvertRow(buf, row, 0, 0)
+	c.Assert(ones(buf), qt.Equals, 64)
+
+	// With dithering, about half of the pixels are white.
+	for _, d := range []Dither{OrderedDither, DiffusionDither, AtkinsonDither} {
+		cv := &Converter{Format: Mono, Dither: d}
+		total := 0
+		for y := 0; y < 16; y++ {
+			c.Assert(cv.ConvertRow(buf, row, 0, y), qt.Equals, 8)
+			total += ones(buf)
+		}
+		c.Assert(total > 16*64*45/100 && total < 16*64*55/100, qt.IsTrue, qt.Commentf("dither %d: %d", d, total))
+	}
+
+	// Single pixels use the same ordered pattern as rows.
+	cv = &Converter{Format: Mono, Dither: OrderedDither}
+	cv.ConvertRow(buf, row, 0, 5)
+	for x := 0; x < 64; x++ {
+		c.Assert(cv.ConvertPixel(gray, x, 5), qt.Equals, Mono.Get(buf, x))
+	}
+
+	// Colors that the format has are left alone.
+	for i := range row {
+		row[i] = color.RGBA{0xFF, 0x00, 0x00, 0xFF}
+	}
+	cv = &Converter{Format: RGB565, Dither: DiffusionDither}
+	out := make([]byte, 128)
+	cv.ConvertRow(out, row, 0, 0)
+	for i := 0; i < len(row); i++ {
+		c.Assert(RGB565.Get(out, i), qt.Equals, uint32(0xF800))
+	}
+}
+
+func ones(buf []byte) int {
+	n := 0
+	for _, b := range buf {
+		for ; b != 0; b >>= 1 {
+			n += int(b & 1)
+		}
+	}
+	return n
+}
@@ -0,0 +1,95 @@
+package screenshot
+
+import (
+	"encoding/binary"
+	"hash/adler32"
+	"hash/crc32"
+	"io"
+)
+
+// encodePNG writes m as a PNG image with one IDAT chunk per row. The zlib
+// stream is made of stored deflate blocks, one per row, so nothing but the
+// row needs to be buffered.
+func encodePNG(w io.Writer, m *Image) error {
+	var hdr [13]byte
+	binary.BigEndian.PutUint32(hdr[0:], uint32(m.width))
+	binary.BigEndian.PutUint32(hdr[4:], uint32(m.height))
+	rowSize := 1 + 3*m.width
+	if m.mono != nil {
+		hdr[8], hdr[9] = 1, 0 // 1-bit grayscale
+		rowSize = 1 + (m.width+7)/8
+	} else {
+		hdr[8], hdr[9] = 8, 2 // 8-bit truecolor
+	}
+	if rowSize > 0xFFFF {
+		return errTooWide
+	}
+	if _, err := io.WriteString(w, "\x89PNG\r\n\x1a\n"); err != nil {
+		return err
+	}
+	if err := writeChunk(w, "IHDR", hdr[:]); err != nil {
+		return err
+	}
+
+	// Room for the zlib header, the header of the deflate block, the row
+	// and the checksum of the zlib stream.
+	buf := make([]byte, 2+5+rowSize+4)
+	sum := adler32.New()
+	for y := 0; y < m.height; y++ {
+		data := buf[:0]
+		if y == 0 {
+			data = append(data, 0x78, 0x01)
+		}
+		final := byte(0)
+		if y == m.height-1 {
+			final = 1
+		}
+		data = append(data, final, byte(rowSize), byte(rowSize>>8), ^byte(rowSize), ^byte(rowSize>>8))
+		row := data[len(data) : len(data)+rowSize]
+		row[0] = 0 // no filter
+		for x := 0; x < m.width; x++ {
+			c := m.RGBAAt(x, y)
+			if m.mono != nil {
+				if x%8 == 0 {
+					row[1+x/8] = 0
+				}
+				if c.R != 0 {
+					row[1+x/8] |= 0x80 >> (x % 8)
+				}
+			} else {
+				row[1+3*x], row[2+3*x], row[3+3*x] = c.R, c.G, c.B
+			}
+		}
+		if err := m.Err(); err != nil {
+			return err
+		}
+		sum.Write(row)
+		data = data[:len(data)+rowSize]
+		if final != 0 {
+			data = binary.BigEndian.AppendUint32(data, sum.Sum32())
+		}
+		if err := writeChunk(w, "IDAT", data); err != nil {
+			return err
+		}
+	}
+	return writeChunk(w, "IEND", nil)
+}
+
+// writeChunk writes a PNG chunk.
+func writeChunk(w io.Writer, name string, data []byte) error {
+	var b [8]byte
+	binary.BigEndian.PutUint32(b[:4], uint32(len(data)))
+	copy(b[4:], name)
+	crc := crc32.NewIEEE()
+	crc.Write(b[4:])
+	crc.Write(data)
+	if _, err := w.Write(b[:]); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint32(b[:4], crc.Sum32())
+	_, err := w.Write(b[:4])
+	return err
+}
@@ -0,0 +1,206 @@
+// Package screenshot streams what a display shows as a PNG or JPEG image, to
+// see the screen of a device in the field.
+//
+// The pixels are read back from displays implementing drivers.PixelReader,
+// or with GetPixel from monochrome displays with a framebuffer, such as the
+// ssd1306. GetPixel is true for white pixels, or for black ones if the
+// display has an Ink method returning true, such as the pcd8544. They are
+// read a few rows at a time while the image is encoded and written, so
+// neither the pixels nor the encoded image are ever whole in RAM. The writer
+// can be a UART, a file on the host opened with semihosting, or the response
+// of Handler.
+package screenshot // import "tinygo.org/x/drivers/screenshot"
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/image/jpeg"
+	"tinygo.org/x/drivers/net/http"
+	"tinygo.org/x/drivers/pixel"
+)
+
+var (
+	// ErrUnsupported is returned for displays whose pixels can not be read.
+	ErrUnsupported = errors.New("screenshot: display pixels can not be read")
+	errTooWide     = errors.New("screenshot: display too wide for PNG rows")
+)
+
+// monoReader is a monochrome display with a framebuffer. GetPixel returns
+// true for lit pixels, which are white, unless the display implements
+// inkReader.
+type monoReader interface {
+	GetPixel(x, y int16) bool
+}
+
+// inkReader is implemented by monochrome displays whose GetPixel returns true
+// for black pixels, such as LCDs.
+type inkReader interface {
+	Ink() bool
+}
+
+// Image is the content of a display as an image.Image. The pixels are read
+// when they are accessed, a band of rows at a time, and kept in RGB565.
+type Image struct {
+	reader        drivers.PixelReader
+	mono          monoReader
+	ink           bool // GetPixel is true for black
+	width, height int
+	format        pixel.Format
+	rows          int // in the band
+	stride        int
+	y0            int // first row of the band, -1 before the first read
+	band          []byte
+	line          []color.RGBA
+	err           error
+}
+
+// NewImage returns the content of d, read rows rows at a time. It returns
+// ErrUnsupported if d can not be read.
+func NewImage(d drivers.Displayer, rows int) (*Image, error) {
+	w, h := d.Size()
+	m := &Image{width: int(w), height: int(h), rows: rows, y0: -1}
+	if m.rows < 1 {
+		m.rows = 1
+	}
+	if r, ok := d.(drivers.PixelReader); ok {
+		m.reader = r
+		m.format = pixel.RGB565
+		m.line = make([]color.RGBA, m.width)
+	} else if r, ok := d.(monoReader); ok {
+		m.mono = r
+		m.format = pixel.Mono
+		if i, ok := d.(inkReader); ok {
+			m.ink = i.Ink()
+		}
+	} else {
+		return nil, ErrUnsupported
+	}
+	m.stride = m.format.BufferSize(m.width)
+	m.band = make([]byte, m.stride*m.rows)
+	return m, nil
+}
+
+// ColorModel implements image.Image.
+func (m *Image) ColorModel() color.Model { return color.RGBAModel }
+
+// Bounds implements image.Image.
+func (m *Image) Bounds() image.Rectangle { return image.Rect(0, 0, m.width, m.height) }
+
+// At implements image.Image.
+func (m *Image) At(x, y int) color.Color { return m.RGBAAt(x, y) }
+
+// RGBAAt returns the color of a pixel. Pixels outside the display, or that
+// could not be read, are transparent black.
+func (m *Image) RGBAAt(x, y int) color.RGBA {
+	if x < 0 || y < 0 || x >= m.width || y >= m.height {
+		return color.RGBA{}
+	}
+	if m.y0 < 0 || y < m.y0 || y >= m.y0+m.rows {
+		if !m.load(y - y%m.rows) {
+			return color.RGBA{}
+		}
+	}
+	return m.format.Color(m.format.Get(m.band[(y-m.y0)*m.stride:], x))
+}
+
+// Err returns the first error met while reading the display.
+func (m *Image) Err() error { return m.err }
+
+// load reads the band starting at row y0.
+func (m *Image) load(y0 int) bool {
+	if m.err != nil {
+		return false
+	}
+	m.y0 = y0
+	for j := 0; j < m.rows && y0+j < m.height; j++ {
+		row := m.band[j*m.stride : (j+1)*m.stride]
+		if m.reader != nil {
+			err := m.reader.ReadPixels(0, int16(y0+j), int16(m.width), 1, m.line)
+			if err != nil {
+				m.err = err
+				return false
+			}
+			m.format.Encode(row, m.line)
+			continue
+		}
+		for x := 0; x < m.width; x++ {
+			var v uint32
+			if m.mono.GetPixel(int16(x), int16(y0+j)) != m.ink {
+				v = 1
+			}
+			m.format.Set(row, x, v)
+		}
+	}
+	return true
+}
+
+// WriteJPEG writes the content of d to w as a JPEG image, encoded with
+// image/jpeg.Encode. It reads 16 rows of pixels at a time.
+func WriteJPEG(w io.Writer, d drivers.Displayer, o *jpeg.Options) error {
+	// The encoder works on 16x16 blocks, row by row.
+	m, err := NewImage(d, 16)
+	if err != nil {
+		return err
+	}
+	return encodeJPEG(w, m, o)
+}
+
+func encodeJPEG(w io.Writer, m *Image, o *jpeg.Options) error {
+	if err := jpeg.Encode(w, m, o); err != nil {
+		return err
+	}
+	return m.Err()
+}
+
+// WritePNG writes the content of d to w as a PNG image. The image is not
+// compressed, so that only a row of pixels is kept in RAM: monochrome
+// displays are written with 1 bit per pixel, others in 24-bit color.
+func WritePNG(w io.Writer, d drivers.Displayer) error {
+	m, err := NewImage(d, 1)
+	if err != nil {
+		return err
+	}
+	return encodePNG(w, m)
+}
+
+// Handler returns an HTTP handler serving a screenshot of d, as a JPEG image
+// if the path of the request ends with .jpg or .jpeg, and as a PNG image
+// otherwise. Errors met before the image is sent are replied with
+// http.Error, later ones leave the client with a truncated image.
+func Handler(d drivers.Displayer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := ""
+		if r.URL != nil {
+			path = r.URL.Path
+		}
+		jpg := strings.HasSuffix(path, ".jpg") || strings.HasSuffix(path, ".jpeg")
+		rows := 1
+		if jpg {
+			rows = 16
+		}
+		m, err := NewImage(d, rows)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotImplemented)
+			return
+		}
+		// Read the first rows before replying, to report read errors.
+		m.RGBAAt(0, 0)
+		if err := m.Err(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		// Later errors can only cut the image short, as the reply is started.
+		if jpg {
+			w.Header().Set("Content-Type", "image/jpeg")
+			encodeJPEG(w, m, nil)
+		} else {
+			w.Header().Set("Content-Type", "image/png")
+			encodePNG(w, m)
+		}
+	})
+}
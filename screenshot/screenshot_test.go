@@ -0,0 +1,176 @@
+package screenshot
+
+import (
+	"bytes"
+	"errors"
+	"image/color"
+	stdjpeg "image/jpeg"
+	"image/png"
+	"net/url"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/net/http"
+	"tinygo.org/x/drivers/pixel"
+	"tinygo.org/x/drivers/tester"
+)
+
+// pattern returns a display with a red left half, and a blue right half
+// getting greener downwards.
+func pattern(w, h int16) *tester.Screen {
+	s := tester.NewScreen(w, h)
+	for y := int16(0); y < h; y++ {
+		for x := int16(0); x < w; x++ {
+			if x < w/2 {
+				s.SetPixel(x, y, color.RGBA{0xF8, 0, 0, 255})
+			} else {
+				s.SetPixel(x, y, color.RGBA{0, uint8(y * 4), 0xF8, 255})
+			}
+		}
+	}
+	return s
+}
+
+// failing is a display whose bottom half cannot be read.
+type failing struct {
+	*tester.Screen
+	Err error
+}
+
+func (s *failing) ReadPixels(x, y, width, height int16, buffer []color.RGBA) error {
+	if y >= s.H/2 {
+		return s.Err
+	}
+	return s.Screen.ReadPixels(x, y, width, height, buffer)
+}
+
+// mono is a monochrome display showing a checkerboard.
+type mono struct {
+	W, H int16
+}
+
+func (s *mono) Size() (int16, int16)              { return s.W, s.H }
+func (s *mono) SetPixel(x, y int16, c color.RGBA) {}
+func (s *mono) Display() error                    { return nil }
+func (s *mono) GetPixel(x, y int16) bool          { return (x/2+y)%2 == 0 }
+
+// lcd is a monochrome display whose GetPixel is true for black pixels.
+type lcd struct {
+	mono
+}
+
+func (s *lcd) Ink() bool { return true }
+
+func TestWritePNG(t *testing.T) {
+	c := qt.New(t)
+	s := pattern(20, 10)
+	var buf bytes.Buffer
+	c.Assert(WritePNG(&buf, s), qt.IsNil)
+	c.Assert(s.Reads, qt.Equals, 10)
+	m, err := png.Decode(&buf)
+	c.Assert(err, qt.IsNil)
+	c.Assert(m.Bounds().Dx(), qt.Equals, 20)
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 20; x++ {
+			r, g, b, _ := m.At(x, y).RGBA()
+			// Colors are kept in RGB565.
+			want := pixel.RGB565.Color(pixel.RGB565.Convert(s.At(int16(x), int16(y))))
+			c.Assert([3]uint8{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8)}, qt.Equals, [3]uint8{want.R, want.G, want.B})
+		}
+	}
+
+	ms := &mono{W: 13, H: 5}
+	buf.Reset()
+	c.Assert(WritePNG(&buf, ms), qt.IsNil)
+	m, err = png.Decode(&buf)
+	c.Assert(err, qt.IsNil)
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 13; x++ {
+			r, _, _, _ := m.At(x, y).RGBA()
+			c.Assert(r != 0, qt.Equals, ms.GetPixel(int16(x), int16(y)))
+		}
+	}
+
+	// Black ink is black in the image.
+	ink := &lcd{mono{W: 13, H: 5}}
+	buf.Reset()
+	c.Assert(WritePNG(&buf, ink), qt.IsNil)
+	m, err = png.Decode(&buf)
+	c.Assert(err, qt.IsNil)
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 13; x++ {
+			r, _, _, _ := m.At(x, y).RGBA()
+			c.Assert(r == 0, qt.Equals, ink.GetPixel(int16(x), int16(y)))
+		}
+	}
+
+	c.Assert(WritePNG(&buf, &screenOnly{}), qt.Equals, ErrUnsupported)
+	f := &failing{Screen: s, Err: errors.New("read failed")}
+	c.Assert(WritePNG(&buf, f), qt.Equals, f.Err)
+}
+
+func TestWriteJPEG(t *testing.T) {
+	c := qt.New(t)
+	s := pattern(40, 20)
+	var buf bytes.Buffer
+	c.Assert(WriteJPEG(&buf, s, nil), qt.IsNil)
+	// The image is read in bands of 16 rows.
+	c.Assert(s.Reads, qt.Equals, 20)
+	m, err := stdjpeg.Decode(&buf)
+	c.Assert(err, qt.IsNil)
+	c.Assert(m.Bounds().Dy(), qt.Equals, 20)
+	r, g, b, _ := m.At(5, 5).RGBA()
+	c.Assert(r>>8 > 0xE0 && g>>8 < 0x20 && b>>8 < 0x20, qt.IsTrue, qt.Commentf("%x %x %x", r, g, b))
+
+	f := &failing{Screen: s, Err: errors.New("read failed")}
+	c.Assert(WriteJPEG(&buf, f, nil), qt.Equals, f.Err)
+}
+
+// response records an HTTP response.
+type response struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (r *response) Header() http.Header         { return r.header }
+func (r *response) Write(b []byte) (int, error) { return r.body.Write(b) }
+func (r *response) WriteHeader(code int)        { r.code = code }
+
+func TestHandler(t *testing.T) {
+	c := qt.New(t)
+	ms := &mono{W: 16, H: 16}
+	get := func(path string) *response {
+		w := &response{header: http.Header{}}
+		Handler(ms).ServeHTTP(w, &http.Request{URL: &url.URL{Path: path}})
+		return w
+	}
+	w := get("/screen.png")
+	c.Assert(w.header.Get("Content-Type"), qt.Equals, "image/png")
+	_, err := png.Decode(&w.body)
+	c.Assert(err, qt.IsNil)
+
+	w = get("/screen.jpg")
+	c.Assert(w.header.Get("Content-Type"), qt.Equals, "image/jpeg")
+	_, err = stdjpeg.Decode(&w.body)
+	c.Assert(err, qt.IsNil)
+
+	w = &response{header: http.Header{}}
+	Handler(&screenOnly{}).ServeHTTP(w, &http.Request{URL: &url.URL{Path: "/"}})
+	c.Assert(w.code, qt.Equals, http.StatusNotImplemented)
+
+	// Read errors are replied before the image.
+	s := &failing{Screen: pattern(8, 8), Err: errors.New("read failed")}
+	w = &response{header: http.Header{}}
+	Handler(s).ServeHTTP(w, &http.Request{URL: &url.URL{Path: "/screen.jpg"}})
+	c.Assert(w.code, qt.Equals, http.StatusInternalServerError)
+	c.Assert(w.body.String(), qt.Equals, "read failed\n")
+}
+
+// screenOnly is a display without any way to read it.
+type screenOnly struct{}
+
+func (s *screenOnly) Size() (int16, int16)              { return 8, 8 }
+func (s *screenOnly) SetPixel(x, y int16, c color.RGBA) {}
+func (s *screenOnly) Display() error                    { return nil }
@@ -45,3 +45,39 @@ func Write(fd uintptr, data []byte) error {
 	}
 	return nil
 }
+
+// Open opens a file on the host with the given mode, as in fopen: 0 is "r",
+// 4 is "w", and adding 1 opens the file in binary mode. It returns the file
+// descriptor, or an *IOError if the file could not be opened.
+func Open(name string, mode int) (uintptr, error) {
+	// The name must be terminated by a zero byte.
+	buf := make([]byte, len(name)+1)
+	copy(buf, name)
+	params := struct {
+		name unsafe.Pointer
+		mode int
+		len  int
+	}{
+		name: unsafe.Pointer(&buf[0]),
+		mode: mode,
+		len:  len(name),
+	}
+	fd := arm.SemihostingCall(arm.SemihostingOpen, uintptr(unsafe.Pointer(&params)))
+	if fd == -1 {
+		return 0, &IOError{}
+	}
+	return uintptr(fd), nil
+}
+
+// Close closes the given file descriptor.
+func Close(fd uintptr) error {
+	params := struct {
+		fd uintptr
+	}{
+		fd: fd,
+	}
+	if arm.SemihostingCall(arm.SemihostingClose, uintptr(unsafe.Pointer(&params))) != 0 {
+		return &IOError{}
+	}
+	return nil
+}
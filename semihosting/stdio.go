@@ -1,5 +1,7 @@
 package semihosting
 
+import "io"
+
 // These three file descriptors are connected to the host stdin/stdout/stderr,
 // and can be used for logging.
 var (
@@ -18,3 +20,36 @@ type File struct {
 func (f *File) Write(buf []byte) error {
 	return Write(f.fd, buf)
 }
+
+// Create creates or truncates a file on the host, to write binary data to it.
+func Create(name string) (*File, error) {
+	fd, err := Open(name, 5) // "wb"
+	if err != nil {
+		return nil, err
+	}
+	return &File{fd: fd}, nil
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	return Close(f.fd)
+}
+
+// Writer returns the file as an io.Writer.
+func (f *File) Writer() io.Writer {
+	return writer{f}
+}
+
+type writer struct {
+	f *File
+}
+
+func (w writer) Write(buf []byte) (int, error) {
+	if err := w.f.Write(buf); err != nil {
+		if e, ok := err.(*IOError); ok {
+			return e.BytesWritten, err
+		}
+		return 0, err
+	}
+	return len(buf), nil
+}
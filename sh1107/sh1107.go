@@ -8,6 +8,7 @@ import (
 	"image/color"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
 )
 
 const (
@@ -49,6 +50,9 @@ type Device struct {
 	externalVCC bool
 	address     uint16
 	pageMode    bool
+	tracking    bool
+	dirty       display.DirtyRows
+	stats       display.Stats
 }
 
 // default address is 0x3C
@@ -77,6 +81,11 @@ func (d *Device) Configure() {
 	} else {
 		println("Dimensions don't work")
 	}
+	if d.pageMode {
+		d.dirty.Init(int(d.pages), d.width)
+	} else {
+		d.dirty.Init(int(d.width), d.pages)
+	}
 	//time.Sleep(100 * time.Nanosecond)
 
 	d.Command(SET_DISP)
@@ -122,14 +131,34 @@ func (d *Device) Configure() {
 
 // Command sends a one byte command to the display
 func (d *Device) Command(command uint8) {
+	d.stats.Bytes += 2
 	d.bus.Tx(d.address, []byte{0x80, command}, nil)
 }
 
+// SetDirtyTracking enables or disables dirty tracking. When enabled, Display
+// only sends the parts of the buffer that changed since the last call.
+func (d *Device) SetDirtyTracking(enable bool) {
+	d.tracking = enable
+	d.dirty.MarkAll()
+}
+
+// Stats returns the number of updates and bytes sent to the display
+func (d *Device) Stats() display.Stats {
+	return d.stats
+}
+
+// writeData sends display data
+func (d *Device) writeData(data []byte) {
+	d.stats.Bytes += len(data)
+	d.bus.WriteRegister(uint8(d.address), 0x40, data)
+}
+
 // ClearBuffer clears the image buffer
 func (d *Device) ClearBuffer() {
 	for i := int16(0); i < d.bufferSize; i++ {
 		d.buffer[i] = 0
 	}
+	d.dirty.MarkAll()
 }
 
 // ClearDisplay clears the image buffer and clear the display
@@ -139,31 +168,50 @@ func (d *Device) ClearDisplay() {
 	d.Display()
 }
 
-// Display sends the whole buffer to the screen
+// Display sends the whole buffer to the screen, or only the changed parts
+// if dirty tracking is enabled
 func (d *Device) Display() error {
 	//println("Entering Display()")
+	if !d.tracking {
+		d.dirty.MarkAll()
+	}
+	sent := false
 
 	if d.pageMode {
 		for page := int16(0); page < d.pages; page++ {
 			//fmt.Printf("page = %d\r\n", page)
+			start, end := d.dirty.Span(int(page))
+			if start >= end {
+				continue
+			}
 			buffer_i := page * d.width
 			d.Command(uint8(SET_PAGE_ADDR | page))
-			// two commands below define first position on each page as col 0
-			d.Command(uint8(SET_COL_LO_ADDR))
-			d.Command(uint8(SET_COL_HI_ADDR))
-			d.bus.WriteRegister(uint8(d.address), 0x40, d.buffer[buffer_i:buffer_i+d.width])
+			// two commands below define the first position to write on the page
+			d.Command(uint8(SET_COL_LO_ADDR | (start & 0x0f)))
+			d.Command(uint8(SET_COL_HI_ADDR | (0xF & (start >> 4))))
+			d.writeData(d.buffer[buffer_i+start : buffer_i+end])
+			sent = true
 		}
 	} else {
 		for col := int16(0); col < d.width; col++ {
+			start, end := d.dirty.Span(int(col))
+			if start >= end {
+				continue
+			}
 			buffer_i := col * d.pages
-			// the first position for each column is page 0
-			d.Command(uint8(SET_PAGE_ADDR | 0))
+			// the first position to write in the column
+			d.Command(uint8(SET_PAGE_ADDR | start))
 			d.Command(uint8(SET_COL_LO_ADDR | (col & 0x0f)))
 			d.Command(uint8(SET_COL_HI_ADDR | (0xF & (col >> 4))))
-			d.bus.WriteRegister(uint8(d.address), 0x40, d.buffer[buffer_i:buffer_i+d.pages])
+			d.writeData(d.buffer[buffer_i+start : buffer_i+end])
+			sent = true
 		}
 	}
 	//println("Leaving Display()")
+	if sent {
+		d.stats.Updates++
+	}
+	d.dirty.Clear()
 
 	return nil
 }
@@ -172,20 +220,26 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 	if x < 0 || x >= d.width || y < 0 || y >= d.height {
 		return
 	}
+	var byteIndex int16
 	if d.pageMode {
-		byteIndex := x + (y/8)*d.width
-		if c.R != 0 || c.G != 0 || c.B != 0 {
-			d.buffer[byteIndex] |= 1 << uint8(y%8)
-		} else {
-			d.buffer[byteIndex] &^= 1 << uint8(y%8)
-		}
+		byteIndex = x + (y/8)*d.width
 	} else {
-		byteIndex := (x*d.height + y) >> 3
-		if c.R != 0 || c.G != 0 || c.B != 0 {
-			d.buffer[byteIndex] |= 1 << uint8(y%8)
-		} else {
-			d.buffer[byteIndex] |= 1 << uint8(y%8)
-		}
+		byteIndex = (x*d.height + y) >> 3
+	}
+	b := d.buffer[byteIndex]
+	if c.R != 0 || c.G != 0 || c.B != 0 {
+		b |= 1 << uint8(y%8)
+	} else {
+		b &^= 1 << uint8(y%8)
+	}
+	if b == d.buffer[byteIndex] {
+		return
+	}
+	d.buffer[byteIndex] = b
+	if d.pageMode {
+		d.dirty.Mark(int(y/8), x)
+	} else {
+		d.dirty.Mark(int(x), y/8)
 	}
 }
 
@@ -0,0 +1,82 @@
+package sh1107
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/tester"
+)
+
+func TestDirtyTracking(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewI2CBus(c)
+	fake := &fakeController{addr: 0x3C, RAM: make([]byte, 64*128/8)}
+	bus.AddDevice(fake)
+
+	d := New(bus, 0x3C, 64, 128, false)
+	d.Configure()
+	d.SetDirtyTracking(true)
+	white := color.RGBA{255, 255, 255, 255}
+
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 1024)
+
+	fake.DataBytes = 0
+	updates := d.Stats().Updates
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 0)
+	c.Assert(d.Stats().Updates, qt.Equals, updates)
+
+	for x := int16(20); x < 30; x++ {
+		d.SetPixel(x, 100, white)
+	}
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 10)
+	c.Assert(d.Stats().Updates, qt.Equals, updates+1)
+	c.Assert(fake.RAM, qt.DeepEquals, d.buffer)
+
+	// Clearing a pixel is sent too.
+	fake.DataBytes = 0
+	d.SetPixel(25, 100, color.RGBA{0, 0, 0, 255})
+	c.Assert(d.GetPixel(25, 100), qt.IsFalse)
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 1)
+	c.Assert(fake.RAM, qt.DeepEquals, d.buffer)
+}
+
+// fakeController emulates the display RAM of an SH1107 in page addressing
+// mode.
+type fakeController struct {
+	addr      uint8
+	RAM       []byte
+	DataBytes int
+	page, col int
+}
+
+func (f *fakeController) Addr() uint8 { return f.addr }
+
+func (f *fakeController) ReadRegister(r uint8, buf []byte) error { return nil }
+
+func (f *fakeController) WriteRegister(r uint8, buf []byte) error {
+	for _, b := range buf {
+		f.RAM[f.page*64+f.col] = b
+		f.DataBytes++
+		f.col++
+	}
+	return nil
+}
+
+func (f *fakeController) Tx(w, r []byte) error {
+	cmd := w[1]
+	switch {
+	case cmd&0xF0 == SET_PAGE_ADDR:
+		f.page = int(cmd & 0x0F)
+	case cmd&0xF0 == SET_COL_LO_ADDR:
+		f.col = f.col&0xF0 | int(cmd&0x0F)
+	case cmd&0xF8 == SET_COL_HI_ADDR:
+		f.col = f.col&0x0F | int(cmd&0x07)<<4
+	}
+	return nil
+}
@@ -5,9 +5,12 @@
 package ssd1289
 
 import (
+	"errors"
 	"image/color"
 	"machine"
 	"time"
+
+	"tinygo.org/x/drivers/pixel"
 )
 
 type Bus interface {
@@ -22,6 +25,11 @@ type Device struct {
 	bus Bus
 }
 
+var (
+	errOutOfBounds = errors.New("rectangle coordinates outside display area")
+	errBufferSize  = errors.New("buffer length does not match with rectangle size")
+)
+
 const width = int16(240)
 const height = int16(320)
 
@@ -160,8 +168,7 @@ func (d *Device) FillDisplay(c color.RGBA) {
 }
 
 func encodeColor(c color.RGBA) uint16 {
-	encoded := (uint16(c.B)&248)<<8 | (uint16(c.G)&252)<<3 | (uint16(c.R)&248)>>3
-	return encoded
+	return uint16(pixel.BGR565.Convert(c))
 }
 
 func (d *Device) SetPixel(x, y int16, c color.RGBA) {
@@ -190,6 +197,64 @@ func (d *Device) FillRect(x, y, w, h int16, c color.RGBA) {
 
 }
 
+// FillRectangle fills a rectangle at given coordinates with a color
+func (d *Device) FillRectangle(x, y, w, h int16, c color.RGBA) error {
+	if !inBounds(x, y, w, h) {
+		return errOutOfBounds
+	}
+	d.FillRect(x, y, w, h, c)
+	return nil
+}
+
+// FillRectangleWithBuffer fills a rectangle at given coordinates with a
+// buffer of colors, row by row
+func (d *Device) FillRectangleWithBuffer(x, y, w, h int16, buffer []color.RGBA) error {
+	if !inBounds(x, y, w, h) {
+		return errOutOfBounds
+	}
+	if int(w)*int(h) != len(buffer) {
+		return errBufferSize
+	}
+	d.cs.Low()
+	d.setXY(uint16(x), uint16(y), uint16(x+(w-1)), uint16(y+(h-1)))
+	d.rs.High()
+	for _, c := range buffer {
+		d.lcdWriteBusInt(encodeColor(c))
+	}
+	d.cs.High()
+	d.rs.Low()
+	return nil
+}
+
+// DrawRGBBitmap8 copies a bitmap in the format returned by PixelFormat to the
+// screen at given coordinates
+func (d *Device) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if !inBounds(x, y, w, h) {
+		return errOutOfBounds
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSize
+	}
+	d.cs.Low()
+	d.setXY(uint16(x), uint16(y), uint16(x+(w-1)), uint16(y+(h-1)))
+	d.rs.High()
+	for i := 0; i < len(data); i += 2 {
+		d.lcdWriteBusInt(uint16(data[i])<<8 | uint16(data[i+1]))
+	}
+	d.cs.High()
+	d.rs.Low()
+	return nil
+}
+
+// PixelFormat returns the format of the data passed to DrawRGBBitmap8
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.BGR565
+}
+
+func inBounds(x, y, w, h int16) bool {
+	return x >= 0 && y >= 0 && w > 0 && h > 0 && x+w <= width && y+h <= height
+}
+
 func (d *Device) Display() error {
 	//Not enough memory to store an entire screen on most microcontrollers
 	return nil
@@ -0,0 +1,73 @@
+//go:build tinygo
+// +build tinygo
+
+package ssd1306
+
+import (
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers"
+)
+
+type SPIBus struct {
+	wire     drivers.SPI
+	dcPin    machine.Pin
+	resetPin machine.Pin
+	csPin    machine.Pin
+}
+
+// NewSPI creates a new SSD1306 connection. The SPI wire must already be configured.
+func NewSPI(bus drivers.SPI, dcPin, resetPin, csPin machine.Pin) Device {
+	dcPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	resetPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	csPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	return Device{
+		bus: &SPIBus{
+			wire:     bus,
+			dcPin:    dcPin,
+			resetPin: resetPin,
+			csPin:    csPin,
+		},
+	}
+}
+
+// setAddress does nothing, but it's required to avoid reflection
+func (b *SPIBus) setAddress(address uint16) {
+	// do nothing
+	println("trying to Configure an address on a SPI device")
+}
+
+// configure configures some pins with the SPI bus
+func (b *SPIBus) configure() {
+	b.csPin.Low()
+	b.dcPin.Low()
+	b.resetPin.Low()
+
+	b.resetPin.High()
+	time.Sleep(1 * time.Millisecond)
+	b.resetPin.Low()
+	time.Sleep(10 * time.Millisecond)
+	b.resetPin.High()
+}
+
+// tx sends data to the display (SPIBus implementation)
+func (b *SPIBus) tx(data []byte, isCommand bool) {
+	if isCommand {
+		b.csPin.High()
+		time.Sleep(1 * time.Millisecond)
+		b.dcPin.Low()
+		b.csPin.Low()
+
+		b.wire.Tx(data, nil)
+		b.csPin.High()
+	} else {
+		b.csPin.High()
+		time.Sleep(1 * time.Millisecond)
+		b.dcPin.High()
+		b.csPin.Low()
+
+		b.wire.Tx(data, nil)
+		b.csPin.High()
+	}
+}
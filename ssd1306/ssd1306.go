@@ -7,10 +7,10 @@ package ssd1306 // import "tinygo.org/x/drivers/ssd1306"
 import (
 	"errors"
 	"image/color"
-	"machine"
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
 )
 
 // Device wraps I2C or SPI connection.
@@ -22,6 +22,9 @@ type Device struct {
 	bufferSize int16
 	vccState   VccMode
 	canReset   bool
+	tracking   bool
+	dirty      display.DirtyRows
+	stats      display.Stats
 }
 
 // Config is the configuration for the display
@@ -30,6 +33,10 @@ type Config struct {
 	Height   int16
 	VccState VccMode
 	Address  uint16
+
+	// DirtyTracking makes Display only send the parts of the buffer that
+	// changed since the last call.
+	DirtyTracking bool
 }
 
 type I2CBus struct {
@@ -37,13 +44,6 @@ type I2CBus struct {
 	Address uint16
 }
 
-type SPIBus struct {
-	wire     drivers.SPI
-	dcPin    machine.Pin
-	resetPin machine.Pin
-	csPin    machine.Pin
-}
-
 type Buser interface {
 	configure()
 	tx(data []byte, isCommand bool)
@@ -62,21 +62,6 @@ func NewI2C(bus drivers.I2C) Device {
 	}
 }
 
-// NewSPI creates a new SSD1306 connection. The SPI wire must already be configured.
-func NewSPI(bus drivers.SPI, dcPin, resetPin, csPin machine.Pin) Device {
-	dcPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
-	resetPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
-	csPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
-	return Device{
-		bus: &SPIBus{
-			wire:     bus,
-			dcPin:    dcPin,
-			resetPin: resetPin,
-			csPin:    csPin,
-		},
-	}
-}
-
 // Configure initializes the display with default configuration
 func (d *Device) Configure(cfg Config) {
 	if cfg.Width != 0 {
@@ -99,6 +84,8 @@ func (d *Device) Configure(cfg Config) {
 	}
 	d.bufferSize = d.width * d.height / 8
 	d.buffer = make([]byte, d.bufferSize)
+	d.tracking = cfg.DirtyTracking
+	d.dirty.Init(int(d.height/8), d.width)
 	d.canReset = cfg.Address != 0 || d.width != 128 || d.height != 64 // I2C or not 128x64
 
 	d.bus.configure()
@@ -170,6 +157,7 @@ func (d *Device) ClearBuffer() {
 	for i := int16(0); i < d.bufferSize; i++ {
 		d.buffer[i] = 0
 	}
+	d.dirty.MarkAll()
 }
 
 // ClearDisplay clears the image buffer and clear the display
@@ -178,8 +166,14 @@ func (d *Device) ClearDisplay() {
 	d.Display()
 }
 
-// Display sends the whole buffer to the screen
+// Display sends the whole buffer to the screen, or only the changed parts
+// if dirty tracking is enabled
 func (d *Device) Display() error {
+	if d.tracking && !d.dirty.Full() {
+		return d.displayDirty()
+	}
+	d.stats.Updates++
+
 	// Reset the screen to 0x0
 	// This works fine with I2C
 	// In the 128x64 (SPI) screen resetting to 0x0 after 128 times corrupt the buffer
@@ -194,9 +188,48 @@ func (d *Device) Display() error {
 	}
 
 	d.Tx(d.buffer, false)
+	d.dirty.Clear()
 	return nil
 }
 
+// displayDirty sends the changed columns of every page
+func (d *Device) displayDirty() error {
+	sent := false
+	for page := 0; page < len(d.buffer)/int(d.width); page++ {
+		start, end := d.dirty.Span(page)
+		if start >= end {
+			continue
+		}
+		d.Command(COLUMNADDR)
+		d.Command(uint8(start))
+		d.Command(uint8(end - 1))
+		d.Command(PAGEADDR)
+		d.Command(uint8(page))
+		d.Command(uint8(page))
+		offset := int16(page) * d.width
+		d.Tx(d.buffer[offset+start:offset+end], false)
+		sent = true
+	}
+	if sent {
+		// Restore the whole window, as Display does not set it on 128x64 SPI
+		// screens
+		d.Command(COLUMNADDR)
+		d.Command(0)
+		d.Command(uint8(d.width - 1))
+		d.Command(PAGEADDR)
+		d.Command(0)
+		d.Command(uint8(d.height/8) - 1)
+		d.stats.Updates++
+	}
+	d.dirty.Clear()
+	return nil
+}
+
+// Stats returns the number of updates and bytes sent to the display
+func (d *Device) Stats() display.Stats {
+	return d.stats
+}
+
 // SetPixel enables or disables a pixel in the buffer
 // color.RGBA{0, 0, 0, 255} is consider transparent, anything else
 // with enable a pixel on the screen
@@ -205,10 +238,15 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 		return
 	}
 	byteIndex := x + (y/8)*d.width
+	b := d.buffer[byteIndex]
 	if c.R != 0 || c.G != 0 || c.B != 0 {
-		d.buffer[byteIndex] |= 1 << uint8(y%8)
+		b |= 1 << uint8(y%8)
 	} else {
-		d.buffer[byteIndex] &^= 1 << uint8(y%8)
+		b &^= 1 << uint8(y%8)
+	}
+	if b != d.buffer[byteIndex] {
+		d.buffer[byteIndex] = b
+		d.dirty.Mark(int(y/8), x)
 	}
 }
 
@@ -230,12 +268,13 @@ func (d *Device) SetBuffer(buffer []byte) error {
 	for i := int16(0); i < d.bufferSize; i++ {
 		d.buffer[i] = buffer[i]
 	}
+	d.dirty.MarkAll()
 	return nil
 }
 
 // Command sends a command to the display
 func (d *Device) Command(command uint8) {
-	d.bus.tx([]byte{command}, true)
+	d.Tx([]byte{command}, true)
 }
 
 // setAddress sets the address to the I2C bus
@@ -243,30 +282,12 @@ func (b *I2CBus) setAddress(address uint16) {
 	b.Address = address
 }
 
-// setAddress does nothing, but it's required to avoid reflection
-func (b *SPIBus) setAddress(address uint16) {
-	// do nothing
-	println("trying to Configure an address on a SPI device")
-}
-
 // configure does nothing, but it's required to avoid reflection
 func (b *I2CBus) configure() {}
 
-// configure configures some pins with the SPI bus
-func (b *SPIBus) configure() {
-	b.csPin.Low()
-	b.dcPin.Low()
-	b.resetPin.Low()
-
-	b.resetPin.High()
-	time.Sleep(1 * time.Millisecond)
-	b.resetPin.Low()
-	time.Sleep(10 * time.Millisecond)
-	b.resetPin.High()
-}
-
 // Tx sends data to the display
 func (d *Device) Tx(data []byte, isCommand bool) {
+	d.stats.Bytes += len(data)
 	d.bus.tx(data, isCommand)
 }
 
@@ -279,27 +300,6 @@ func (b *I2CBus) tx(data []byte, isCommand bool) {
 	}
 }
 
-// tx sends data to the display (SPIBus implementation)
-func (b *SPIBus) tx(data []byte, isCommand bool) {
-	if isCommand {
-		b.csPin.High()
-		time.Sleep(1 * time.Millisecond)
-		b.dcPin.Low()
-		b.csPin.Low()
-
-		b.wire.Tx(data, nil)
-		b.csPin.High()
-	} else {
-		b.csPin.High()
-		time.Sleep(1 * time.Millisecond)
-		b.dcPin.High()
-		b.csPin.Low()
-
-		b.wire.Tx(data, nil)
-		b.csPin.High()
-	}
-}
-
 // Size returns the current size of the display.
 func (d *Device) Size() (w, h int16) {
 	return d.width, d.height
@@ -0,0 +1,187 @@
+package ssd1306
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/tester"
+)
+
+var (
+	white = color.RGBA{255, 255, 255, 255}
+	black = color.RGBA{0, 0, 0, 255}
+)
+
+func TestDirtyTracking(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewI2CBus(c)
+	fake := newFakeController(Address, 128, 64)
+	bus.AddDevice(fake)
+
+	d := NewI2C(bus)
+	d.Configure(Config{Width: 128, Height: 64, Address: Address, DirtyTracking: true})
+
+	// The first update sends everything.
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 1024)
+	c.Assert(d.Stats().Updates, qt.Equals, 1)
+
+	// Nothing changed, nothing is sent.
+	bytes := d.Stats().Bytes
+	d.SetPixel(0, 0, black)
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(d.Stats().Bytes, qt.Equals, bytes)
+	c.Assert(d.Stats().Updates, qt.Equals, 1)
+
+	// A single pixel costs the addressing commands and a single byte.
+	fake.DataBytes = 0
+	d.SetPixel(10, 20, white)
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 1)
+	c.Assert(d.Stats().Bytes, qt.Equals, bytes+6+1+6)
+	c.Assert(d.Stats().Updates, qt.Equals, 2)
+	c.Assert(fake.RAM, qt.DeepEquals, d.buffer)
+
+	// A line across two pages sends a span in each page.
+	fake.DataBytes = 0
+	for x := int16(5); x < 50; x++ {
+		d.SetPixel(x, 7, white)
+		d.SetPixel(x, 8, white)
+	}
+	d.SetPixel(127, 63, white)
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 45+45+1)
+	c.Assert(fake.RAM, qt.DeepEquals, d.buffer)
+
+	// Clearing the buffer sends the whole buffer again.
+	fake.DataBytes = 0
+	d.ClearDisplay()
+	c.Assert(fake.DataBytes, qt.Equals, 1024)
+	c.Assert(fake.RAM, qt.DeepEquals, d.buffer)
+}
+
+func TestDirtyThenFullUpdate(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewI2CBus(c)
+	fake := newFakeController(Address, 128, 64)
+	bus.AddDevice(fake)
+
+	// Without an address a 128x64 screen is handled like SPI ones, which
+	// do not set the window before a full update.
+	d := NewI2C(bus)
+	d.Configure(Config{Width: 128, Height: 64, DirtyTracking: true})
+	c.Assert(d.Display(), qt.IsNil)
+
+	fake.Commands = nil
+	d.SetPixel(10, 20, white)
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.Commands, qt.DeepEquals, []byte{
+		COLUMNADDR, 10, 10, PAGEADDR, 2, 2,
+		COLUMNADDR, 0, 127, PAGEADDR, 0, 7,
+	})
+
+	fake.Commands = nil
+	d.ClearBuffer()
+	d.SetPixel(100, 50, white)
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.Commands, qt.HasLen, 0)
+	c.Assert(fake.RAM, qt.DeepEquals, d.buffer)
+}
+
+func TestNoDirtyTracking(t *testing.T) {
+	c := qt.New(t)
+	bus := tester.NewI2CBus(c)
+	fake := newFakeController(Address, 128, 32)
+	bus.AddDevice(fake)
+
+	d := NewI2C(bus)
+	d.Configure(Config{Width: 128, Height: 32, Address: Address})
+	d.SetPixel(3, 3, white)
+	c.Assert(d.Display(), qt.IsNil)
+	d.SetPixel(4, 3, white)
+	c.Assert(d.Display(), qt.IsNil)
+	c.Assert(fake.DataBytes, qt.Equals, 2*512)
+	c.Assert(fake.RAM, qt.DeepEquals, d.buffer)
+}
+
+// fakeController emulates the display RAM of an SSD1306 in horizontal
+// addressing mode.
+type fakeController struct {
+	addr      uint8
+	width     int
+	RAM       []byte
+	DataBytes int
+	Commands  []byte
+	col, page int
+	colStart  int
+	colEnd    int
+	pageStart int
+	pageEnd   int
+	pending   uint8
+	args      []byte
+}
+
+func newFakeController(addr uint8, width, height int) *fakeController {
+	return &fakeController{
+		addr:    addr,
+		width:   width,
+		RAM:     make([]byte, width*height/8),
+		colEnd:  width - 1,
+		pageEnd: height/8 - 1,
+	}
+}
+
+func (f *fakeController) Addr() uint8 { return f.addr }
+
+func (f *fakeController) ReadRegister(r uint8, buf []byte) error { return nil }
+
+func (f *fakeController) Tx(w, r []byte) error { return nil }
+
+func (f *fakeController) WriteRegister(r uint8, buf []byte) error {
+	for _, b := range buf {
+		if r == 0x40 {
+			f.data(b)
+		} else {
+			f.command(b)
+		}
+	}
+	return nil
+}
+
+func (f *fakeController) command(b byte) {
+	f.Commands = append(f.Commands, b)
+	if f.pending != 0 {
+		f.args = append(f.args, b)
+		if len(f.args) < 2 {
+			return
+		}
+		if f.pending == COLUMNADDR {
+			f.colStart, f.colEnd = int(f.args[0]), int(f.args[1])
+			f.col = f.colStart
+		} else {
+			f.pageStart, f.pageEnd = int(f.args[0]), int(f.args[1])
+			f.page = f.pageStart
+		}
+		f.pending = 0
+		return
+	}
+	if b == COLUMNADDR || b == PAGEADDR {
+		f.pending = b
+		f.args = f.args[:0]
+	}
+}
+
+func (f *fakeController) data(b byte) {
+	f.RAM[f.page*f.width+f.col] = b
+	f.DataBytes++
+	f.col++
+	if f.col > f.colEnd {
+		f.col = f.colStart
+		f.page++
+		if f.page > f.pageEnd {
+			f.page = f.pageStart
+		}
+	}
+}
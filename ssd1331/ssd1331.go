@@ -2,6 +2,11 @@
 //
 // Datasheet: https://www.crystalfontz.com/controllers/SolomonSystech/SSD1331/381/
 //
+// The driver implements drivers.Rotator with the remapping of the controller.
+// It does not implement drivers.Scroller: the SSD1331 only scrolls the whole
+// screen by its start line, or moves pixels with its graphic acceleration
+// commands, and cannot keep the fixed areas a Scroller needs.
+//
 package ssd1331 // import "tinygo.org/x/drivers/ssd1331"
 
 import (
@@ -12,10 +17,13 @@ import (
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
 )
 
 type Model uint8
-type Rotation uint8
+
+// Rotation controls the rotation used by the display.
+type Rotation = drivers.Rotation
 
 // Device wraps an SPI connection.
 type Device struct {
@@ -25,6 +33,7 @@ type Device struct {
 	csPin       machine.Pin
 	width       int16
 	height      int16
+	rotation    Rotation
 	batchLength int16
 	isBGR       bool
 	batchData   []uint8
@@ -32,8 +41,9 @@ type Device struct {
 
 // Config is the configuration for the display
 type Config struct {
-	Width  int16
-	Height int16
+	Width    int16 // Width without rotation
+	Height   int16 // Height without rotation
+	Rotation Rotation
 }
 
 // New creates a new SSD1331 connection. The SPI wire must already be configured.
@@ -61,6 +71,7 @@ func (d *Device) Configure(cfg Config) {
 	} else {
 		d.height = 64
 	}
+	d.rotation = drivers.Rotation0
 
 	d.batchLength = d.width
 	if d.height > d.width {
@@ -116,6 +127,7 @@ func (d *Device) Configure(cfg Config) {
 	d.Command(CONTRASTC)
 	d.Command(0x7D)
 	d.Command(DISPLAYON)
+	d.SetRotation(cfg.Rotation)
 }
 
 // Display does nothing, there's no buffer as it might be too big for some boards
@@ -133,6 +145,10 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 
 // setWindow prepares the screen to be modified at a given rectangle
 func (d *Device) setWindow(x, y, w, h int16) {
+	if d.rotation == drivers.Rotation90 || d.rotation == drivers.Rotation270 {
+		// the controller increments the row address first
+		x, y, w, h = y, x, h, w
+	}
 	/*d.Tx([]uint8{SETCOLUMN}, true)
 	d.Tx([]uint8{uint8(x), uint8(x + w - 1)}, false)
 	d.Tx([]uint8{SETROW}, true)
@@ -152,7 +168,7 @@ func (d *Device) FillRectangle(x, y, width, height int16, c color.RGBA) error {
 		return errors.New("rectangle coordinates outside display area")
 	}
 	d.setWindow(x, y, width, height)
-	c565 := RGBATo565(c)
+	c565 := pixel.ToRGB565(c)
 	c1 := uint8(c565 >> 8)
 	c2 := uint8(c565)
 
@@ -189,15 +205,11 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 
 	offset := int16(0)
 	for k > 0 {
-		for i := int16(0); i < d.batchLength; i++ {
-			if offset+i < l {
-				c565 := RGBATo565(buffer[offset+i])
-				c1 := uint8(c565 >> 8)
-				c2 := uint8(c565)
-				d.batchData[i*2] = c1
-				d.batchData[i*2+1] = c2
-			}
+		end := offset + d.batchLength
+		if end > l {
+			end = l
 		}
+		pixel.RGB565.Encode(d.batchData, buffer[offset:end])
 		if k >= d.batchLength {
 			d.Tx(d.batchData, false)
 		} else {
@@ -209,6 +221,25 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 	return nil
 }
 
+// DrawRGBBitmap8 copies an RGB565 bitmap to the screen at given coordinates
+func (d *Device) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if x < 0 || y < 0 || w <= 0 || h <= 0 ||
+		x >= d.width || (x+w) > d.width || y >= d.height || (y+h) > d.height {
+		return errors.New("rectangle coordinates outside display area")
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errors.New("buffer length does not match with rectangle size")
+	}
+	d.setWindow(x, y, w, h)
+	d.Tx(data, false)
+	return nil
+}
+
+// PixelFormat returns the format of the data passed to DrawRGBBitmap8
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.RGB565
+}
+
 // DrawFastVLine draws a vertical line faster than using SetPixel
 func (d *Device) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
 	if y0 > y1 {
@@ -261,15 +292,45 @@ func (d *Device) Size() (w, h int16) {
 	return d.width, d.height
 }
 
-// IsBGR changes the color mode (RGB/BGR)
+// remap holds the SETREMAP value of each rotation: 65k colors with COM split,
+// the column and COM scan order of the rotation, and vertical address
+// increment for 90 and 270 degrees
+var remap = [4]uint8{
+	drivers.Rotation0:   0x72,
+	drivers.Rotation90:  0x71,
+	drivers.Rotation180: 0x60,
+	drivers.Rotation270: 0x63,
+}
+
+// GetRotation returns the current rotation of the device
+func (d *Device) GetRotation() Rotation {
+	return d.rotation
+}
+
+// SetRotation changes the rotation of the device (clock-wise)
+func (d *Device) SetRotation(rotation Rotation) {
+	rotation %= 4
+	if (rotation^d.rotation)&1 != 0 {
+		d.width, d.height = d.height, d.width
+	}
+	d.rotation = rotation
+	v := remap[rotation]
+	if d.isBGR {
+		v |= 0x04 // C, B, A color order
+	}
+	d.Command(SETREMAP)
+	d.Command(v)
+}
+
+// IsBGR changes the color mode (RGB/BGR), from the next call to Configure or
+// SetRotation
 func (d *Device) IsBGR(bgr bool) {
 	d.isBGR = bgr
 }
 
 // RGBATo565 converts a color.RGBA to uint16 used in the display
+//
+// Deprecated: use pixel.ToRGB565.
 func RGBATo565(c color.RGBA) uint16 {
-	r, g, b, _ := c.RGBA()
-	return uint16((r & 0xF800) +
-		((g & 0xFC00) >> 5) +
-		((b & 0xF800) >> 11))
+	return pixel.ToRGB565(c)
 }
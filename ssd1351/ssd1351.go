@@ -2,6 +2,11 @@
 //
 // Datasheet: https://download.mikroe.com/documents/datasheets/ssd1351-revision-1.3.pdf
 //
+// The driver implements drivers.Rotator with the remapping of the controller.
+// It does not implement drivers.Scroller: the SSD1351 only scrolls the whole
+// screen by its start line, or scrolls horizontally, and cannot keep the fixed
+// areas a Scroller needs.
+//
 package ssd1351 // import "tinygo.org/x/drivers/ssd1351"
 
 import (
@@ -11,6 +16,7 @@ import (
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
 )
 
 var (
@@ -18,6 +24,9 @@ var (
 	errBufferSizeMismatch = errors.New("buffer length does not match with rectangle size")
 )
 
+// Rotation controls the rotation used by the display.
+type Rotation = drivers.Rotation
+
 // Device wraps an SPI connection.
 type Device struct {
 	bus          drivers.SPI
@@ -28,6 +37,8 @@ type Device struct {
 	rwPin        machine.Pin
 	width        int16
 	height       int16
+	rotation     Rotation
+	isBGR        bool
 	rowOffset    int16
 	columnOffset int16
 	bufferLength int16
@@ -35,10 +46,11 @@ type Device struct {
 
 // Config is the configuration for the display
 type Config struct {
-	Width        int16
-	Height       int16
+	Width        int16 // Width without rotation
+	Height       int16 // Height without rotation
 	RowOffset    int16
 	ColumnOffset int16
+	Rotation     Rotation
 }
 
 // New creates a new SSD1351 connection. The SPI wire must already be configured.
@@ -65,6 +77,7 @@ func (d *Device) Configure(cfg Config) {
 
 	d.width = cfg.Width
 	d.height = cfg.Height
+	d.rotation = drivers.Rotation0
 	d.rowOffset = cfg.RowOffset
 	d.columnOffset = cfg.ColumnOffset
 
@@ -139,6 +152,7 @@ func (d *Device) Configure(cfg Config) {
 	d.Command(SET_DISPLAY_MODE_RESET)
 	d.Command(SLEEP_MODE_DISPLAY_ON)
 
+	d.SetRotation(cfg.Rotation)
 }
 
 // Display does nothing, there's no buffer as it might be too big for some boards
@@ -156,8 +170,23 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 
 // setWindow prepares the screen memory to be modified at given coordinates
 func (d *Device) setWindow(x, y, w, h int16) {
-	x += d.columnOffset
-	y += d.rowOffset
+	columns, rows := d.width, d.height
+	if d.rotation == drivers.Rotation90 || d.rotation == drivers.Rotation270 {
+		// the controller increments the row address first
+		x, y, w, h = y, x, h, w
+		columns, rows = rows, columns
+	}
+	// columns and rows scanned in reverse start at the other end of the memory
+	if d.rotation == drivers.Rotation90 || d.rotation == drivers.Rotation180 {
+		x += 128 - columns - d.columnOffset
+	} else {
+		x += d.columnOffset
+	}
+	if d.rotation == drivers.Rotation180 || d.rotation == drivers.Rotation270 {
+		y += 128 - rows - d.rowOffset
+	} else {
+		y += d.rowOffset
+	}
 	d.Command(SET_COLUMN_ADDRESS)
 	d.Tx([]byte{uint8(x), uint8(x + w - 1)}, false)
 	d.Command(SET_ROW_ADDRESS)
@@ -172,7 +201,7 @@ func (d *Device) FillRectangle(x, y, width, height int16, c color.RGBA) error {
 		return errDrawingOutOfBounds
 	}
 	d.setWindow(x, y, width, height)
-	c565 := RGBATo565(c)
+	c565 := pixel.ToRGB565(c)
 	c1 := uint8(c565 >> 8)
 	c2 := uint8(c565)
 
@@ -220,15 +249,11 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 
 	offset := int16(0)
 	for dim > 0 {
-		for i := int16(0); i < bl; i++ {
-			if offset+i < l {
-				c565 := RGBATo565(buffer[offset+i])
-				c1 := uint8(c565 >> 8)
-				c2 := uint8(c565)
-				data[i*2] = c1
-				data[i*2+1] = c2
-			}
+		end := offset + bl
+		if end > l {
+			end = l
 		}
+		pixel.RGB565.Encode(data, buffer[offset:end])
 		if dim >= d.bufferLength {
 			d.Tx(data, false)
 		} else {
@@ -240,6 +265,25 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 	return nil
 }
 
+// DrawRGBBitmap8 copies an RGB565 bitmap to the screen at given coordinates
+func (d *Device) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if x < 0 || y < 0 || w <= 0 || h <= 0 ||
+		x >= d.width || (x+w) > d.width || y >= d.height || (y+h) > d.height {
+		return errDrawingOutOfBounds
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSizeMismatch
+	}
+	d.setWindow(x, y, w, h)
+	d.Tx(data, false)
+	return nil
+}
+
+// PixelFormat returns the format of the data passed to DrawRGBBitmap8
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.RGB565
+}
+
 // DrawFastVLine draws a vertical line faster than using SetPixel
 func (d *Device) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
 	if y0 > y1 {
@@ -267,6 +311,42 @@ func (d *Device) SetContrast(contrastA, contrastB, contrastC uint8) {
 	d.Tx([]byte{contrastA, contrastB, contrastC}, false)
 }
 
+// remap holds the SET_REMAP_COLORDEPTH value of each rotation: 65k colors with
+// COM split, the column and COM scan order of the rotation, and vertical
+// address increment for 90 and 270 degrees
+var remap = [4]uint8{
+	drivers.Rotation0:   0x62,
+	drivers.Rotation90:  0x61,
+	drivers.Rotation180: 0x70,
+	drivers.Rotation270: 0x73,
+}
+
+// GetRotation returns the current rotation of the device
+func (d *Device) GetRotation() Rotation {
+	return d.rotation
+}
+
+// SetRotation changes the rotation of the device (clock-wise)
+func (d *Device) SetRotation(rotation Rotation) {
+	rotation %= 4
+	if (rotation^d.rotation)&1 != 0 {
+		d.width, d.height = d.height, d.width
+	}
+	d.rotation = rotation
+	v := remap[rotation]
+	if d.isBGR {
+		v |= 0x04 // C, B, A color order
+	}
+	d.Command(SET_REMAP_COLORDEPTH)
+	d.Data(v)
+}
+
+// IsBGR changes the color mode (RGB/BGR), from the next call to Configure or
+// SetRotation
+func (d *Device) IsBGR(bgr bool) {
+	d.isBGR = bgr
+}
+
 // Command sends a command byte to the display
 func (d *Device) Command(command uint8) {
 	d.Tx([]byte{command}, true)
@@ -291,9 +371,8 @@ func (d *Device) Size() (w, h int16) {
 }
 
 // RGBATo565 converts a color.RGBA to uint16 used in the display
+//
+// Deprecated: use pixel.ToRGB565.
 func RGBATo565(c color.RGBA) uint16 {
-	r, g, b, _ := c.RGBA()
-	return uint16((r & 0xF800) +
-		((g & 0xFC00) >> 5) +
-		((b & 0xF800) >> 11))
+	return pixel.ToRGB565(c)
 }
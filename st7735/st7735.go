@@ -12,10 +12,13 @@ import (
 	"errors"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
 )
 
 type Model uint8
-type Rotation uint8
+
+// Rotation controls the rotation used by the display.
+type Rotation = drivers.Rotation
 
 // Device wraps an SPI connection.
 type Device struct {
@@ -255,7 +258,7 @@ func (d *Device) FillRectangle(x, y, width, height int16, c color.RGBA) error {
 		return errors.New("rectangle coordinates outside display area")
 	}
 	d.setWindow(x, y, width, height)
-	c565 := RGBATo565(c)
+	c565 := pixel.ToRGB565(c)
 	c1 := uint8(c565 >> 8)
 	c2 := uint8(c565)
 
@@ -292,15 +295,11 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 
 	offset := int16(0)
 	for k > 0 {
-		for i := int16(0); i < d.batchLength; i++ {
-			if offset+i < l {
-				c565 := RGBATo565(buffer[offset+i])
-				c1 := uint8(c565 >> 8)
-				c2 := uint8(c565)
-				d.batchData[i*2] = c1
-				d.batchData[i*2+1] = c2
-			}
+		end := offset + d.batchLength
+		if end > l {
+			end = l
 		}
+		pixel.RGB565.Encode(d.batchData, buffer[offset:end])
 		if k >= d.batchLength {
 			d.Tx(d.batchData, false)
 		} else {
@@ -312,6 +311,26 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 	return nil
 }
 
+// DrawRGBBitmap8 copies an RGB565 bitmap to the screen at given coordinates
+func (d *Device) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	k, i := d.Size()
+	if x < 0 || y < 0 || w <= 0 || h <= 0 ||
+		x >= k || (x+w) > k || y >= i || (y+h) > i {
+		return errors.New("rectangle coordinates outside display area")
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errors.New("buffer length does not match with rectangle size")
+	}
+	d.setWindow(x, y, w, h)
+	d.Tx(data, false)
+	return nil
+}
+
+// PixelFormat returns the format of the data passed to DrawRGBBitmap8
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.RGB565
+}
+
 // DrawFastVLine draws a vertical line faster than using SetPixel
 func (d *Device) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
 	if y0 > y1 {
@@ -337,6 +356,11 @@ func (d *Device) FillScreen(c color.RGBA) {
 	}
 }
 
+// GetRotation returns the current rotation of the device
+func (d *Device) GetRotation() Rotation {
+	return d.rotation
+}
+
 // SetRotation changes the rotation of the device (clock-wise)
 func (d *Device) SetRotation(rotation Rotation) {
 	madctl := uint8(0)
@@ -409,9 +433,8 @@ func (d *Device) IsBGR(bgr bool) {
 }
 
 // RGBATo565 converts a color.RGBA to uint16 used in the display
+//
+// Deprecated: use pixel.ToRGB565.
 func RGBATo565(c color.RGBA) uint16 {
-	r, g, b, _ := c.RGBA()
-	return uint16((r & 0xF800) +
-		((g & 0xFC00) >> 5) +
-		((b & 0xF800) >> 11))
+	return pixel.ToRGB565(c)
 }
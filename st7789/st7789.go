@@ -14,10 +14,11 @@ import (
 	"errors"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/pixel"
 )
 
 // Rotation controls the rotation used by the display.
-type Rotation uint8
+type Rotation = drivers.Rotation
 
 // FrameRate controls the frame rate used by the display.
 type FrameRate uint8
@@ -242,13 +243,18 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 
 // setWindow prepares the screen to be modified at a given rectangle
 func (d *Device) setWindow(x, y, w, h int16) {
+	d.setAddress(x, y, w, h)
+	d.Command(RAMWR)
+}
+
+// setAddress sets the rectangle of display memory written or read next.
+func (d *Device) setAddress(x, y, w, h int16) {
 	x += d.columnOffset
 	y += d.rowOffset
 	d.Tx([]uint8{CASET}, true)
 	d.Tx([]uint8{uint8(x >> 8), uint8(x), uint8((x + w - 1) >> 8), uint8(x + w - 1)}, false)
 	d.Tx([]uint8{RASET}, true)
 	d.Tx([]uint8{uint8(y >> 8), uint8(y), uint8((y + h - 1) >> 8), uint8(y + h - 1)}, false)
-	d.Command(RAMWR)
 }
 
 // FillRectangle fills a rectangle at a given coordinates with a color
@@ -259,7 +265,7 @@ func (d *Device) FillRectangle(x, y, width, height int16, c color.RGBA) error {
 		return errors.New("rectangle coordinates outside display area")
 	}
 	d.setWindow(x, y, width, height)
-	c565 := RGBATo565(c)
+	c565 := pixel.ToRGB565(c)
 	c1 := uint8(c565 >> 8)
 	c2 := uint8(c565)
 
@@ -296,15 +302,11 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 	data := make([]uint8, d.batchLength*2)
 	offset := int32(0)
 	for k > 0 {
-		for i := int32(0); i < d.batchLength; i++ {
-			if offset+i < int32(len(buffer)) {
-				c565 := RGBATo565(buffer[offset+i])
-				c1 := uint8(c565 >> 8)
-				c2 := uint8(c565)
-				data[i*2] = c1
-				data[i*2+1] = c2
-			}
+		end := offset + d.batchLength
+		if end > int32(len(buffer)) {
+			end = int32(len(buffer))
 		}
+		pixel.RGB565.Encode(data, buffer[offset:end])
 		if k >= d.batchLength {
 			d.Tx(data, false)
 		} else {
@@ -316,6 +318,58 @@ func (d *Device) FillRectangleWithBuffer(x, y, width, height int16, buffer []col
 	return nil
 }
 
+// DrawRGBBitmap8 copies an RGB565 bitmap to the screen at given coordinates
+func (d *Device) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	k, i := d.Size()
+	if x < 0 || y < 0 || w <= 0 || h <= 0 ||
+		x >= k || (x+w) > k || y >= i || (y+h) > i {
+		return errors.New("rectangle coordinates outside display area")
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errors.New("buffer length does not match with rectangle size")
+	}
+	d.setWindow(x, y, w, h)
+	d.Tx(data, false)
+	return nil
+}
+
+// ReadPixels reads a rectangle of the display memory into buffer, row by
+// row. The SDO pin of the display must be connected to the SDI (MISO) pin of
+// the SPI bus, which many modules do not do.
+func (d *Device) ReadPixels(x, y, width, height int16, buffer []color.RGBA) error {
+	k, i := d.Size()
+	if x < 0 || y < 0 || width <= 0 || height <= 0 ||
+		x >= k || (x+width) > k || y >= i || (y+height) > i {
+		return errors.New("rectangle coordinates outside display area")
+	}
+	if int32(width)*int32(height) != int32(len(buffer)) {
+		return errors.New("buffer length does not match with rectangle size")
+	}
+	d.setAddress(x, y, width, height)
+	d.dcPin.Low()
+	d.csPin.Low()
+	d.bus.Transfer(RAMRD)
+	d.dcPin.High()
+	// A dummy byte comes first, then 3 bytes per pixel with 6 bits per
+	// channel, whatever COLMOD is set to.
+	d.bus.Transfer(0xFF)
+	var rgb [3]uint8
+	for n := range buffer {
+		for c := range rgb {
+			v, _ := d.bus.Transfer(0xFF)
+			rgb[c] = v&0xFC | v>>6
+		}
+		buffer[n] = color.RGBA{rgb[0], rgb[1], rgb[2], 255}
+	}
+	d.csPin.High()
+	return nil
+}
+
+// PixelFormat returns the format of the data passed to DrawRGBBitmap8
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.RGB565
+}
+
 // DrawFastVLine draws a vertical line faster than using SetPixel
 func (d *Device) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
 	if y0 > y1 {
@@ -341,6 +395,11 @@ func (d *Device) FillScreen(c color.RGBA) {
 	}
 }
 
+// GetRotation returns the current rotation of the device
+func (d *Device) GetRotation() Rotation {
+	return d.rotation
+}
+
 // SetRotation changes the rotation of the device (clock-wise)
 func (d *Device) SetRotation(rotation Rotation) {
 	madctl := uint8(0)
@@ -459,9 +518,8 @@ func (d *Device) StopScroll() {
 }
 
 // RGBATo565 converts a color.RGBA to uint16 used in the display
+//
+// Deprecated: use pixel.ToRGB565.
 func RGBATo565(c color.RGBA) uint16 {
-	r, g, b, _ := c.RGBA()
-	return uint16((r & 0xF800) +
-		((g & 0xFC00) >> 5) +
-		((b & 0xF800) >> 11))
+	return pixel.ToRGB565(c)
 }
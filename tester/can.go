@@ -0,0 +1,234 @@
+package tester
+
+import (
+	"errors"
+	"sync"
+
+	"tinygo.org/x/drivers"
+)
+
+// ErrCANBusOff is returned when sending from a node in the bus-off state.
+var ErrCANBusOff = errors.New("CAN node is bus-off")
+
+// CANBus is an in-memory CAN bus connecting any number of nodes.
+//
+// Frames sent by the nodes are kept pending until the bus transmits them,
+// which happens when Transmit is called or a node calls Receive or
+// ErrorState. Pending frames are transmitted in the order of CAN bus
+// arbitration, so the frame with the lowest identifier goes first.
+//
+// Transmission errors can be injected with Corrupt, which updates the error
+// counters of the nodes the way a CAN controller does. A frame that fails is
+// retransmitted until it succeeds or its sender goes bus-off.
+type CANBus struct {
+	// Corrupt is called for every transmission attempt, if set. When it
+	// returns true, the transmission fails with an error.
+	Corrupt func(frame *drivers.CANFrame) bool
+
+	mu    sync.Mutex
+	nodes []*CANNode
+}
+
+// CANNode is a node on an in-memory CAN bus. It implements drivers.CANBus.
+type CANNode struct {
+	bus      *CANBus
+	pending  []drivers.CANFrame
+	received []drivers.CANFrame
+	filters  []drivers.CANFilter
+	txErrors int
+	rxErrors int
+}
+
+// NewCANBus returns a new in-memory CAN bus without nodes.
+func NewCANBus() *CANBus {
+	return &CANBus{}
+}
+
+// NewNode adds a new node to the bus.
+func (bus *CANBus) NewNode() *CANNode {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	n := &CANNode{bus: bus}
+	bus.nodes = append(bus.nodes, n)
+	return n
+}
+
+// Transmit transmits all pending frames.
+func (bus *CANBus) Transmit() {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.transmit()
+}
+
+// transmit transmits pending frames until none are left, or a frame is not
+// acknowledged because there is no other node to receive it.
+func (bus *CANBus) transmit() {
+	for {
+		sender, idx := bus.arbitrate()
+		if sender == nil {
+			return
+		}
+		frame := &sender.pending[idx]
+
+		var receivers []*CANNode
+		for _, n := range bus.nodes {
+			if n != sender && n.state() != drivers.CANBusOff {
+				receivers = append(receivers, n)
+			}
+		}
+		if len(receivers) == 0 {
+			// Acknowledgement error. An error passive node does not count
+			// these, so it keeps trying without going bus-off.
+			if sender.state() == drivers.CANErrorActive {
+				sender.txErrors += 8
+			}
+			return
+		}
+
+		if bus.Corrupt != nil && bus.Corrupt(frame) {
+			sender.txErrors += 8
+			for _, n := range receivers {
+				n.rxErrors++
+			}
+			if sender.state() == drivers.CANBusOff {
+				sender.pending = sender.pending[:0]
+			}
+			continue
+		}
+
+		if sender.txErrors > 0 {
+			sender.txErrors--
+		}
+		for _, n := range receivers {
+			if n.rxErrors > 127 {
+				n.rxErrors = 127
+			} else if n.rxErrors > 0 {
+				n.rxErrors--
+			}
+			if n.accepts(frame) {
+				n.received = append(n.received, *frame)
+			}
+		}
+		sender.pending = append(sender.pending[:idx], sender.pending[idx+1:]...)
+	}
+}
+
+// arbitrate returns the node and the index of the pending frame that wins
+// arbitration, or nil if no frames are pending.
+func (bus *CANBus) arbitrate() (*CANNode, int) {
+	var winner *CANNode
+	var idx int
+	var best uint64
+	for _, n := range bus.nodes {
+		for i := range n.pending {
+			p := priority(&n.pending[i])
+			if winner == nil || p < best {
+				winner, idx, best = n, i, p
+			}
+		}
+	}
+	return winner, idx
+}
+
+// priority returns the arbitration field of the frame as a number. Lower
+// numbers win arbitration: dominant bits are 0.
+func priority(frame *drivers.CANFrame) uint64 {
+	var rtr uint64
+	if frame.Remote {
+		rtr = 1
+	}
+	if !frame.Extended {
+		// base ID, RTR, IDE
+		return uint64(frame.ID&0x7ff)<<21 | rtr<<20
+	}
+	// base ID, SRR, IDE, extended ID, RTR
+	base := uint64(frame.ID>>18) & 0x7ff
+	return base<<21 | 1<<20 | 1<<19 | uint64(frame.ID&0x3ffff)<<1 | rtr
+}
+
+// Send implements drivers.CANBus.
+func (n *CANNode) Send(frame *drivers.CANFrame) error {
+	n.bus.mu.Lock()
+	defer n.bus.mu.Unlock()
+	if n.state() == drivers.CANBusOff {
+		return ErrCANBusOff
+	}
+	n.pending = append(n.pending, *frame)
+	return nil
+}
+
+// Receive implements drivers.CANBus.
+func (n *CANNode) Receive(frame *drivers.CANFrame) (bool, error) {
+	n.bus.mu.Lock()
+	defer n.bus.mu.Unlock()
+	n.bus.transmit()
+	if len(n.received) == 0 {
+		return false, nil
+	}
+	*frame = n.received[0]
+	n.received = n.received[1:]
+	return true, nil
+}
+
+// SetFilters implements drivers.CANBus.
+func (n *CANNode) SetFilters(filters ...drivers.CANFilter) error {
+	n.bus.mu.Lock()
+	defer n.bus.mu.Unlock()
+	n.filters = append(n.filters[:0], filters...)
+	return nil
+}
+
+// ErrorState implements drivers.CANBus.
+func (n *CANNode) ErrorState() (state drivers.CANErrorState, txErrors, rxErrors uint8, err error) {
+	n.bus.mu.Lock()
+	defer n.bus.mu.Unlock()
+	n.bus.transmit()
+	return n.state(), counter(n.txErrors), counter(n.rxErrors), nil
+}
+
+// Recover brings a bus-off node back to the error active state, as a CAN
+// controller does after seeing enough idle bus time.
+func (n *CANNode) Recover() {
+	n.bus.mu.Lock()
+	defer n.bus.mu.Unlock()
+	n.txErrors = 0
+	n.rxErrors = 0
+}
+
+// Pending returns the number of frames waiting for transmission.
+func (n *CANNode) Pending() int {
+	n.bus.mu.Lock()
+	defer n.bus.mu.Unlock()
+	return len(n.pending)
+}
+
+func (n *CANNode) state() drivers.CANErrorState {
+	switch {
+	case n.txErrors > 255:
+		return drivers.CANBusOff
+	case n.txErrors > 127 || n.rxErrors > 127:
+		return drivers.CANErrorPassive
+	default:
+		return drivers.CANErrorActive
+	}
+}
+
+func (n *CANNode) accepts(frame *drivers.CANFrame) bool {
+	if len(n.filters) == 0 {
+		return true
+	}
+	for _, f := range n.filters {
+		if f.Match(frame) {
+			return true
+		}
+	}
+	return false
+}
+
+// counter returns an error counter as read from a CAN controller register.
+func counter(v int) uint8 {
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
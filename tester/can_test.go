@@ -0,0 +1,131 @@
+package tester
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers"
+)
+
+var _ drivers.CANBus = (*CANNode)(nil)
+
+func canFrame(id uint32, extended bool, data ...byte) *drivers.CANFrame {
+	f := &drivers.CANFrame{ID: id, Extended: extended}
+	f.SetPayload(data)
+	return f
+}
+
+func TestCANArbitration(t *testing.T) {
+	c := qt.New(t)
+	bus := NewCANBus()
+	a, b, rx := bus.NewNode(), bus.NewNode(), bus.NewNode()
+
+	c.Assert(a.Send(canFrame(0x200, false, 1)), qt.IsNil)
+	c.Assert(a.Send(canFrame(0x100<<18, true, 2)), qt.IsNil)
+	c.Assert(b.Send(canFrame(0x100, false, 3)), qt.IsNil)
+	c.Assert(b.Send(&drivers.CANFrame{ID: 0x100, Remote: true}), qt.IsNil)
+	c.Assert(b.Send(canFrame(0x080, false, 4)), qt.IsNil)
+	c.Assert(a.Pending(), qt.Equals, 2)
+
+	var ids []uint32
+	var f drivers.CANFrame
+	for {
+		ok, err := rx.Receive(&f)
+		c.Assert(err, qt.IsNil)
+		if !ok {
+			break
+		}
+		ids = append(ids, f.ID)
+	}
+	// Standard data frames win over remote frames and extended frames with
+	// the same base ID.
+	c.Assert(ids, qt.DeepEquals, []uint32{0x080, 0x100, 0x100, 0x100 << 18, 0x200})
+	c.Assert(a.Pending(), qt.Equals, 0)
+
+	// The senders see each others frames.
+	ok, err := a.Receive(&f)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(f.ID, qt.Equals, uint32(0x080))
+	c.Assert(f.Payload(), qt.DeepEquals, []byte{4})
+}
+
+func TestCANFilters(t *testing.T) {
+	c := qt.New(t)
+	bus := NewCANBus()
+	tx, rx := bus.NewNode(), bus.NewNode()
+	c.Assert(rx.SetFilters(
+		drivers.CANFilter{ID: 0x7E8, Mask: 0x7F8},
+		drivers.CANFilter{ID: 0x18DAF100, Mask: 0x1FFFFF00, Extended: true},
+	), qt.IsNil)
+
+	for _, f := range []*drivers.CANFrame{
+		canFrame(0x7DF, false),
+		canFrame(0x7E9, false),
+		canFrame(0x7E9, true),
+		canFrame(0x18DAF110, true),
+	} {
+		c.Assert(tx.Send(f), qt.IsNil)
+	}
+
+	var ids []uint32
+	var f drivers.CANFrame
+	for ok, _ := rx.Receive(&f); ok; ok, _ = rx.Receive(&f) {
+		ids = append(ids, f.ID)
+	}
+	// The base ID of the extended frame is 0x636, so it wins arbitration.
+	c.Assert(ids, qt.DeepEquals, []uint32{0x18DAF110, 0x7E9})
+}
+
+func TestCANErrors(t *testing.T) {
+	c := qt.New(t)
+	bus := NewCANBus()
+	tx := bus.NewNode()
+
+	// Without another node, frames are not acknowledged.
+	c.Assert(tx.Send(canFrame(0x123, false)), qt.IsNil)
+	for i := 0; i < 20; i++ {
+		bus.Transmit()
+	}
+	state, tec, _, err := tx.ErrorState()
+	c.Assert(err, qt.IsNil)
+	c.Assert(state, qt.Equals, drivers.CANErrorPassive)
+	c.Assert(tec, qt.Equals, uint8(128))
+
+	rx := bus.NewNode()
+	bus.Transmit()
+	c.Assert(tx.Pending(), qt.Equals, 0)
+	_, tec, _, _ = tx.ErrorState()
+	c.Assert(tec, qt.Equals, uint8(127))
+
+	// Corrupt the next two transmissions.
+	failures := 2
+	bus.Corrupt = func(*drivers.CANFrame) bool {
+		failures--
+		return failures >= 0
+	}
+	c.Assert(tx.Send(canFrame(0x123, false)), qt.IsNil)
+	var f drivers.CANFrame
+	ok, err := rx.Receive(&f)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ok, qt.IsTrue)
+	_, tec, _, _ = tx.ErrorState()
+	c.Assert(tec, qt.Equals, uint8(127+16-1))
+	_, _, rec, _ := rx.ErrorState()
+	c.Assert(rec, qt.Equals, uint8(1))
+
+	// Continuous errors make the sender go bus-off.
+	bus.Corrupt = func(*drivers.CANFrame) bool { return true }
+	c.Assert(tx.Send(canFrame(0x123, false)), qt.IsNil)
+	state, _, _, _ = tx.ErrorState()
+	c.Assert(state, qt.Equals, drivers.CANBusOff)
+	c.Assert(tx.Pending(), qt.Equals, 0)
+	c.Assert(tx.Send(canFrame(0x123, false)), qt.Equals, ErrCANBusOff)
+
+	bus.Corrupt = nil
+	tx.Recover()
+	state, tec, _, _ = tx.ErrorState()
+	c.Assert(state, qt.Equals, drivers.CANErrorActive)
+	c.Assert(tec, qt.Equals, uint8(0))
+}
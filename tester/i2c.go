@@ -51,6 +51,13 @@ func (bus *I2CBus) Tx(addr uint16, w, r []byte) error {
 	return bus.FindDevice(uint8(addr)).Tx(w, r)
 }
 
+// WriteByte implements I2C.WriteByte. Writing a byte without an address is
+// not supported by the mock bus, so it flags an error.
+func (bus *I2CBus) WriteByte(data byte) error {
+	bus.c.Fatalf("WriteByte(%#x) not supported by mock i2c bus", data)
+	return nil
+}
+
 // FindDevice returns the device with the given address.
 func (bus *I2CBus) FindDevice(addr uint8) I2CDevice {
 	for _, dev := range bus.devices {
@@ -0,0 +1,63 @@
+package tester
+
+import (
+	"image"
+	"image/color"
+)
+
+// Screen is a display in memory, to test drawing code. It implements
+// drivers.Displayer, and records the pixels set and the calls made to it.
+type Screen struct {
+	W, H int16
+
+	// Pixels holds the pixels set inside the display, by position.
+	Pixels map[[2]int16]color.RGBA
+
+	// Drawn is the smallest rectangle holding the pixels set since it was
+	// last reset to the zero value.
+	Drawn image.Rectangle
+
+	// Displays and Reads count the calls to Display and ReadPixels.
+	Displays int
+	Reads    int
+}
+
+// NewScreen returns a Screen of w x h pixels, none of them set.
+func NewScreen(w, h int16) *Screen {
+	return &Screen{W: w, H: h, Pixels: map[[2]int16]color.RGBA{}}
+}
+
+// Size returns the size of the display.
+func (s *Screen) Size() (int16, int16) {
+	return s.W, s.H
+}
+
+// SetPixel sets a pixel, if it is inside the display.
+func (s *Screen) SetPixel(x, y int16, c color.RGBA) {
+	if x < 0 || y < 0 || x >= s.W || y >= s.H {
+		return
+	}
+	s.Pixels[[2]int16{x, y}] = c
+	s.Drawn = s.Drawn.Union(image.Rect(int(x), int(y), int(x)+1, int(y)+1))
+}
+
+// Display counts the calls made to it.
+func (s *Screen) Display() error {
+	s.Displays++
+	return nil
+}
+
+// At returns the color of a pixel, the zero value if it was never set.
+func (s *Screen) At(x, y int16) color.RGBA {
+	return s.Pixels[[2]int16{x, y}]
+}
+
+// ReadPixels reads the pixels of a rectangle of the display in buffer, row
+// by row.
+func (s *Screen) ReadPixels(x, y, width, height int16, buffer []color.RGBA) error {
+	s.Reads++
+	for i := range buffer[:int(width)*int(height)] {
+		buffer[i] = s.At(x+int16(i%int(width)), y+int16(i/int(width)))
+	}
+	return nil
+}
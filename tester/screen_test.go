@@ -0,0 +1,35 @@
+package tester
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers"
+)
+
+var _ drivers.Displayer = (*Screen)(nil)
+
+func TestScreen(t *testing.T) {
+	c := qt.New(t)
+	red := color.RGBA{255, 0, 0, 255}
+	s := NewScreen(4, 3)
+	s.SetPixel(1, 1, red)
+	s.SetPixel(3, 2, red)
+	s.SetPixel(4, 0, red)
+	s.SetPixel(0, -1, red)
+	c.Assert(s.Pixels, qt.HasLen, 2)
+	c.Assert(s.Drawn, qt.Equals, image.Rect(1, 1, 4, 3))
+	c.Assert(s.At(1, 1), qt.Equals, red)
+	c.Assert(s.At(0, 0), qt.Equals, color.RGBA{})
+
+	buf := make([]color.RGBA, 4)
+	c.Assert(s.ReadPixels(1, 1, 2, 2, buf), qt.IsNil)
+	c.Assert(buf, qt.DeepEquals, []color.RGBA{red, {}, {}, {}})
+	c.Assert(s.Reads, qt.Equals, 1)
+
+	c.Assert(s.Display(), qt.IsNil)
+	c.Assert(s.Displays, qt.Equals, 1)
+}
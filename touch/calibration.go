@@ -0,0 +1,288 @@
+package touch
+
+import (
+	"encoding/binary"
+	"errors"
+	"image/color"
+	"time"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
+)
+
+var (
+	ErrCalibrationPoints = errors.New("touch calibration needs at least 3 points, not on a line")
+	ErrCalibrationData   = errors.New("invalid touch calibration data")
+)
+
+// Calibration maps the raw coordinates of a touch screen, such as the ADC
+// values of resistive panels, to display coordinates with an affine
+// transform, which corrects the scale, offset, rotation and skew of the
+// panel:
+//
+//	x = (A*rawX + B*rawY + C) / 65536
+//	y = (D*rawX + E*rawY + F) / 65536
+type Calibration struct {
+	A, B, C, D, E, F int32
+}
+
+// Calibrate computes the calibration from touches of known targets: raw
+// holds the points read at the display points screen. With 3 points the
+// transform is exact, with more, such as the 5 points of CalibrationTargets,
+// it is a least squares fit which averages out the reading errors.
+func Calibrate(raw, screen []Point) (Calibration, error) {
+	if len(raw) < 3 || len(raw) != len(screen) {
+		return Calibration{}, ErrCalibrationPoints
+	}
+	// Solve the normal equations M*[A B C] = V for x and y, where M is the
+	// sum of [rx ry 1]^T*[rx ry 1]. Raw values are centered on their mean to
+	// keep the numbers small.
+	var mx, my float64
+	for _, p := range raw {
+		mx += float64(p.X)
+		my += float64(p.Y)
+	}
+	n := float64(len(raw))
+	mx, my = mx/n, my/n
+
+	var m [3][3]float64
+	var vx, vy [3]float64
+	for i, p := range raw {
+		r := [3]float64{float64(p.X) - mx, float64(p.Y) - my, 1}
+		for j := range r {
+			for k := range r {
+				m[j][k] += r[j] * r[k]
+			}
+			vx[j] += r[j] * float64(screen[i].X)
+			vy[j] += r[j] * float64(screen[i].Y)
+		}
+	}
+	ax, ok := solve3(m, vx)
+	if !ok {
+		return Calibration{}, ErrCalibrationPoints
+	}
+	ay, _ := solve3(m, vy)
+
+	// Undo the centering: a*(rx-mx) + b*(ry-my) + c.
+	ax[2] -= ax[0]*mx + ax[1]*my
+	ay[2] -= ay[0]*mx + ay[1]*my
+	fixed := func(v float64) int32 {
+		v *= 65536
+		if v < 0 {
+			return int32(v - 0.5)
+		}
+		return int32(v + 0.5)
+	}
+	return Calibration{
+		A: fixed(ax[0]), B: fixed(ax[1]), C: fixed(ax[2]),
+		D: fixed(ay[0]), E: fixed(ay[1]), F: fixed(ay[2]),
+	}, nil
+}
+
+// solve3 solves m*x = v with Cramer's rule.
+func solve3(m [3][3]float64, v [3]float64) (x [3]float64, ok bool) {
+	det := func(m [3][3]float64) float64 {
+		return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+			m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+			m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+	}
+	d := det(m)
+	scale := m[0][0]*m[1][1]*m[2][2] + 1
+	if d == 0 || d/scale < 1e-9 && d/scale > -1e-9 {
+		return x, false
+	}
+	for i := range x {
+		mi := m
+		for j := range mi {
+			mi[j][i] = v[j]
+		}
+		x[i] = det(mi) / d
+	}
+	return x, true
+}
+
+// Transform returns the display coordinates of a raw point. Z is unchanged.
+func (c *Calibration) Transform(p Point) Point {
+	x, y := int64(p.X), int64(p.Y)
+	return Point{
+		X: int((int64(c.A)*x + int64(c.B)*y + int64(c.C) + 1<<15) >> 16),
+		Y: int((int64(c.D)*x + int64(c.E)*y + int64(c.F) + 1<<15) >> 16),
+		Z: p.Z,
+	}
+}
+
+// calibrationMagic starts the binary form of a Calibration, so erased or
+// unrelated EEPROM or flash content is rejected.
+var calibrationMagic = [2]byte{'T', 'C'}
+
+// MarshalBinary encodes the calibration in 26 bytes, to store it in EEPROM
+// or flash.
+func (c Calibration) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 26)
+	copy(b, calibrationMagic[:])
+	for i, v := range [...]int32{c.A, c.B, c.C, c.D, c.E, c.F} {
+		binary.BigEndian.PutUint32(b[2+4*i:], uint32(v))
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes a calibration encoded by MarshalBinary.
+func (c *Calibration) UnmarshalBinary(b []byte) error {
+	if len(b) < 26 || b[0] != calibrationMagic[0] || b[1] != calibrationMagic[1] {
+		return ErrCalibrationData
+	}
+	var v [6]int32
+	for i := range v {
+		v[i] = int32(binary.BigEndian.Uint32(b[2+4*i:]))
+	}
+	if v[0] == 0 && v[1] == 0 || v[3] == 0 && v[4] == 0 {
+		return ErrCalibrationData
+	}
+	*c = Calibration{v[0], v[1], v[2], v[3], v[4], v[5]}
+	return nil
+}
+
+// CalibrationTargets returns the display points to touch for a calibration
+// with 3 or 5 points, placed at 10% from the edges of a width*height
+// display.
+func CalibrationTargets(width, height int16, points int) []Point {
+	w, h := int(width), int(height)
+	x0, y0, x1, y1 := w/10, h/10, w-1-w/10, h-1-h/10
+	if points < 5 {
+		return []Point{{X: x0, Y: y0}, {X: x1, Y: h / 2}, {X: w / 2, Y: y1}}
+	}
+	return []Point{{X: x0, Y: y0}, {X: x1, Y: y0}, {X: x1, Y: y1}, {X: x0, Y: y1}, {X: w / 2, Y: h / 2}}
+}
+
+// Calibrated is a Pointer returning the points of another one in display
+// coordinates. The calibration is done with the display at Rotation0, and
+// the points follow the rotation set with SetRotation. Points that are not
+// touched (Z <= 0) are returned unchanged.
+type Calibrated struct {
+	Pointer     Pointer
+	Calibration Calibration
+
+	width, height int16
+	rotation      drivers.Rotation
+}
+
+// NewCalibrated returns p calibrated for a width*height display, in its
+// Rotation0 orientation.
+func NewCalibrated(p Pointer, c Calibration, width, height int16) *Calibrated {
+	return &Calibrated{Pointer: p, Calibration: c, width: width, height: height}
+}
+
+// ReadTouchPoint reads a point and maps it to display coordinates.
+func (c *Calibrated) ReadTouchPoint() Point {
+	p := c.Pointer.ReadTouchPoint()
+	if p.Z <= 0 {
+		return p
+	}
+	return c.transform(p)
+}
+
+// ReadTouches reads the touches of a MultiPointer and maps them to display
+// coordinates. If the calibrated Pointer is no MultiPointer, its point is
+// returned as the touch 0.
+func (c *Calibrated) ReadTouches(touches []Touch) int {
+	m, ok := c.Pointer.(MultiPointer)
+	if !ok {
+		p := c.ReadTouchPoint()
+		if p.Z <= 0 || len(touches) == 0 {
+			return 0
+		}
+		touches[0] = Touch{Point: p}
+		return 1
+	}
+	n := m.ReadTouches(touches)
+	for i := range touches[:n] {
+		touches[i].Point = c.transform(touches[i].Point)
+	}
+	return n
+}
+
+func (c *Calibrated) transform(p Point) Point {
+	p = c.Calibration.Transform(p)
+	w, h := int(c.width), int(c.height)
+	switch c.rotation {
+	case drivers.Rotation90:
+		p.X, p.Y = p.Y, w-1-p.X
+	case drivers.Rotation180:
+		p.X, p.Y = w-1-p.X, h-1-p.Y
+	case drivers.Rotation270:
+		p.X, p.Y = h-1-p.Y, p.X
+	}
+	return p
+}
+
+// GetRotation returns the rotation of the display.
+func (c *Calibrated) GetRotation() drivers.Rotation {
+	return c.rotation
+}
+
+// SetRotation sets the rotation of the display, clockwise like
+// drivers.Rotator.
+func (c *Calibrated) SetRotation(rotation drivers.Rotation) {
+	c.rotation = rotation % 4
+}
+
+// CalibrateDisplay runs an interactive calibration: it draws a cross at
+// every target of CalibrationTargets on d, waits for it to be touched and
+// released, and computes the calibration from the points read from p. p
+// returns raw points, usually through a Filter, and d must be at Rotation0.
+func CalibrateDisplay(d drivers.Displayer, p Pointer, points int) (Calibration, error) {
+	w, h := d.Size()
+	targets := CalibrationTargets(w, h, points)
+	raw := make([]Point, len(targets))
+	fill := display.NewDrawer(d)
+	for i, t := range targets {
+		fill.FillRectangle(0, 0, w, h, black)
+		drawCross(d, t, white)
+		if err := d.Display(); err != nil {
+			return Calibration{}, err
+		}
+		raw[i] = waitTouch(p)
+	}
+	fill.FillRectangle(0, 0, w, h, black)
+	if err := d.Display(); err != nil {
+		return Calibration{}, err
+	}
+	return Calibrate(raw, targets)
+}
+
+var (
+	black = color.RGBA{0, 0, 0, 255}
+	white = color.RGBA{255, 255, 255, 255}
+)
+
+func drawCross(d drivers.Displayer, p Point, c color.RGBA) {
+	x, y := int16(p.X), int16(p.Y)
+	for i := int16(-6); i <= 6; i++ {
+		d.SetPixel(x+i, y, c)
+		d.SetPixel(x, y+i, c)
+	}
+}
+
+// waitTouch returns the average of the first readings of a touch, once it is
+// released.
+func waitTouch(p Pointer) Point {
+	const samples = 8
+	var sum Point
+	n := 0
+	for {
+		t := p.ReadTouchPoint()
+		switch {
+		case t.Z > 0 && n < samples:
+			sum.X += t.X
+			sum.Y += t.Y
+			sum.Z += t.Z
+			n++
+		case t.Z <= 0 && n == samples:
+			return Point{X: sum.X / n, Y: sum.Y / n, Z: sum.Z / n}
+		case t.Z <= 0:
+			// Released too early, start again.
+			sum, n = Point{}, 0
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,158 @@
+package touch
+
+import (
+	"image/color"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers"
+)
+
+// panel simulates a resistive panel of a 240x320 display, with the axes
+// swapped and inverted, returning 16 bit raw values.
+func panel(x, y int) Point {
+	return Point{X: 60000 - y*170, Y: 4000 + x*230, Z: 1000}
+}
+
+// script is a Pointer returning recorded points, then untouched ones.
+type script []Point
+
+func (s *script) ReadTouchPoint() Point {
+	if len(*s) == 0 {
+		return Point{}
+	}
+	p := (*s)[0]
+	*s = (*s)[1:]
+	return p
+}
+
+func TestCalibrate(t *testing.T) {
+	c := qt.New(t)
+	targets := CalibrationTargets(240, 320, 3)
+	raw := make([]Point, len(targets))
+	for i, p := range targets {
+		raw[i] = panel(p.X, p.Y)
+	}
+	cal, err := Calibrate(raw, targets)
+	c.Assert(err, qt.IsNil)
+	for _, p := range []Point{{X: 0, Y: 0}, {X: 239, Y: 319}, {X: 120, Y: 17}} {
+		c.Assert(cal.Transform(panel(p.X, p.Y)), qt.Equals, Point{X: p.X, Y: p.Y, Z: 1000})
+	}
+
+	// 5 points with reading errors.
+	targets = CalibrationTargets(240, 320, 5)
+	raw = raw[:0]
+	for i, p := range targets {
+		r := panel(p.X, p.Y)
+		r.X += []int{90, -120, 60, 0, -30}[i]
+		r.Y += []int{-100, 40, 0, 150, -90}[i]
+		raw = append(raw, r)
+	}
+	cal, err = Calibrate(raw, targets)
+	c.Assert(err, qt.IsNil)
+	p := cal.Transform(panel(100, 200))
+	c.Assert(p.X >= 99 && p.X <= 101 && p.Y >= 199 && p.Y <= 201, qt.IsTrue, qt.Commentf("%v", p))
+
+	// Points on a line.
+	_, err = Calibrate([]Point{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}}, targets[:3])
+	c.Assert(err, qt.Equals, ErrCalibrationPoints)
+	_, err = Calibrate(raw[:2], targets[:2])
+	c.Assert(err, qt.Equals, ErrCalibrationPoints)
+}
+
+func TestCalibrationBinary(t *testing.T) {
+	c := qt.New(t)
+	cal := Calibration{A: 1, B: -2, C: 3, D: -4, E: 5, F: -6}
+	b, err := cal.MarshalBinary()
+	c.Assert(err, qt.IsNil)
+	c.Assert(b, qt.HasLen, 26)
+
+	var got Calibration
+	c.Assert(got.UnmarshalBinary(b), qt.IsNil)
+	c.Assert(got, qt.Equals, cal)
+
+	// Erased flash.
+	erased := make([]byte, 26)
+	for i := range erased {
+		erased[i] = 0xff
+	}
+	c.Assert(got.UnmarshalBinary(erased), qt.Equals, ErrCalibrationData)
+	c.Assert(got.UnmarshalBinary(b[:10]), qt.Equals, ErrCalibrationData)
+}
+
+func TestCalibratedRotation(t *testing.T) {
+	c := qt.New(t)
+	cal := Calibration{A: 65536, E: 65536} // identity
+	for _, tc := range []struct {
+		rotation drivers.Rotation
+		want     Point
+	}{
+		{drivers.Rotation0, Point{X: 10, Y: 20, Z: 1}},
+		{drivers.Rotation90, Point{X: 20, Y: 229, Z: 1}},
+		{drivers.Rotation180, Point{X: 229, Y: 299, Z: 1}},
+		{drivers.Rotation270, Point{X: 299, Y: 10, Z: 1}},
+	} {
+		s := script{{X: 10, Y: 20, Z: 1}, {}}
+		p := NewCalibrated(&s, cal, 240, 320)
+		p.SetRotation(tc.rotation)
+		c.Assert(p.ReadTouchPoint(), qt.Equals, tc.want, qt.Commentf("rotation %d", tc.rotation))
+		c.Assert(p.ReadTouchPoint(), qt.Equals, Point{})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	c := qt.New(t)
+
+	// The median removes a spike, and low pressures are not touches.
+	s := script{
+		{X: 100, Y: 100, Z: 50}, {X: 9000, Y: 0, Z: 50}, {X: 102, Y: 98, Z: 60},
+		{X: 100, Y: 100, Z: 5}, {X: 100, Y: 100, Z: 5}, {X: 100, Y: 100, Z: 50},
+	}
+	f := Filter{Pointer: &s, MinPressure: 10, Median: 3}
+	c.Assert(f.ReadTouchPoint(), qt.Equals, Point{X: 102, Y: 98, Z: 50})
+	c.Assert(f.ReadTouchPoint(), qt.Equals, Point{})
+
+	// A touch needs 2 readings to start and end, a glitch keeps the last
+	// position.
+	s = script{{X: 10, Y: 10, Z: 1}, {X: 20, Y: 20, Z: 1}, {}, {X: 30, Y: 30, Z: 1}, {}, {}}
+	f = Filter{Pointer: &s, Debounce: 2}
+	var got []Point
+	for i := 0; i < 6; i++ {
+		got = append(got, f.ReadTouchPoint())
+	}
+	c.Assert(got, qt.DeepEquals, []Point{
+		{}, {X: 20, Y: 20, Z: 1}, {X: 20, Y: 20, Z: 1}, {X: 30, Y: 30, Z: 1}, {X: 30, Y: 30, Z: 1}, {},
+	})
+
+	// Smoothing starts from the first point of a touch.
+	s = script{{X: 100, Y: 100, Z: 1}, {X: 200, Y: 0, Z: 1}}
+	f = Filter{Pointer: &s, Smoothing: 192}
+	c.Assert(f.ReadTouchPoint(), qt.Equals, Point{X: 100, Y: 100, Z: 1})
+	c.Assert(f.ReadTouchPoint(), qt.Equals, Point{X: 125, Y: 75, Z: 1})
+}
+
+// screen is a display that ignores what is drawn.
+type screen struct{ displays int }
+
+func (s *screen) Size() (int16, int16)              { return 240, 320 }
+func (s *screen) SetPixel(x, y int16, c color.RGBA) {}
+func (s *screen) Display() error                    { s.displays++; return nil }
+
+func TestCalibrateDisplay(t *testing.T) {
+	c := qt.New(t)
+	var s script
+	for _, p := range CalibrationTargets(240, 320, 5) {
+		// A short touch is ignored.
+		s = append(s, Point{X: 1, Y: 1, Z: 1}, Point{})
+		for i := 0; i < 10; i++ {
+			s = append(s, panel(p.X, p.Y))
+		}
+		s = append(s, Point{})
+	}
+	d := &screen{}
+	cal, err := CalibrateDisplay(d, &s, 5)
+	c.Assert(err, qt.IsNil)
+	c.Assert(d.displays, qt.Equals, 6)
+	c.Assert(cal.Transform(panel(50, 60)), qt.Equals, Point{X: 50, Y: 60, Z: 1000})
+}
@@ -0,0 +1,103 @@
+package touch
+
+// Filter is a Pointer cleaning up the points of a noisy touch screen, such as
+// a resistive panel. Its zero value with Pointer set passes points through.
+type Filter struct {
+	// Pointer is the filtered touch screen.
+	Pointer Pointer
+
+	// MinPressure is the Z value below which the screen is not touched.
+	MinPressure int
+
+	// Median is the number of points read for every point returned, which
+	// is their median. It removes spikes, and is at most 5.
+	Median int
+
+	// Smoothing is the weight of the previous position in the returned one,
+	// from 0 (no smoothing) to 255 (very slow moves), to remove jitter.
+	Smoothing uint8
+
+	// Debounce is the number of successive readings needed to start or end
+	// a touch, so short glitches of the pressure are ignored.
+	Debounce int
+
+	touching bool
+	changes  int   // successive readings with the other touch state
+	last     Point // last returned touched point
+	samples  [5]Point
+}
+
+// ReadTouchPoint reads and filters a point. Z is 0 if the screen is not
+// touched.
+func (f *Filter) ReadTouchPoint() Point {
+	p, touched := f.read()
+	if touched != f.touching {
+		f.changes++
+		if f.changes >= f.Debounce {
+			f.touching = touched
+			f.changes = 0
+			if touched {
+				f.last = p
+			}
+		}
+	} else {
+		f.changes = 0
+	}
+	if !f.touching {
+		return Point{}
+	}
+	if touched {
+		// Exponential smoothing of the position, in 1/256 steps.
+		s := int(f.Smoothing)
+		f.last = Point{
+			X: (f.last.X*s + p.X*(256-s) + 128) >> 8,
+			Y: (f.last.Y*s + p.Y*(256-s) + 128) >> 8,
+			Z: p.Z,
+		}
+	}
+	// A touch being released keeps its last position.
+	return f.last
+}
+
+// read reads Median points, and returns the median of the touched ones if
+// most of them are.
+func (f *Filter) read() (Point, bool) {
+	n := f.Median
+	if n < 1 {
+		n = 1
+	}
+	if n > len(f.samples) {
+		n = len(f.samples)
+	}
+	touched := 0
+	for i := 0; i < n; i++ {
+		p := f.Pointer.ReadTouchPoint()
+		if p.Z > 0 && p.Z >= f.MinPressure {
+			f.samples[touched] = p
+			touched++
+		}
+	}
+	if touched == 0 || 2*touched <= n && n > 1 {
+		return Point{}, false
+	}
+	s := f.samples[:touched]
+	return Point{
+		X: median(s, func(p *Point) int { return p.X }),
+		Y: median(s, func(p *Point) int { return p.Y }),
+		Z: median(s, func(p *Point) int { return p.Z }),
+	}, true
+}
+
+// median returns the median of a coordinate of a few points.
+func median(points []Point, get func(*Point) int) int {
+	var v [5]int
+	for i := range points {
+		x := get(&points[i])
+		j := i
+		for ; j > 0 && v[j-1] > x; j-- {
+			v[j] = v[j-1]
+		}
+		v[j] = x
+	}
+	return v[len(points)/2]
+}
@@ -0,0 +1,256 @@
+package touch
+
+import "time"
+
+// GestureType is the kind of a Gesture.
+type GestureType uint8
+
+const (
+	// Tap is a short touch that did not move.
+	Tap GestureType = iota + 1
+
+	// LongPress is a touch held without moving. It is reported while the
+	// touch is still down.
+	LongPress
+
+	// Swipe is a single touch moved and lifted.
+	Swipe
+
+	// Pinch is two touches moving closer or apart. It is reported on every
+	// move of the touches.
+	Pinch
+)
+
+// Direction is the main direction of a swipe.
+type Direction uint8
+
+const (
+	DirectionNone Direction = iota
+	DirectionLeft
+	DirectionRight
+	DirectionUp
+	DirectionDown
+)
+
+// Gesture is a gesture recognized by a GestureRecognizer.
+type Gesture struct {
+	Type GestureType
+
+	// X and Y are the position of a tap or long press, the start of a swipe
+	// and the middle of a pinch.
+	X, Y int
+
+	// DX and DY are the move of a swipe.
+	DX, DY    int
+	Direction Direction
+
+	// Scale is the distance between the touches of a pinch, in percent of
+	// their distance when it started.
+	Scale int
+}
+
+// GestureRecognizer recognizes gestures in touch events. Its zero value uses
+// the default settings below, which suit display coordinates: events of raw
+// touch screens should be calibrated first.
+type GestureRecognizer struct {
+	// TapDistance is the move after which a touch is no tap or long press.
+	// The default is 10.
+	TapDistance int
+
+	// SwipeDistance is the shortest move of a swipe. The default is 40.
+	SwipeDistance int
+
+	// LongPress is the time a touch is held for a long press. The default
+	// is 500ms.
+	LongPress time.Duration
+
+	down     int // touches down
+	start    Event
+	moved    bool // too far for a tap
+	long     bool // long press reported
+	pinch    bool
+	tracked  int // touches in ids and pos
+	ids      [2]uint8
+	pos      [2]Point
+	distance int // of the touches when the pinch started
+	scale    int // last reported scale
+}
+
+func (r *GestureRecognizer) tapDistance() int {
+	if r.TapDistance == 0 {
+		return 10
+	}
+	return r.TapDistance
+}
+
+func (r *GestureRecognizer) swipeDistance() int {
+	if r.SwipeDistance == 0 {
+		return 40
+	}
+	return r.SwipeDistance
+}
+
+func (r *GestureRecognizer) longPress() time.Duration {
+	if r.LongPress == 0 {
+		return 500 * time.Millisecond
+	}
+	return r.LongPress
+}
+
+// Event processes a touch event, and returns the gesture it completes, if
+// any.
+func (r *GestureRecognizer) Event(e Event) (Gesture, bool) {
+	switch e.Type {
+	case Down:
+		r.down++
+		if r.down == 1 {
+			r.start = e
+			r.moved, r.long, r.pinch = false, false, false
+			r.tracked = 0
+		}
+		r.track(e)
+	case Move:
+		if i := r.index(e.ID); i >= 0 {
+			r.pos[i] = e.Point
+		}
+		if r.pinch {
+			return r.movePinch(e)
+		}
+		if r.down == 1 && e.ID == r.start.ID {
+			if distance(e.Point, r.start.Point) > r.tapDistance() {
+				r.moved = true
+			}
+			return r.Tick(e.Time)
+		}
+	case Up:
+		if r.down > 0 {
+			r.down--
+		}
+		r.untrack(e.ID)
+		if r.pinch || r.down > 0 || e.ID != r.start.ID {
+			return Gesture{}, false
+		}
+		return r.release(e)
+	}
+	return Gesture{}, false
+}
+
+// Tick reports a long press once a touch has been held long enough. It
+// should be called regularly, as touches held still send no events.
+func (r *GestureRecognizer) Tick(now time.Duration) (Gesture, bool) {
+	if r.down != 1 || r.pinch || r.moved || r.long || now-r.start.Time < r.longPress() {
+		return Gesture{}, false
+	}
+	r.long = true
+	return Gesture{Type: LongPress, X: r.start.X, Y: r.start.Y}, true
+}
+
+func (r *GestureRecognizer) release(e Event) (Gesture, bool) {
+	if r.long {
+		return Gesture{}, false
+	}
+	if !r.moved {
+		if e.Time-r.start.Time >= r.longPress() {
+			return Gesture{Type: LongPress, X: r.start.X, Y: r.start.Y}, true
+		}
+		return Gesture{Type: Tap, X: r.start.X, Y: r.start.Y}, true
+	}
+	dx, dy := e.X-r.start.X, e.Y-r.start.Y
+	if distance(e.Point, r.start.Point) < r.swipeDistance() {
+		return Gesture{}, false
+	}
+	g := Gesture{Type: Swipe, X: r.start.X, Y: r.start.Y, DX: dx, DY: dy}
+	switch {
+	case abs(dx) >= abs(dy) && dx < 0:
+		g.Direction = DirectionLeft
+	case abs(dx) >= abs(dy):
+		g.Direction = DirectionRight
+	case dy < 0:
+		g.Direction = DirectionUp
+	default:
+		g.Direction = DirectionDown
+	}
+	return g, true
+}
+
+// track adds a touch to the two followed for pinches, and starts a pinch
+// when there are two.
+func (r *GestureRecognizer) track(e Event) {
+	if r.tracked == 2 {
+		return
+	}
+	r.ids[r.tracked], r.pos[r.tracked] = e.ID, e.Point
+	r.tracked++
+	if r.tracked == 2 {
+		r.pinch = true
+		r.distance = distance(r.pos[0], r.pos[1])
+		r.scale = 100
+	}
+}
+
+// untrack removes a lifted touch from the followed ones.
+func (r *GestureRecognizer) untrack(id uint8) {
+	i := r.index(id)
+	if i < 0 {
+		return
+	}
+	if i == 0 {
+		r.ids[0], r.pos[0] = r.ids[1], r.pos[1]
+	}
+	r.tracked--
+}
+
+// index returns the index of a followed touch in ids, or -1.
+func (r *GestureRecognizer) index(id uint8) int {
+	for i := 0; i < r.tracked; i++ {
+		if r.ids[i] == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *GestureRecognizer) movePinch(e Event) (Gesture, bool) {
+	if r.index(e.ID) < 0 {
+		// A touch not followed yet replaces a lifted one.
+		r.track(e)
+		return Gesture{}, false
+	}
+	if r.tracked < 2 || r.distance == 0 {
+		return Gesture{}, false
+	}
+	scale := distance(r.pos[0], r.pos[1]) * 100 / r.distance
+	if scale == r.scale {
+		return Gesture{}, false
+	}
+	r.scale = scale
+	return Gesture{
+		Type:  Pinch,
+		X:     (r.pos[0].X + r.pos[1].X) / 2,
+		Y:     (r.pos[0].Y + r.pos[1].Y) / 2,
+		Scale: scale,
+	}, true
+}
+
+// distance returns the distance between two points, rounded down.
+func distance(a, b Point) int {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	return isqrt(dx*dx + dy*dy)
+}
+
+func isqrt(n int) int {
+	x := n
+	y := (x + 1) / 2
+	for y < x {
+		x = y
+		y = (x + n/x) / 2
+	}
+	return x
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,130 @@
+package touch
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+// reading is a recorded reading of a MultiPointer.
+type reading struct {
+	ms      int
+	touches []Touch
+}
+
+// trace is a MultiPointer replaying recorded readings.
+type trace struct {
+	readings []reading
+}
+
+func (t *trace) ReadTouches(touches []Touch) int {
+	n := copy(touches, t.readings[0].touches)
+	t.readings = t.readings[1:]
+	return n
+}
+
+func at(id uint8, x, y int) Touch {
+	return Touch{ID: id, Point: Point{X: x, Y: y, Z: 1}}
+}
+
+// recognize replays readings through a Tracker and a GestureRecognizer, and
+// returns the gestures.
+func recognize(readings ...reading) []Gesture {
+	t := &trace{readings: readings}
+	var tracker Tracker
+	var r GestureRecognizer
+	var gestures []Gesture
+	for len(t.readings) > 0 {
+		now := time.Duration(t.readings[0].ms) * time.Millisecond
+		for _, e := range tracker.Poll(t, now, nil) {
+			if g, ok := r.Event(e); ok {
+				gestures = append(gestures, g)
+			}
+		}
+		if g, ok := r.Tick(now); ok {
+			gestures = append(gestures, g)
+		}
+	}
+	return gestures
+}
+
+func TestTracker(t *testing.T) {
+	c := qt.New(t)
+	var tracker Tracker
+	events := tracker.Update([]Touch{at(0, 10, 10)}, 0, nil)
+	events = tracker.Update([]Touch{at(0, 10, 10), at(1, 50, 50)}, 1, events)
+	events = tracker.Update([]Touch{at(1, 60, 50)}, 2, events)
+	events = tracker.Update(nil, 3, events)
+	c.Assert(events, qt.DeepEquals, []Event{
+		{Type: Down, Touch: at(0, 10, 10), Time: 0},
+		{Type: Down, Touch: at(1, 50, 50), Time: 1},
+		{Type: Up, Touch: at(0, 10, 10), Time: 2},
+		{Type: Move, Touch: at(1, 60, 50), Time: 2},
+		{Type: Up, Touch: at(1, 60, 50), Time: 3},
+	})
+}
+
+func TestGestures(t *testing.T) {
+	c := qt.New(t)
+
+	// A tap with a little jitter.
+	c.Assert(recognize(
+		reading{0, []Touch{at(0, 100, 100)}},
+		reading{20, []Touch{at(0, 102, 99)}},
+		reading{40, nil},
+	), qt.DeepEquals, []Gesture{{Type: Tap, X: 100, Y: 100}})
+
+	// A long press is reported while held.
+	c.Assert(recognize(
+		reading{0, []Touch{at(0, 100, 100)}},
+		reading{300, []Touch{at(0, 100, 100)}},
+		reading{600, []Touch{at(0, 101, 100)}},
+		reading{900, []Touch{at(0, 101, 100)}},
+		reading{950, nil},
+	), qt.DeepEquals, []Gesture{{Type: LongPress, X: 100, Y: 100}})
+
+	// A swipe to the left.
+	c.Assert(recognize(
+		reading{0, []Touch{at(0, 200, 100)}},
+		reading{20, []Touch{at(0, 150, 105)}},
+		reading{40, []Touch{at(0, 80, 110)}},
+		reading{60, nil},
+	), qt.DeepEquals, []Gesture{{Type: Swipe, X: 200, Y: 100, DX: -120, DY: 10, Direction: DirectionLeft}})
+
+	// A short move is neither a tap nor a swipe.
+	c.Assert(recognize(
+		reading{0, []Touch{at(0, 100, 100)}},
+		reading{20, []Touch{at(0, 100, 120)}},
+		reading{40, nil},
+	), qt.HasLen, 0)
+
+	// A pinch zooming in, the second touch lifted first.
+	c.Assert(recognize(
+		reading{0, []Touch{at(0, 100, 100)}},
+		reading{20, []Touch{at(0, 100, 100), at(1, 140, 100)}},
+		reading{40, []Touch{at(0, 90, 100), at(1, 150, 100)}},
+		reading{60, []Touch{at(0, 80, 100), at(1, 160, 100)}},
+		reading{80, []Touch{at(0, 80, 100)}},
+		reading{100, nil},
+	), qt.DeepEquals, []Gesture{
+		{Type: Pinch, X: 115, Y: 100, Scale: 125},
+		{Type: Pinch, X: 120, Y: 100, Scale: 150},
+		{Type: Pinch, X: 115, Y: 100, Scale: 175},
+		{Type: Pinch, X: 120, Y: 100, Scale: 200},
+	})
+
+	// The first touch lifted and a new one put down: the pinch follows the
+	// remaining and the new touch.
+	c.Assert(recognize(
+		reading{0, []Touch{at(0, 100, 100)}},
+		reading{20, []Touch{at(0, 100, 100), at(1, 140, 100)}},
+		reading{40, []Touch{at(1, 140, 100)}},
+		reading{60, []Touch{at(1, 140, 100), at(2, 100, 100)}},
+		reading{80, []Touch{at(1, 150, 100), at(2, 90, 100)}},
+		reading{100, nil},
+	), qt.DeepEquals, []Gesture{
+		{Type: Pinch, X: 125, Y: 100, Scale: 125},
+		{Type: Pinch, X: 120, Y: 100, Scale: 150},
+	})
+}
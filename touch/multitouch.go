@@ -0,0 +1,90 @@
+package touch
+
+import "time"
+
+// Touch is one of the points touching a screen. The ID of a touch stays the
+// same from the moment it touches the screen until it is lifted.
+type Touch struct {
+	ID uint8
+	Point
+}
+
+// MultiPointer is a touch screen that can read several touches at once.
+type MultiPointer interface {
+	// ReadTouches reads the current touches into touches, and returns their
+	// number.
+	ReadTouches(touches []Touch) int
+}
+
+// EventType is the kind of an Event.
+type EventType uint8
+
+const (
+	// Down is a new touch.
+	Down EventType = iota
+
+	// Move is a touch moving.
+	Move
+
+	// Up is a touch being lifted, at its last position.
+	Up
+)
+
+// Event is a change of a touch.
+type Event struct {
+	Type EventType
+	Touch
+
+	// Time of the event, from any fixed origin.
+	Time time.Duration
+}
+
+// MaxTouches is the number of touches followed by a Tracker.
+const MaxTouches = 5
+
+// Tracker turns successive readings of a MultiPointer into events.
+type Tracker struct {
+	touches [MaxTouches]Touch
+	n       int
+}
+
+// Update compares touches with the previous ones, and appends the Down, Move
+// and Up events to events.
+func (t *Tracker) Update(touches []Touch, now time.Duration, events []Event) []Event {
+	if len(touches) > MaxTouches {
+		touches = touches[:MaxTouches]
+	}
+	// Lifted touches.
+	for _, old := range t.touches[:t.n] {
+		if _, ok := find(touches, old.ID); !ok {
+			events = append(events, Event{Type: Up, Touch: old, Time: now})
+		}
+	}
+	for _, tc := range touches {
+		old, ok := find(t.touches[:t.n], tc.ID)
+		switch {
+		case !ok:
+			events = append(events, Event{Type: Down, Touch: tc, Time: now})
+		case old.X != tc.X || old.Y != tc.Y:
+			events = append(events, Event{Type: Move, Touch: tc, Time: now})
+		}
+	}
+	t.n = copy(t.touches[:], touches)
+	return events
+}
+
+// Poll reads the touches of p and returns the events, appended to events.
+func (t *Tracker) Poll(p MultiPointer, now time.Duration, events []Event) []Event {
+	var touches [MaxTouches]Touch
+	n := p.ReadTouches(touches[:])
+	return t.Update(touches[:n], now, events)
+}
+
+func find(touches []Touch, id uint8) (Touch, bool) {
+	for _, t := range touches {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return Touch{}, false
+}
@@ -60,6 +60,7 @@ func (res *FourWire) Configure(config *FourWireConfig) error {
 // ReadTouchPoint reads a single touch.Point from the device.  If the device
 // was configured with ReadSamples > 1, each value will be sampled that many
 // times and averaged to smooth over spurious results of the analog reads.
+// The values are raw, use touch.Calibrated to get display coordinates.
 func (res *FourWire) ReadTouchPoint() (p touch.Point) {
 	p.X = int(sample(res.ReadX, res.readSamples))
 	p.Y = int(sample(res.ReadY, res.readSamples))
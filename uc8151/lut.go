@@ -0,0 +1,53 @@
+package uc8151
+
+// Look up tables for 4 gray levels, derived from the ones of the Waveshare
+// 4.2in display, whose controller uses the same table format. The level of a
+// pixel is selected by its bit in the old and the new data.
+var (
+	lutGrayVCOM = [44]uint8{
+		0x00, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x60, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x00, 0x14, 0x00, 0x00, 0x00, 0x01,
+		0x00, 0x13, 0x0A, 0x01, 0x00, 0x01,
+	}
+	lutGrayWW = [42]uint8{
+		0x40, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x10, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0xA0, 0x13, 0x01, 0x00, 0x00, 0x01,
+	}
+	lutGrayBW = [42]uint8{
+		0x40, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x00, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0x99, 0x0C, 0x01, 0x03, 0x04, 0x01,
+	}
+	lutGrayWB = [42]uint8{
+		0x40, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x00, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0x99, 0x0B, 0x04, 0x04, 0x01, 0x01,
+	}
+	lutGrayBB = [42]uint8{
+		0x80, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x20, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0x50, 0x13, 0x01, 0x00, 0x00, 0x01,
+	}
+)
+
+// sendLUT sends the look up tables for the VCOM and the transitions of the
+// pixels.
+func (d *Device) sendLUT(vcom, ww, bw, wb, bb []uint8) {
+	d.SendCommand(LUT_VCOM)
+	for i := 0; i < 44; i++ {
+		d.SendData(vcom[i])
+	}
+	for j, lut := range [][]uint8{ww, bw, wb, bb} {
+		d.SendCommand(LUT_WW + uint8(j))
+		// the transition tables have no VCOM stage, so 2 bytes less
+		for i := 0; i < 42; i++ {
+			d.SendData(lut[i])
+		}
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
+	"tinygo.org/x/drivers/pixel"
 )
 
 type Config struct {
@@ -20,6 +22,14 @@ type Config struct {
 	Rotation Rotation // Rotation is clock-wise
 	Speed    Speed    // Value from DEFAULT, MEDIUM, FAST, TURBO
 	Blocking bool
+
+	// DirtyTracking makes Display only refresh the area of the buffer that
+	// changed since the last call, using a partial window.
+	DirtyTracking bool
+
+	// FullRefreshEvery forces a full refresh after this many partial
+	// refreshes, to clean up ghosting. Zero never forces one.
+	FullRefreshEvery int
 }
 
 type Device struct {
@@ -35,6 +45,13 @@ type Device struct {
 	rotation     Rotation
 	speed        Speed
 	blocking     bool
+	tracking     bool
+	dirty        display.DirtyRows
+	stats        display.Stats
+	mode         display.RefreshMode
+	policy       display.RefreshPolicy
+	waveform     display.RefreshMode
+	gray         []uint8
 }
 
 type Rotation uint8
@@ -70,21 +87,23 @@ func (d *Device) Configure(cfg Config) {
 	d.rotation = cfg.Rotation
 	d.speed = cfg.Speed
 	d.blocking = cfg.Blocking
+	d.tracking = cfg.DirtyTracking
+	d.policy = display.RefreshPolicy{FullEvery: cfg.FullRefreshEvery}
+	d.waveform = display.FullRefresh
 	d.bufferLength = (uint32(d.width) * uint32(d.height)) / 8
 	d.buffer = make([]uint8, d.bufferLength)
 	for i := uint32(0); i < d.bufferLength; i++ {
 		d.buffer[i] = 0xFF
 	}
+	d.dirty.Init(int(d.height), d.width/8)
+	if d.gray != nil {
+		d.gray = nil
+		d.SetRefreshMode(display.GrayRefresh)
+	}
 
 	d.Reset()
 
-	d.SendCommand(PSR)
-	if d.speed == 0 {
-		d.SendData(RES_128x296 | LUT_OTP | FORMAT_BW | SHIFT_RIGHT | BOOSTER_ON | RESET_NONE | SCAN_UP)
-	} else {
-		d.SendData(RES_128x296 | LUT_REG | FORMAT_BW | SHIFT_RIGHT | BOOSTER_ON | RESET_NONE | SCAN_UP)
-	}
-
+	d.setPanel(d.speed == 0)
 	d.SetLUT(d.speed)
 
 	d.SendCommand(PWR)
@@ -122,6 +141,37 @@ func (d *Device) Configure(cfg Config) {
 
 }
 
+// setPanel sets the panel settings, with the look up tables from the OTP
+// memory or from the registers
+func (d *Device) setPanel(otp bool) {
+	d.SendCommand(PSR)
+	if otp {
+		d.SendData(RES_128x296 | LUT_OTP | FORMAT_BW | SHIFT_RIGHT | BOOSTER_ON | RESET_NONE | SCAN_UP)
+	} else {
+		d.SendData(RES_128x296 | LUT_REG | FORMAT_BW | SHIFT_RIGHT | BOOSTER_ON | RESET_NONE | SCAN_UP)
+	}
+}
+
+// setWaveform loads the look up tables for a refresh mode, if needed.
+// Partial refreshes use the TURBO tables.
+func (d *Device) setWaveform(mode display.RefreshMode) {
+	if mode == d.waveform {
+		return
+	}
+	d.waveform = mode
+	switch mode {
+	case display.PartialRefresh:
+		d.setPanel(false)
+		d.SetLUT(TURBO)
+	case display.GrayRefresh:
+		d.setPanel(false)
+		d.sendLUT(lutGrayVCOM[:], lutGrayWW[:], lutGrayBW[:], lutGrayWB[:], lutGrayBB[:])
+	default:
+		d.setPanel(d.speed == 0)
+		d.SetLUT(d.speed)
+	}
+}
+
 // Reset resets the device
 func (d *Device) Reset() {
 	d.rst.Low()
@@ -153,6 +203,7 @@ func (d *Device) sendDataCommand(isCommand bool, data uint8) {
 	} else {
 		d.dc.High()
 	}
+	d.stats.Bytes++
 	d.cs.Low()
 	d.bus.Transfer(data)
 	d.cs.High()
@@ -162,25 +213,71 @@ func (d *Device) sendDataCommand(isCommand bool, data uint8) {
 // The display have 2 colors: black and white
 // We use RGBA(0,0,0, 255) as white (transparent)
 // Anything else as black
+// In the GrayRefresh mode the other colors are shown in 3 levels of gray, the
+// brighter the darker, see display.Ink.
 func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 	x, y = d.xy(x, y)
 
 	if x < 0 || x >= d.width || y < 0 || y >= d.height {
 		return
 	}
+	ink := display.Ink(c)
+	if d.gray != nil {
+		i := int(x) + int(y)*int(d.width)
+		v := uint32(3 - ink)
+		if pixel.Gray2.Get(d.gray, i) != v {
+			pixel.Gray2.Set(d.gray, i, v)
+			d.dirty.Mark(int(y), x/8)
+		}
+		return
+	}
 	byteIndex := x/8 + y*(d.width/8)
-	if c.R == 0 && c.G == 0 && c.B == 0 { // TRANSPARENT / WHITE
-		d.buffer[byteIndex] &^= 0x80 >> uint8(x%8)
+	b := d.buffer[byteIndex]
+	if ink == 0 { // TRANSPARENT / WHITE
+		b &^= 0x80 >> uint8(x%8)
 	} else { // WHITE / EMPTY
-		d.buffer[byteIndex] |= 0x80 >> uint8(x%8)
+		b |= 0x80 >> uint8(x%8)
+	}
+	if b != d.buffer[byteIndex] {
+		d.buffer[byteIndex] = b
+		d.dirty.Mark(int(y), x/8)
 	}
 }
 
-// Display sends the buffer to the screen.
+// Display sends the buffer to the screen, with the refresh selected by
+// SetRefreshMode. With dirty tracking enabled only the area that changed is
+// refreshed, and nothing is done if nothing changed.
 func (d *Device) Display() error {
+	x0, x1, y0, y1 := int16(0), d.width/8, int16(0), d.height
+	if d.tracking && !d.dirty.Full() {
+		top, bottom, start, end := d.dirty.Bounds()
+		if top == bottom {
+			return nil
+		}
+		x0, x1, y0, y1 = start, end, int16(top), int16(bottom)
+	}
 	if d.blocking {
 		d.WaitUntilIdle()
 	}
+	whole := x1-x0 == d.width/8 && y1-y0 == d.height
+	d.dirty.Clear()
+
+	mode := d.policy.Next(d.mode)
+	d.setWaveform(mode)
+	switch {
+	case mode == display.GrayRefresh:
+		d.displayGray()
+	case mode == display.PartialRefresh || (!whole && d.mode == display.FullRefresh):
+		d.displayWindow(x0, x1, y0, y1)
+	default:
+		d.displayFull()
+	}
+	return nil
+}
+
+// displayFull sends the whole buffer to the screen
+func (d *Device) displayFull() {
+	d.stats.Updates++
 	d.SendCommand(PON)
 	d.SendCommand(PTOU)
 	d.SendCommand(DTM2)
@@ -194,13 +291,63 @@ func (d *Device) Display() error {
 		d.WaitUntilIdle()
 		d.PowerOff()
 	}
+}
+
+// displayGray sends the gray buffer to the screen, the levels are selected
+// by the old and new data
+func (d *Device) displayGray() {
+	d.stats.Updates++
+	d.SendCommand(PON)
+	d.SendCommand(PTOU)
+	d.SendCommand(DTM1)
+	for i := 0; i < int(d.bufferLength); i++ {
+		d.SendData(^display.GrayPlane(d.gray, i, 1)) // bit set: black
+	}
+	d.SendCommand(DTM2)
+	for i := 0; i < int(d.bufferLength); i++ {
+		d.SendData(^display.GrayPlane(d.gray, i, 0))
+	}
+
+	d.SendCommand(DSP)
+	d.SendCommand(DRF)
+	if d.blocking {
+		d.WaitUntilIdle()
+		d.PowerOff()
+	}
+}
+
+// SetRefreshMode selects the refresh done by Display. The GrayRefresh mode
+// uses a second buffer with 2 bits per pixel, which is cleared to white.
+func (d *Device) SetRefreshMode(mode display.RefreshMode) error {
+	if mode > display.GrayRefresh {
+		return display.ErrRefreshMode
+	}
+	d.mode = mode
+	if mode != display.GrayRefresh {
+		d.gray = nil
+	} else if d.gray == nil {
+		d.gray = make([]uint8, d.bufferLength*2)
+		for i := range d.gray {
+			d.gray[i] = 0xFF
+		}
+	}
+	d.dirty.MarkAll()
 	return nil
 }
 
+// ForceFullRefresh makes the next Display do a full refresh.
+func (d *Device) ForceFullRefresh() {
+	d.policy.ForceFull()
+	d.dirty.MarkAll()
+}
+
 // DisplayRect sends only an area of the buffer to the screen.
 // The rectangle points need to be a multiple of 8 in the screen.
 // They might not work as expected if the screen is rotated.
 func (d *Device) DisplayRect(x int16, y int16, width int16, height int16) error {
+	if d.gray != nil {
+		return display.ErrRefreshMode
+	}
 	if d.blocking {
 		d.WaitUntilIdle()
 	}
@@ -230,23 +377,35 @@ func (d *Device) DisplayRect(x int16, y int16, width int16, height int16) error
 		height = d.height
 	}
 
+	if d.policy.Next(display.PartialRefresh) == display.FullRefresh {
+		d.setWaveform(display.FullRefresh)
+		d.displayFull()
+		return nil
+	}
+	d.setWaveform(d.mode)
+	d.displayWindow(x/8, width/8, y, height)
+	return nil
+}
+
+// displayWindow refreshes the bytes [x0, x1) of the lines [y0, y1) using a
+// partial window.
+func (d *Device) displayWindow(x0, x1, y0, y1 int16) {
+	d.stats.Updates++
 	d.SendCommand(PON)
 	d.SendCommand(PTIN)
 	d.SendCommand(PTL)
 
-	d.SendData(uint8(x))
-	d.SendData(uint8(x+width-1) | 0x07)
-	d.SendData(uint8(y >> 8))
-	d.SendData(uint8(y))
-	d.SendData(uint8((y + height - 1) >> 8))
-	d.SendData(uint8(y + height - 1))
+	d.SendData(uint8(x0 * 8))
+	d.SendData(uint8(x1*8-1) | 0x07)
+	d.SendData(uint8(y0 >> 8))
+	d.SendData(uint8(y0))
+	d.SendData(uint8((y1 - 1) >> 8))
+	d.SendData(uint8(y1 - 1))
 	d.SendData(0x01)
 
 	d.SendCommand(DTM2)
-	x = x / 8
-	width = width / 8
-	for ; y < height; y++ {
-		for i := x; i < width; i++ {
+	for y := y0; y < y1; y++ {
+		for i := x0; i < x1; i++ {
 			d.SendData(d.buffer[i+y*(d.width/8)])
 		}
 	}
@@ -258,7 +417,11 @@ func (d *Device) DisplayRect(x int16, y int16, width int16, height int16) error
 		d.WaitUntilIdle()
 		d.PowerOff()
 	}
-	return nil
+}
+
+// Stats returns the number of updates and bytes sent to the display
+func (d *Device) Stats() display.Stats {
+	return d.stats
 }
 
 // ClearDisplay erases the device SRAM
@@ -284,6 +447,10 @@ func (d *Device) ClearBuffer() {
 	for i := uint32(0); i < d.bufferLength; i++ {
 		d.buffer[i] = 0x00
 	}
+	for i := range d.gray {
+		d.gray[i] = 0xFF
+	}
+	d.dirty.MarkAll()
 }
 
 // Size returns the current size of the display.
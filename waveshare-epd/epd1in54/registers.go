@@ -0,0 +1,32 @@
+package epd1in54
+
+// Registers
+const (
+	EPD_WIDTH  = 200
+	EPD_HEIGHT = 200
+
+	DRIVER_OUTPUT_CONTROL                = 0x01
+	DEEP_SLEEP_MODE                      = 0x10
+	DATA_ENTRY_MODE_SETTING              = 0x11
+	SW_RESET                             = 0x12
+	TEMPERATURE_SENSOR_CONTROL           = 0x18
+	MASTER_ACTIVATION                    = 0x20
+	DISPLAY_UPDATE_CONTROL_1             = 0x21
+	DISPLAY_UPDATE_CONTROL_2             = 0x22
+	WRITE_RAM                            = 0x24
+	WRITE_RAM_OLD                        = 0x26
+	BORDER_WAVEFORM_CONTROL              = 0x3C
+	SET_RAM_X_ADDRESS_START_END_POSITION = 0x44
+	SET_RAM_Y_ADDRESS_START_END_POSITION = 0x45
+	SET_RAM_X_ADDRESS_COUNTER            = 0x4E
+	SET_RAM_Y_ADDRESS_COUNTER            = 0x4F
+
+	// Sequences of DISPLAY_UPDATE_CONTROL_2
+	UPDATE_FULL    = 0xF7 // load temperature and waveform, display mode 1
+	UPDATE_PARTIAL = 0xFC // display mode 2, only drives the pixels that changed
+
+	NO_ROTATION  Rotation = 0
+	ROTATION_90  Rotation = 1 // 90 degrees clock-wise rotation
+	ROTATION_180 Rotation = 2
+	ROTATION_270 Rotation = 3
+)
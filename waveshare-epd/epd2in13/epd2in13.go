@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
 )
 
 type Config struct {
@@ -18,6 +19,10 @@ type Config struct {
 	Height       int16
 	LogicalWidth int16    // LogicalWidth must be a multiple of 8 and same size or bigger than Width
 	Rotation     Rotation // Rotation is clock-wise
+
+	// FullRefreshEvery forces a full refresh after this many partial
+	// refreshes, to clean up ghosting. Zero never forces one.
+	FullRefreshEvery int
 }
 
 type Device struct {
@@ -32,6 +37,9 @@ type Device struct {
 	buffer       []uint8
 	bufferLength uint32
 	rotation     Rotation
+	mode         display.RefreshMode
+	policy       display.RefreshPolicy
+	fullLUT      bool
 }
 
 type Rotation uint8
@@ -85,6 +93,7 @@ func (d *Device) Configure(cfg Config) {
 		d.height = 250
 	}
 	d.rotation = cfg.Rotation
+	d.policy = display.RefreshPolicy{FullEvery: cfg.FullRefreshEvery}
 	d.bufferLength = (uint32(d.logicalWidth) * uint32(d.height)) / 8
 	d.buffer = make([]uint8, d.bufferLength)
 	for i := uint32(0); i < d.bufferLength; i++ {
@@ -153,8 +162,20 @@ func (d *Device) sendDataCommand(isCommand bool, data uint8) {
 	d.cs.High()
 }
 
-// SetLUT sets the look up tables for full or partial updates
+// SetLUT sets the look up tables for full or partial updates, and the
+// refresh mode used by Display accordingly
 func (d *Device) SetLUT(fullUpdate bool) {
+	if fullUpdate {
+		d.mode = display.FullRefresh
+	} else {
+		d.mode = display.PartialRefresh
+	}
+	d.setLUT(fullUpdate)
+}
+
+// setLUT sends the look up tables for full or partial updates
+func (d *Device) setLUT(fullUpdate bool) {
+	d.fullLUT = fullUpdate
 	d.SendCommand(WRITE_LUT_REGISTER)
 	if fullUpdate {
 		for i := 0; i < 30; i++ {
@@ -184,8 +205,12 @@ func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 	}
 }
 
-// Display sends the buffer to the screen.
+// Display sends the buffer to the screen, with the refresh selected by
+// SetRefreshMode.
 func (d *Device) Display() error {
+	if full := d.policy.Next(d.mode) == display.FullRefresh; full != d.fullLUT {
+		d.setLUT(full)
+	}
 	d.setMemoryArea(0, 0, d.logicalWidth-1, d.height-1)
 	for j := int16(0); j < d.height; j++ {
 		d.setMemoryPointer(0, j)
@@ -248,6 +273,22 @@ func (d *Device) DisplayRect(x int16, y int16, width int16, height int16) error
 	return nil
 }
 
+// SetRefreshMode selects the refresh done by Display. The display has no
+// grayscale waveform, so GrayRefresh is not supported.
+func (d *Device) SetRefreshMode(mode display.RefreshMode) error {
+	if mode != display.FullRefresh && mode != display.PartialRefresh {
+		return display.ErrRefreshMode
+	}
+	d.mode = mode
+	d.setLUT(mode == display.FullRefresh)
+	return nil
+}
+
+// ForceFullRefresh makes the next Display do a full refresh.
+func (d *Device) ForceFullRefresh() {
+	d.policy.ForceFull()
+}
+
 // ClearDisplay erases the device SRAM
 func (d *Device) ClearDisplay() {
 	d.setMemoryArea(0, 0, d.logicalWidth-1, d.height-1)
@@ -11,12 +11,17 @@ import (
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
 )
 
 type Config struct {
 	Width     int16
 	Height    int16
 	NumColors uint8
+
+	// FullRefreshEvery forces a full refresh after this many partial
+	// refreshes. Zero never forces one.
+	FullRefreshEvery int
 }
 
 type Device struct {
@@ -29,6 +34,9 @@ type Device struct {
 	height       int16
 	buffer       [][]uint8
 	bufferLength uint32
+	mode         display.RefreshMode
+	policy       display.RefreshPolicy
+	dirty        display.DirtyRows
 }
 
 type Color uint8
@@ -75,6 +83,8 @@ func (d *Device) Configure(cfg Config) {
 			d.buffer[i][j] = 0xFF
 		}
 	}
+	d.policy = display.RefreshPolicy{FullEvery: cfg.FullRefreshEvery}
+	d.dirty.Init(int(d.height), d.width/8)
 
 	d.cs.Low()
 	d.dc.Low()
@@ -160,6 +170,7 @@ func (d *Device) SetEPDPixel(x int16, y int16, c Color) {
 		return
 	}
 	byteIndex := (x + y*d.width) / 8
+	d.dirty.Mark(int(y), x/8)
 	if c == WHITE {
 		d.buffer[BLACK-1][byteIndex] |= 0x80 >> uint8(x%8)
 		d.buffer[COLORED-1][byteIndex] |= 0x80 >> uint8(x%8)
@@ -172,8 +183,23 @@ func (d *Device) SetEPDPixel(x int16, y int16, c Color) {
 	}
 }
 
-// Display sends the buffer (if any) to the screen.
+// Display sends the buffer (if any) to the screen. In the PartialRefresh mode
+// only the area that changed since the last call is sent and refreshed.
 func (d *Device) Display() error {
+	if d.mode == display.PartialRefresh && !d.dirty.Full() {
+		top, bottom, start, end := d.dirty.Bounds()
+		if top == bottom {
+			return nil
+		}
+		if d.policy.Next(display.PartialRefresh) == display.PartialRefresh {
+			d.dirty.Clear()
+			d.displayWindow(start, end, int16(top), int16(bottom))
+			return nil
+		}
+	} else {
+		d.policy.Next(display.FullRefresh)
+	}
+	d.dirty.Clear()
 	d.SendCommand(DATA_START_TRANSMISSION_1) // black
 	time.Sleep(2 * time.Millisecond)
 	for i := uint32(0); i < d.bufferLength; i++ {
@@ -190,16 +216,47 @@ func (d *Device) Display() error {
 	return nil
 }
 
-// SetDisplayRect sends a rectangle of data at specific coordinates to the device SRAM directly
-func (d *Device) SetDisplayRect(buffer [][]uint8, x int16, y int16, w int16, h int16) error {
-	if w%8 != 0 {
-		return errors.New("rectangle width needs to be a multiple of 8")
-	}
-	for i := range buffer {
-		if int16(len(buffer[i])) < (w/8)*h {
-			return errors.New("buffer has the wrong size")
+// displayWindow sends the bytes [x0, x1) of the lines [y0, y1) of the buffers
+// and refreshes only that area of the screen
+func (d *Device) displayWindow(x0, x1, y0, y1 int16) {
+	d.setPartialWindow(x0*8, y0, (x1-x0)*8, y1-y0)
+	for i, command := range []uint8{DATA_START_TRANSMISSION_1, DATA_START_TRANSMISSION_2} {
+		d.SendCommand(command)
+		for y := y0; y < y1; y++ {
+			for x := x0; x < x1; x++ {
+				if i < len(d.buffer) {
+					d.SendData(d.buffer[i][x+y*(d.width/8)])
+				} else {
+					d.SendData(0xFF)
+				}
+			}
 		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	d.SendCommand(DISPLAY_REFRESH)
+	time.Sleep(100 * time.Millisecond)
+	d.WaitUntilIdle()
+	d.SendCommand(PARTIAL_OUT)
+}
+
+// SetRefreshMode selects the refresh done by Display. The display has no
+// grayscale waveform, so GrayRefresh is not supported.
+func (d *Device) SetRefreshMode(mode display.RefreshMode) error {
+	if mode != display.FullRefresh && mode != display.PartialRefresh {
+		return display.ErrRefreshMode
 	}
+	d.mode = mode
+	return nil
+}
+
+// ForceFullRefresh makes the next Display do a full refresh.
+func (d *Device) ForceFullRefresh() {
+	d.policy.ForceFull()
+	d.dirty.MarkAll()
+}
+
+// setPartialWindow starts writing to a rectangle of the device SRAM
+func (d *Device) setPartialWindow(x int16, y int16, w int16, h int16) {
 	d.SendCommand(PARTIAL_IN)
 	d.SendCommand(PARTIAL_WINDOW)
 	d.SendData(uint8(x) & 0xF8)
@@ -210,6 +267,19 @@ func (d *Device) SetDisplayRect(buffer [][]uint8, x int16, y int16, w int16, h i
 	d.SendData(uint8(y+h-1) & 0xFF)
 	d.SendData(0x01)
 	time.Sleep(2 * time.Millisecond)
+}
+
+// SetDisplayRect sends a rectangle of data at specific coordinates to the device SRAM directly
+func (d *Device) SetDisplayRect(buffer [][]uint8, x int16, y int16, w int16, h int16) error {
+	if w%8 != 0 {
+		return errors.New("rectangle width needs to be a multiple of 8")
+	}
+	for i := range buffer {
+		if int16(len(buffer[i])) < (w/8)*h {
+			return errors.New("buffer has the wrong size")
+		}
+	}
+	d.setPartialWindow(x, y, w, h)
 	d.SendCommand(DATA_START_TRANSMISSION_1)
 	for i := int16(0); i < (w/8)*h; i++ {
 		d.SendData(buffer[BLACK-1][i])
@@ -237,16 +307,7 @@ func (d *Device) SetDisplayRectColor(buffer []uint8, x int16, y int16, w int16,
 	if c == WHITE {
 		return errors.New("wrong color")
 	}
-	d.SendCommand(PARTIAL_IN)
-	d.SendCommand(PARTIAL_WINDOW)
-	d.SendData(uint8(x) & 0xF8)
-	d.SendData(((uint8(x) & 0xF8) + uint8(w) - 1) | 0x07)
-	d.SendData(uint8(y) >> 8)
-	d.SendData(uint8(y) & 0xFF)
-	d.SendData(uint8(y+h-1) >> 8)
-	d.SendData(uint8(y+h-1) & 0xFF)
-	d.SendData(0x01)
-	time.Sleep(2 * time.Millisecond)
+	d.setPartialWindow(x, y, w, h)
 	if c == COLORED {
 		d.SendCommand(DATA_START_TRANSMISSION_2)
 	} else {
@@ -295,6 +356,7 @@ func (d *Device) ClearBuffer() {
 			d.buffer[i][j] = 0xFF
 		}
 	}
+	d.dirty.MarkAll()
 }
 
 // Size returns the current size of the display.
@@ -0,0 +1,61 @@
+// Package epd2in9 implements a driver for the Waveshare 2.9in black and white
+// e-paper device (V2), controlled by a SSD1680.
+//
+// Datasheet: https://www.waveshare.com/w/upload/7/79/2.9inch-e-paper-v2-specification.pdf
+package epd2in9 // import "tinygo.org/x/drivers/waveshare-epd/epd2in9"
+
+import (
+	"machine"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/waveshare-epd/internal/ssd1680"
+)
+
+type Config struct {
+	Width    int16 // Width is the display resolution
+	Height   int16
+	Rotation Rotation // Rotation is clock-wise
+
+	// FullRefreshEvery forces a full refresh after this many partial
+	// refreshes, to clean up ghosting. Zero never forces one.
+	FullRefreshEvery int
+}
+
+// Device is the display. Its methods other than Configure are shared with the
+// other drivers of SSD1680 and SSD1681 displays.
+type Device struct {
+	ssd1680.Device
+}
+
+type Rotation = ssd1680.Rotation
+
+// New returns a new epd2in9 driver. Pass in a fully configured SPI bus.
+func New(bus drivers.SPI, csPin, dcPin, rstPin, busyPin machine.Pin) Device {
+	return Device{ssd1680.New(bus, csPin, dcPin, rstPin, busyPin)}
+}
+
+// Configure sets up the device.
+func (d *Device) Configure(cfg Config) {
+	if cfg.Width == 0 {
+		cfg.Width = EPD_WIDTH
+	}
+	if cfg.Height == 0 {
+		cfg.Height = EPD_HEIGHT
+	}
+	d.Init(cfg.Width, cfg.Height, cfg.Rotation, cfg.FullRefreshEvery)
+
+	d.SendCommand(DRIVER_OUTPUT_CONTROL)
+	d.SendData(uint8((cfg.Height - 1) & 0xFF))
+	d.SendData(uint8((cfg.Height - 1) >> 8))
+	d.SendData(0x00)
+	d.SendCommand(DATA_ENTRY_MODE_SETTING)
+	d.SendData(0x03) // X increment; Y increment
+	d.SendCommand(BORDER_WAVEFORM_CONTROL)
+	d.SendData(0x05)
+	d.SendCommand(DISPLAY_UPDATE_CONTROL_1)
+	d.SendData(0x00)
+	d.SendData(0x80)
+	d.SendCommand(TEMPERATURE_SENSOR_CONTROL)
+	d.SendData(0x80) // internal sensor
+	d.WaitUntilIdle()
+}
@@ -9,11 +9,19 @@
 package epd4in2
 
 import (
+	"errors"
 	"image/color"
 	"machine"
 	"time"
 
 	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
+	"tinygo.org/x/drivers/pixel"
+)
+
+var (
+	errNoBuffer = errors.New("epd4in2: configured without buffer")
+	errBand     = errors.New("epd4in2: bands must be written in order from the top, without rotation")
 )
 
 type Config struct {
@@ -21,6 +29,14 @@ type Config struct {
 	Height       int16
 	LogicalWidth int16    // LogicalWidth must be a multiple of 8 and same size or bigger than Width
 	Rotation     Rotation // Rotation is clock-wise
+
+	// FullRefreshEvery forces a full refresh after this many partial
+	// refreshes, to clean up ghosting. Zero never forces one.
+	FullRefreshEvery int
+
+	// Unbuffered does not allocate the buffer, for boards without enough
+	// RAM. Images are then sent with WriteBand, see display.DrawBands.
+	Unbuffered bool
 }
 
 type Device struct {
@@ -35,6 +51,11 @@ type Device struct {
 	buffer       []uint8
 	bufferLength uint32
 	rotation     Rotation
+	mode         display.RefreshMode
+	policy       display.RefreshPolicy
+	gray         []uint8
+	bandY        int16
+	dirty        display.DirtyRows
 }
 
 type Rotation uint8
@@ -72,11 +93,15 @@ func (d *Device) Configure(cfg Config) {
 		d.height = EPD_HEIGHT
 	}
 	d.rotation = cfg.Rotation
+	d.policy = display.RefreshPolicy{FullEvery: cfg.FullRefreshEvery}
 	d.bufferLength = (uint32(d.logicalWidth) * uint32(d.height)) / 8
-	d.buffer = make([]uint8, d.bufferLength)
-	for i := uint32(0); i < d.bufferLength; i++ {
-		d.buffer[i] = 0xFF
+	d.buffer = nil
+	if !cfg.Unbuffered {
+		d.buffer = make([]uint8, d.bufferLength)
+		d.dirty.Init(int(d.height), d.logicalWidth/8)
+		d.ClearBuffer()
 	}
+	d.bandY = 0
 
 	d.cs.Low()
 	d.dc.Low()
@@ -203,29 +228,35 @@ func (d *Device) SetLUT() {
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 	}
 
+	d.sendLUT(lut_vcom0, lut_ww, lut_bw, lut_bb, lut_wb)
+}
+
+// sendLUT sends the look up tables for the VCOM and the transitions of the
+// pixels.
+func (d *Device) sendLUT(vcom, ww, bw, wb, bb []uint8) {
 	d.SendCommand(LUT_FOR_VCOM) //vcom
 	for count := 0; count < 44; count++ {
-		d.SendData(lut_vcom0[count])
+		d.SendData(vcom[count])
 	}
 
 	d.SendCommand(LUT_WHITE_TO_WHITE) //ww --
 	for count := 0; count < 42; count++ {
-		d.SendData(lut_ww[count])
+		d.SendData(ww[count])
 	}
 
 	d.SendCommand(LUT_BLACK_TO_WHITE) //bw r
 	for count := 0; count < 42; count++ {
-		d.SendData(lut_bw[count])
+		d.SendData(bw[count])
 	}
 
 	d.SendCommand(LUT_WHITE_TO_BLACK) //wb w
 	for count := 0; count < 42; count++ {
-		d.SendData(lut_bb[count])
+		d.SendData(wb[count])
 	}
 
 	d.SendCommand(LUT_BLACK_TO_BLACK) //bb b
 	for count := 0; count < 42; count++ {
-		d.SendData(lut_wb[count])
+		d.SendData(bb[count])
 	}
 }
 
@@ -233,21 +264,170 @@ func (d *Device) SetLUT() {
 // The display have 2 colors: black and white
 // We use RGBA(0,0,0, 255) as white (transparent)
 // Anything else as black
+// In the GrayRefresh mode the other colors are shown in 3 levels of gray, the
+// brighter the darker, see display.Ink.
 func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
 	x, y = d.xy(x, y)
-	if x < 0 || x >= d.logicalWidth || y < 0 || y >= d.height {
+	if x < 0 || x >= d.logicalWidth || y < 0 || y >= d.height || d.buffer == nil {
+		return
+	}
+	ink := display.Ink(c)
+	if d.gray != nil {
+		i := int(x) + int(y)*int(d.logicalWidth)
+		pixel.Gray2.Set(d.gray, i, uint32(3-ink))
 		return
 	}
 	byteIndex := (uint32(x) + uint32(y)*uint32(d.logicalWidth)) / 8
-	if c.R == 0 && c.G == 0 && c.B == 0 { // TRANSPARENT / WHITE
-		d.buffer[byteIndex] |= 0x80 >> uint8(x%8)
+	b := d.buffer[byteIndex]
+	if ink == 0 { // TRANSPARENT / WHITE
+		b |= 0x80 >> uint8(x%8)
 	} else { // WHITE / EMPTY
-		d.buffer[byteIndex] &^= 0x80 >> uint8(x%8)
+		b &^= 0x80 >> uint8(x%8)
+	}
+	if b != d.buffer[byteIndex] {
+		d.buffer[byteIndex] = b
+		d.dirty.Mark(int(y), x/8)
 	}
 }
 
-// Display sends the buffer to the screen.
+// Display sends the buffer to the screen, with the refresh selected by
+// SetRefreshMode. A partial refresh only sends and refreshes the rectangle
+// around the pixels changed since the last call.
 func (d *Device) Display() error {
+	if d.buffer == nil {
+		return errNoBuffer
+	}
+	switch d.policy.Next(d.mode) {
+	case display.PartialRefresh:
+		// Only the window around the changed pixels is refreshed
+		top, bottom, start, end := d.dirty.Bounds()
+		if top < bottom {
+			d.displayWindow(start, end, int16(top), int16(bottom))
+		}
+	case display.GrayRefresh:
+		d.displayGray()
+	default:
+		d.displayFull()
+	}
+	d.dirty.Clear()
+	return nil
+}
+
+// DisplayRect sends only an area of the buffer to the screen, with a partial
+// refresh. The rectangle points need to be a multiple of 8 in the screen.
+// They might not work as expected if the screen is rotated.
+func (d *Device) DisplayRect(x int16, y int16, width int16, height int16) error {
+	if d.buffer == nil {
+		return errNoBuffer
+	}
+	if d.gray != nil {
+		return display.ErrRefreshMode
+	}
+	x, y = d.xy(x, y)
+	if x < 0 || y < 0 || x >= d.logicalWidth || y >= d.height || width < 0 || height < 0 {
+		return errors.New("wrong rectangle")
+	}
+	if d.rotation == ROTATION_90 {
+		width, height = height, width
+		x -= width
+	} else if d.rotation == ROTATION_180 {
+		x -= width - 1
+		y -= height - 1
+	} else if d.rotation == ROTATION_270 {
+		width, height = height, width
+		y -= height
+	}
+	x &= 0xF8
+	width &= 0xF8
+	width = x + width // reuse variables
+	if width >= d.logicalWidth {
+		width = d.logicalWidth
+	}
+	height = y + height
+	if height > d.height {
+		height = d.height
+	}
+	if d.policy.Next(display.PartialRefresh) == display.FullRefresh {
+		d.displayFull()
+		return nil
+	}
+	d.displayWindow(x/8, width/8, y, height)
+	return nil
+}
+
+// PixelFormat returns the format of the data of WriteBand.
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.Mono
+}
+
+// BandColor returns the value of c in the data of WriteBand: 1 for white,
+// as set by SetPixel for black, and 0 for the other colors.
+func (d *Device) BandColor(c color.RGBA) uint32 {
+	if display.Ink(c) == 0 {
+		return 1
+	}
+	return 0
+}
+
+// WriteBand writes the rows [y, y+height) of the screen, without using the
+// buffer, in the pixel.Mono format, see BandColor. Bands must be written in order from the
+// top, without rotation, and the screen is refreshed after the last one.
+// It implements display.BandWriter.
+func (d *Device) WriteBand(y, height int16, data []byte) error {
+	if d.rotation != NO_ROTATION || y != d.bandY || y+height > d.height || len(data) != int(d.logicalWidth/8)*int(height) {
+		return errBand
+	}
+	if y == 0 {
+		d.setResolution()
+		d.SendCommand(DATA_START_TRANSMISSION_1)
+		for i := uint32(0); i < d.bufferLength; i++ {
+			d.SendData(0xFF)
+		}
+		d.SendCommand(DATA_START_TRANSMISSION_2)
+	}
+	for _, b := range data {
+		d.SendData(b)
+	}
+	d.bandY = y + height
+	if d.bandY == d.height {
+		d.bandY = 0
+		d.SetLUT()
+		d.SendCommand(DISPLAY_REFRESH)
+		time.Sleep(100 * time.Millisecond)
+		d.WaitUntilIdle()
+
+		// The old data in the display memory is not the image, partial
+		// refreshes need a full one first.
+		d.policy.ForceFull()
+	}
+	return nil
+}
+
+// SetRefreshMode selects the refresh done by Display. The GrayRefresh mode
+// uses a second buffer with 2 bits per pixel, which is cleared to white.
+func (d *Device) SetRefreshMode(mode display.RefreshMode) error {
+	if mode > display.GrayRefresh {
+		return display.ErrRefreshMode
+	}
+	d.mode = mode
+	if mode != display.GrayRefresh {
+		d.gray = nil
+	} else if d.gray == nil {
+		d.gray = make([]uint8, d.bufferLength*2)
+		for i := range d.gray {
+			d.gray[i] = 0xFF
+		}
+	}
+	return nil
+}
+
+// ForceFullRefresh makes the next Display do a full refresh.
+func (d *Device) ForceFullRefresh() {
+	d.policy.ForceFull()
+}
+
+// setResolution sets the resolution and the border of the display
+func (d *Device) setResolution() {
 	d.SendCommand(RESOLUTION_SETTING)
 	d.SendData(uint8(d.height >> 8))
 	d.SendData(uint8(d.logicalWidth & 0xff))
@@ -259,11 +439,17 @@ func (d *Device) Display() error {
 
 	d.SendCommand(VCOM_AND_DATA_INTERVAL_SETTING)
 	d.SendCommand(0x97) //VBDF 17|D7 VBDW 97  VBDB 57  VBDF F7  VBDW 77  VBDB 37  VBDR B7
+}
 
+// displayFull sends the buffer to the screen with a full refresh
+func (d *Device) displayFull() {
+	d.setResolution()
+
+	// The full refresh ignores the old data, but partial refreshes need it
 	d.SendCommand(DATA_START_TRANSMISSION_1)
 	var i int16
 	for i = 0; i < d.logicalWidth/8*d.height; i++ {
-		d.SendData(0xFF) // bit set: white, bit reset: black
+		d.SendData(d.buffer[i]) // bit set: white, bit reset: black
 	}
 	time.Sleep(2 * time.Millisecond)
 	d.SendCommand(DATA_START_TRANSMISSION_2)
@@ -277,8 +463,65 @@ func (d *Device) Display() error {
 	d.SendCommand(DISPLAY_REFRESH)
 	time.Sleep(100 * time.Millisecond)
 	d.WaitUntilIdle()
+}
 
-	return nil
+// displayWindow sends the bytes [x0, x1) of the lines [y0, y1) to the screen
+// with a partial refresh.
+func (d *Device) displayWindow(x0, x1, y0, y1 int16) {
+	d.setResolution()
+	d.SendCommand(PARTIAL_IN)
+	d.SendCommand(PARTIAL_WINDOW)
+	d.SendData(uint8((x0 * 8) >> 8))
+	d.SendData(uint8(x0 * 8))
+	d.SendData(uint8((x1*8 - 1) >> 8))
+	d.SendData(uint8(x1*8-1) | 0x07)
+	d.SendData(uint8(y0 >> 8))
+	d.SendData(uint8(y0))
+	d.SendData(uint8((y1 - 1) >> 8))
+	d.SendData(uint8(y1 - 1))
+	d.SendData(0x01) // gates scan inside and outside of the window
+
+	d.sendWindow(DATA_START_TRANSMISSION_2, x0, x1, y0, y1)
+	d.sendLUT(lutPartialVCOM[:], lutPartialWW[:], lutPartialBW[:], lutPartialWB[:], lutPartialBB[:])
+	d.SendCommand(DISPLAY_REFRESH)
+	time.Sleep(100 * time.Millisecond)
+	d.WaitUntilIdle()
+
+	// The new data is the old data of the next partial refresh
+	d.sendWindow(DATA_START_TRANSMISSION_1, x0, x1, y0, y1)
+	d.SendCommand(PARTIAL_OUT)
+}
+
+// sendWindow sends the bytes [x0, x1) of the lines [y0, y1) of the buffer
+func (d *Device) sendWindow(command uint8, x0, x1, y0, y1 int16) {
+	d.SendCommand(command)
+	for y := y0; y < y1; y++ {
+		for i := x0; i < x1; i++ {
+			d.SendData(d.buffer[i+y*(d.logicalWidth/8)])
+		}
+	}
+	time.Sleep(2 * time.Millisecond)
+}
+
+// displayGray sends the gray buffer to the screen, the levels are selected
+// by the old and new data
+func (d *Device) displayGray() {
+	d.setResolution()
+	d.SendCommand(DATA_START_TRANSMISSION_1)
+	for i := 0; i < int(d.bufferLength); i++ {
+		d.SendData(display.GrayPlane(d.gray, i, 1))
+	}
+	time.Sleep(2 * time.Millisecond)
+	d.SendCommand(DATA_START_TRANSMISSION_2)
+	for i := 0; i < int(d.bufferLength); i++ {
+		d.SendData(display.GrayPlane(d.gray, i, 0))
+	}
+	time.Sleep(2 * time.Millisecond)
+
+	d.sendLUT(lutGrayVCOM[:], lutGrayWW[:], lutGrayBW[:], lutGrayWB[:], lutGrayBB[:])
+	d.SendCommand(DISPLAY_REFRESH)
+	time.Sleep(100 * time.Millisecond)
+	d.WaitUntilIdle()
 }
 
 // ClearDisplay erases the device SRAM
@@ -323,9 +566,13 @@ func (d *Device) IsBusy() bool {
 
 // ClearBuffer sets the buffer to 0xFF (white)
 func (d *Device) ClearBuffer() {
-	for i := uint32(0); i < d.bufferLength; i++ {
+	for i := range d.buffer {
 		d.buffer[i] = 0xFF
 	}
+	for i := range d.gray {
+		d.gray[i] = 0xFF
+	}
+	d.dirty.MarkAll()
 }
 
 // Size returns the current size of the display.
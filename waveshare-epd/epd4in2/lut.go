@@ -0,0 +1,58 @@
+package epd4in2
+
+// Derived from https://github.com/waveshare/e-Paper/blob/master/RaspberryPi_JetsonNano/c/lib/e-Paper/EPD_4in2.c
+
+// Look up tables for partial updates, faster but there will be some ghosting.
+// Only the pixels that changed are driven.
+var (
+	lutPartialVCOM = [44]uint8{
+		0x00, 0x01, 0x20, 0x01, 0x00, 0x01,
+	}
+	lutPartialWW = [42]uint8{
+		0x00, 0x01, 0x20, 0x01, 0x00, 0x01,
+	}
+	lutPartialBW = [42]uint8{
+		0x20, 0x01, 0x20, 0x01, 0x00, 0x01,
+	}
+	lutPartialWB = [42]uint8{
+		0x10, 0x01, 0x20, 0x01, 0x00, 0x01,
+	}
+	lutPartialBB = [42]uint8{
+		0x00, 0x01, 0x20, 0x01, 0x00, 0x01,
+	}
+)
+
+// Look up tables for 4 gray levels. The level of a pixel is selected by its
+// bit in the old and the new data.
+var (
+	lutGrayVCOM = [44]uint8{
+		0x00, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x60, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x00, 0x14, 0x00, 0x00, 0x00, 0x01,
+		0x00, 0x13, 0x0A, 0x01, 0x00, 0x01,
+	}
+	lutGrayWW = [42]uint8{
+		0x40, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x10, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0xA0, 0x13, 0x01, 0x00, 0x00, 0x01,
+	}
+	lutGrayBW = [42]uint8{
+		0x40, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x00, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0x99, 0x0C, 0x01, 0x03, 0x04, 0x01,
+	}
+	lutGrayWB = [42]uint8{
+		0x40, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x00, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0x99, 0x0B, 0x04, 0x04, 0x01, 0x01,
+	}
+	lutGrayBB = [42]uint8{
+		0x80, 0x0A, 0x00, 0x00, 0x00, 0x01,
+		0x90, 0x14, 0x14, 0x00, 0x00, 0x01,
+		0x20, 0x14, 0x0A, 0x00, 0x00, 0x01,
+		0x50, 0x13, 0x01, 0x00, 0x00, 0x01,
+	}
+)
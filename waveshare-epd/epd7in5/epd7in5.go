@@ -0,0 +1,463 @@
+// Package epd7in5 implements a driver for the Waveshare 7.5in black and white
+// e-paper device (V2, 800x480).
+//
+// Derived from:
+//
+//	https://github.com/waveshare/e-Paper/blob/master/RaspberryPi_JetsonNano/c/lib/e-Paper/EPD_7in5_V2.c
+//
+// Datasheet: https://www.waveshare.com/w/upload/6/60/7.5inch_e-Paper_V2_Specification.pdf
+package epd7in5 // import "tinygo.org/x/drivers/waveshare-epd/epd7in5"
+
+import (
+	"errors"
+	"image/color"
+	"io"
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
+	"tinygo.org/x/drivers/pixel"
+)
+
+var (
+	errNoBuffer = errors.New("epd7in5: configured without buffer, use DisplayFrom or WriteBand")
+	errBand     = errors.New("epd7in5: bands must be written in order from the top, without rotation")
+)
+
+type Config struct {
+	Width    int16 // Width is the display resolution
+	Height   int16
+	Rotation Rotation // Rotation is clock-wise
+
+	// FullRefreshEvery forces a full refresh after this many partial
+	// refreshes, to clean up ghosting. Zero never forces one.
+	FullRefreshEvery int
+
+	// Unbuffered does not allocate the buffer (48kB for the whole display),
+	// for boards without enough RAM. Images are then shown with DisplayFrom
+	// or WriteBand, see display.DrawBands.
+	Unbuffered bool
+}
+
+type Device struct {
+	bus          drivers.SPI
+	cs           machine.Pin
+	dc           machine.Pin
+	rst          machine.Pin
+	busy         machine.Pin
+	width        int16
+	height       int16
+	buffer       []uint8
+	bufferLength uint32
+	rotation     Rotation
+	mode         display.RefreshMode
+	policy       display.RefreshPolicy
+	waveform     display.RefreshMode
+	bandY        int16
+}
+
+type Rotation uint8
+
+// New returns a new epd7in5 driver. Pass in a fully configured SPI bus.
+func New(bus drivers.SPI, csPin, dcPin, rstPin, busyPin machine.Pin) Device {
+	csPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	dcPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	rstPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	busyPin.Configure(machine.PinConfig{Mode: machine.PinInput})
+	return Device{
+		bus:  bus,
+		cs:   csPin,
+		dc:   dcPin,
+		rst:  rstPin,
+		busy: busyPin,
+	}
+}
+
+// Configure sets up the device.
+func (d *Device) Configure(cfg Config) {
+	if cfg.Width != 0 {
+		d.width = cfg.Width
+	} else {
+		d.width = EPD_WIDTH
+	}
+	if cfg.Height != 0 {
+		d.height = cfg.Height
+	} else {
+		d.height = EPD_HEIGHT
+	}
+	d.rotation = cfg.Rotation
+	d.policy = display.RefreshPolicy{FullEvery: cfg.FullRefreshEvery}
+	d.bufferLength = (uint32(d.width) * uint32(d.height)) / 8
+	d.buffer = nil
+	if !cfg.Unbuffered {
+		d.buffer = make([]uint8, d.bufferLength)
+		d.ClearBuffer()
+	}
+	d.bandY = 0
+
+	d.cs.Low()
+	d.dc.Low()
+	d.rst.Low()
+
+	d.Reset()
+	d.SendCommand(POWER_SETTING)
+	d.SendData(0x07)
+	d.SendData(0x07) // VGH=20V, VGL=-20V
+	d.SendData(0x3f) // VDH=15V
+	d.SendData(0x3f) // VDL=-15V
+	d.SendCommand(POWER_ON)
+	time.Sleep(100 * time.Millisecond)
+	d.WaitUntilIdle()
+
+	d.SendCommand(PANEL_SETTING)
+	d.SendData(0x1F) // KW-3f KWR-2F BWROTP 0f BWOTP 1f
+	d.SendCommand(RESOLUTION_SETTING)
+	d.SendData(uint8(d.width >> 8))
+	d.SendData(uint8(d.width & 0xff))
+	d.SendData(uint8(d.height >> 8))
+	d.SendData(uint8(d.height & 0xff))
+	d.SendCommand(DUAL_SPI)
+	d.SendData(0x00)
+	d.SendCommand(TCON_SETTING)
+	d.SendData(0x22)
+	d.waveform = display.PartialRefresh
+	d.setWaveform(display.FullRefresh)
+}
+
+// Reset resets the device
+func (d *Device) Reset() {
+	d.rst.High()
+	time.Sleep(20 * time.Millisecond)
+	d.rst.Low()
+	time.Sleep(2 * time.Millisecond)
+	d.rst.High()
+	time.Sleep(20 * time.Millisecond)
+}
+
+// DeepSleep puts the display into deepsleep
+func (d *Device) DeepSleep() {
+	d.SendCommand(POWER_OFF)
+	d.WaitUntilIdle()
+	d.SendCommand(DEEP_SLEEP)
+	d.SendData(0xA5)
+}
+
+// SendCommand sends a command to the display
+func (d *Device) SendCommand(command uint8) {
+	d.sendDataCommand(true, command)
+}
+
+// SendData sends a data byte to the display
+func (d *Device) SendData(data uint8) {
+	d.sendDataCommand(false, data)
+}
+
+// sendDataCommand sends image data or a command to the screen
+func (d *Device) sendDataCommand(isCommand bool, data uint8) {
+	if isCommand {
+		d.dc.Low()
+	} else {
+		d.dc.High()
+	}
+	d.cs.Low()
+	d.bus.Transfer(data)
+	d.cs.High()
+}
+
+// setWaveform sets the data interval and temperature settings of a refresh
+// mode, if needed. Partial refreshes copy the new data to the old data
+// themselves.
+func (d *Device) setWaveform(mode display.RefreshMode) {
+	if mode == d.waveform {
+		return
+	}
+	d.waveform = mode
+	d.SendCommand(VCOM_AND_DATA_INTERVAL_SETTING)
+	if mode == display.PartialRefresh {
+		d.SendData(0xA9)
+		d.SendData(0x07)
+		d.SendCommand(CASCADE_SETTING)
+		d.SendData(0x02) // use the forced temperature
+		d.SendCommand(FORCE_TEMPERATURE)
+		d.SendData(0x6E) // fast waveform
+	} else {
+		d.SendData(0x10)
+		d.SendData(0x07)
+		d.SendCommand(CASCADE_SETTING)
+		d.SendData(0x00)
+	}
+}
+
+// SetPixel modifies the internal buffer in a single pixel.
+// The display have 2 colors: black and white
+// We use RGBA(0,0,0, 255) as white (transparent)
+// Anything else as black
+func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
+	x, y = d.xy(x, y)
+	if x < 0 || x >= d.width || y < 0 || y >= d.height || d.buffer == nil {
+		return
+	}
+	byteIndex := (uint32(x) + uint32(y)*uint32(d.width)) / 8
+	if c.R == 0 && c.G == 0 && c.B == 0 { // TRANSPARENT / WHITE
+		d.buffer[byteIndex] |= 0x80 >> uint8(x%8)
+	} else { // BLACK
+		d.buffer[byteIndex] &^= 0x80 >> uint8(x%8)
+	}
+}
+
+// Display sends the buffer to the screen, with the refresh selected by
+// SetRefreshMode.
+func (d *Device) Display() error {
+	if d.buffer == nil {
+		return errNoBuffer
+	}
+	if d.policy.Next(d.mode) == display.PartialRefresh {
+		d.displayWindow(0, d.width/8, 0, d.height)
+	} else {
+		d.displayFull()
+	}
+	return nil
+}
+
+// DisplayRect sends only an area of the buffer to the screen, with a partial
+// refresh. The rectangle points need to be a multiple of 8 in the screen.
+// They might not work as expected if the screen is rotated.
+func (d *Device) DisplayRect(x int16, y int16, width int16, height int16) error {
+	if d.buffer == nil {
+		return errNoBuffer
+	}
+	x, y = d.xy(x, y)
+	if x < 0 || y < 0 || x >= d.width || y >= d.height || width < 0 || height < 0 {
+		return errors.New("wrong rectangle")
+	}
+	if d.rotation == ROTATION_90 {
+		width, height = height, width
+		x -= width
+	} else if d.rotation == ROTATION_180 {
+		x -= width - 1
+		y -= height - 1
+	} else if d.rotation == ROTATION_270 {
+		width, height = height, width
+		y -= height
+	}
+	x &= 0xF8
+	width &= 0xF8
+	width = x + width // reuse variables
+	if width >= d.width {
+		width = d.width
+	}
+	height = y + height
+	if height > d.height {
+		height = d.height
+	}
+	if d.policy.Next(display.PartialRefresh) == display.FullRefresh {
+		d.displayFull()
+		return nil
+	}
+	d.displayWindow(x/8, width/8, y, height)
+	return nil
+}
+
+// DisplayFrom streams an image to the screen with a full refresh, without
+// using the buffer. The image is read from r as width/8*height bytes, a bit
+// per pixel (1 is white) from left to right and top to bottom, without
+// rotation.
+func (d *Device) DisplayFrom(r io.Reader) error {
+	d.setWaveform(display.FullRefresh)
+	d.SendCommand(DATA_START_TRANSMISSION_1)
+	for i := uint32(0); i < d.bufferLength; i++ {
+		d.SendData(0xFF)
+	}
+	d.SendCommand(DATA_START_TRANSMISSION_2)
+	var chunk [32]uint8
+	for n := uint32(0); n < d.bufferLength; {
+		m := uint32(len(chunk))
+		if d.bufferLength-n < m {
+			m = d.bufferLength - n
+		}
+		if _, err := io.ReadFull(r, chunk[:m]); err != nil {
+			return err
+		}
+		for _, b := range chunk[:m] {
+			d.SendData(^b)
+		}
+		n += m
+	}
+	d.refresh()
+
+	// The old data in the display memory is not the image, partial
+	// refreshes need a full one first.
+	d.policy.ForceFull()
+	return nil
+}
+
+// PixelFormat returns the format of the data of WriteBand.
+func (d *Device) PixelFormat() pixel.Format {
+	return pixel.Mono
+}
+
+// BandColor returns the value of c in the data of WriteBand: 1 for white,
+// as set by SetPixel for black, and 0 for the other colors.
+func (d *Device) BandColor(c color.RGBA) uint32 {
+	if display.Ink(c) == 0 {
+		return 1
+	}
+	return 0
+}
+
+// WriteBand writes the rows [y, y+height) of the screen, without using the
+// buffer, in the pixel.Mono format, see BandColor. Bands must be written in order from the
+// top, without rotation, and the screen is refreshed after the last one.
+// It implements display.BandWriter.
+func (d *Device) WriteBand(y, height int16, data []byte) error {
+	if d.rotation != NO_ROTATION || y != d.bandY || y+height > d.height || len(data) != int(d.width/8)*int(height) {
+		return errBand
+	}
+	if y == 0 {
+		d.setWaveform(display.FullRefresh)
+		d.SendCommand(DATA_START_TRANSMISSION_1)
+		for i := uint32(0); i < d.bufferLength; i++ {
+			d.SendData(0xFF)
+		}
+		d.SendCommand(DATA_START_TRANSMISSION_2)
+	}
+	for _, b := range data {
+		d.SendData(^b)
+	}
+	d.bandY = y + height
+	if d.bandY == d.height {
+		d.bandY = 0
+		d.refresh()
+
+		// The old data in the display memory is not the image, partial
+		// refreshes need a full one first.
+		d.policy.ForceFull()
+	}
+	return nil
+}
+
+// displayFull sends the buffer to the screen with a full refresh
+func (d *Device) displayFull() {
+	d.setWaveform(display.FullRefresh)
+	d.SendCommand(DATA_START_TRANSMISSION_1)
+	for i := uint32(0); i < d.bufferLength; i++ {
+		d.SendData(d.buffer[i])
+	}
+	d.SendCommand(DATA_START_TRANSMISSION_2)
+	for i := uint32(0); i < d.bufferLength; i++ {
+		d.SendData(^d.buffer[i]) // bit set: black
+	}
+	d.refresh()
+}
+
+// displayWindow sends the bytes [x0, x1) of the lines [y0, y1) to the screen
+// with a partial refresh
+func (d *Device) displayWindow(x0, x1, y0, y1 int16) {
+	d.setWaveform(display.PartialRefresh)
+	d.SendCommand(PARTIAL_IN)
+	d.SendCommand(PARTIAL_WINDOW)
+	d.SendData(uint8((x0 * 8) >> 8))
+	d.SendData(uint8(x0 * 8))
+	d.SendData(uint8((x1*8 - 1) >> 8))
+	d.SendData(uint8(x1*8 - 1))
+	d.SendData(uint8(y0 >> 8))
+	d.SendData(uint8(y0))
+	d.SendData(uint8((y1 - 1) >> 8))
+	d.SendData(uint8(y1 - 1))
+	d.SendData(0x01) // gates scan inside and outside of the window
+
+	d.SendCommand(DATA_START_TRANSMISSION_2)
+	for y := y0; y < y1; y++ {
+		for i := x0; i < x1; i++ {
+			d.SendData(^d.buffer[uint32(i)+uint32(y)*uint32(d.width/8)])
+		}
+	}
+	d.refresh()
+	d.SendCommand(PARTIAL_OUT)
+}
+
+// refresh refreshes the screen and waits for it
+func (d *Device) refresh() {
+	d.SendCommand(DISPLAY_REFRESH)
+	time.Sleep(100 * time.Millisecond)
+	d.WaitUntilIdle()
+}
+
+// SetRefreshMode selects the refresh done by Display. The GrayRefresh mode
+// is not supported.
+func (d *Device) SetRefreshMode(mode display.RefreshMode) error {
+	if mode != display.FullRefresh && mode != display.PartialRefresh {
+		return display.ErrRefreshMode
+	}
+	d.mode = mode
+	return nil
+}
+
+// ForceFullRefresh makes the next Display do a full refresh.
+func (d *Device) ForceFullRefresh() {
+	d.policy.ForceFull()
+}
+
+// ClearDisplay erases the device SRAM and the buffer
+func (d *Device) ClearDisplay() {
+	d.ClearBuffer()
+	d.setWaveform(display.FullRefresh)
+	d.SendCommand(DATA_START_TRANSMISSION_1)
+	for i := uint32(0); i < d.bufferLength; i++ {
+		d.SendData(0xFF)
+	}
+	d.SendCommand(DATA_START_TRANSMISSION_2)
+	for i := uint32(0); i < d.bufferLength; i++ {
+		d.SendData(0x00)
+	}
+	d.refresh()
+	d.policy.ForceFull()
+}
+
+// WaitUntilIdle waits until the display is ready
+func (d *Device) WaitUntilIdle() {
+	for !d.busy.Get() {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// IsBusy returns the busy status of the display
+func (d *Device) IsBusy() bool {
+	return !d.busy.Get()
+}
+
+// ClearBuffer sets the buffer to 0xFF (white)
+func (d *Device) ClearBuffer() {
+	for i := range d.buffer {
+		d.buffer[i] = 0xFF
+	}
+}
+
+// Size returns the current size of the display.
+func (d *Device) Size() (w, h int16) {
+	if d.rotation == ROTATION_90 || d.rotation == ROTATION_270 {
+		return d.height, d.width
+	}
+	return d.width, d.height
+}
+
+// SetRotation changes the rotation (clock-wise) of the device
+func (d *Device) SetRotation(rotation Rotation) {
+	d.rotation = rotation
+}
+
+// xy chages the coordinates according to the rotation
+func (d *Device) xy(x, y int16) (int16, int16) {
+	switch d.rotation {
+	case NO_ROTATION:
+		return x, y
+	case ROTATION_90:
+		return d.width - y - 1, x
+	case ROTATION_180:
+		return d.width - x - 1, d.height - y - 1
+	case ROTATION_270:
+		return y, d.height - x - 1
+	}
+	return x, y
+}
@@ -0,0 +1,35 @@
+package epd7in5
+
+// Registers
+const (
+	// Display resolution
+	EPD_WIDTH  = 800
+	EPD_HEIGHT = 480
+
+	PANEL_SETTING                  = 0x00
+	POWER_SETTING                  = 0x01
+	POWER_OFF                      = 0x02
+	POWER_ON                       = 0x04
+	BOOSTER_SOFT_START             = 0x06
+	DEEP_SLEEP                     = 0x07
+	DATA_START_TRANSMISSION_1      = 0x10
+	DATA_STOP                      = 0x11
+	DISPLAY_REFRESH                = 0x12
+	DATA_START_TRANSMISSION_2      = 0x13
+	DUAL_SPI                       = 0x15
+	VCOM_AND_DATA_INTERVAL_SETTING = 0x50
+	TCON_SETTING                   = 0x60
+	RESOLUTION_SETTING             = 0x61
+	GET_STATUS                     = 0x71
+	VCM_DC_SETTING                 = 0x82
+	PARTIAL_WINDOW                 = 0x90
+	PARTIAL_IN                     = 0x91
+	PARTIAL_OUT                    = 0x92
+	CASCADE_SETTING                = 0xE0
+	FORCE_TEMPERATURE              = 0xE5
+
+	NO_ROTATION  Rotation = 0
+	ROTATION_90  Rotation = 1 // 90 degrees clock-wise rotation
+	ROTATION_180 Rotation = 2
+	ROTATION_270 Rotation = 3
+)
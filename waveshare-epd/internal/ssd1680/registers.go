@@ -0,0 +1,24 @@
+package ssd1680
+
+// Registers
+const (
+	DEEP_SLEEP_MODE                      = 0x10
+	SW_RESET                             = 0x12
+	MASTER_ACTIVATION                    = 0x20
+	DISPLAY_UPDATE_CONTROL_2             = 0x22
+	WRITE_RAM                            = 0x24
+	WRITE_RAM_OLD                        = 0x26
+	SET_RAM_X_ADDRESS_START_END_POSITION = 0x44
+	SET_RAM_Y_ADDRESS_START_END_POSITION = 0x45
+	SET_RAM_X_ADDRESS_COUNTER            = 0x4E
+	SET_RAM_Y_ADDRESS_COUNTER            = 0x4F
+
+	// Sequences of DISPLAY_UPDATE_CONTROL_2
+	UPDATE_FULL    = 0xF7 // load temperature and waveform, display mode 1
+	UPDATE_PARTIAL = 0xFC // display mode 2, only drives the pixels that changed
+
+	NO_ROTATION  Rotation = 0
+	ROTATION_90  Rotation = 1 // 90 degrees clock-wise rotation
+	ROTATION_180 Rotation = 2
+	ROTATION_270 Rotation = 3
+)
@@ -0,0 +1,328 @@
+// Package ssd1680 holds the code shared by the drivers of the Waveshare
+// e-paper displays controlled by a SSD1680 or a SSD1681, which only differ by
+// their size and initialization.
+package ssd1680 // import "tinygo.org/x/drivers/waveshare-epd/internal/ssd1680"
+
+import (
+	"errors"
+	"image/color"
+	"io"
+	"machine"
+	"time"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
+)
+
+// Device is a display, embedded in the Device of the drivers.
+type Device struct {
+	bus          drivers.SPI
+	cs           machine.Pin
+	dc           machine.Pin
+	rst          machine.Pin
+	busy         machine.Pin
+	width        int16
+	height       int16
+	buffer       []uint8
+	bufferLength uint32
+	rotation     Rotation
+	mode         display.RefreshMode
+	policy       display.RefreshPolicy
+}
+
+type Rotation uint8
+
+// New returns a new device. Pass in a fully configured SPI bus.
+func New(bus drivers.SPI, csPin, dcPin, rstPin, busyPin machine.Pin) Device {
+	csPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	dcPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	rstPin.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	busyPin.Configure(machine.PinConfig{Mode: machine.PinInput})
+	return Device{
+		bus:  bus,
+		cs:   csPin,
+		dc:   dcPin,
+		rst:  rstPin,
+		busy: busyPin,
+	}
+}
+
+// Init allocates the buffer and resets the controller, which is then
+// initialized by the driver of the panel.
+func (d *Device) Init(width, height int16, rotation Rotation, fullRefreshEvery int) {
+	d.width = width
+	d.height = height
+	d.rotation = rotation
+	d.policy = display.RefreshPolicy{FullEvery: fullRefreshEvery}
+	d.bufferLength = (uint32(d.width) * uint32(d.height)) / 8
+	d.buffer = make([]uint8, d.bufferLength)
+	for i := uint32(0); i < d.bufferLength; i++ {
+		d.buffer[i] = 0xFF
+	}
+
+	d.cs.Low()
+	d.dc.Low()
+	d.rst.Low()
+
+	d.Reset()
+	d.WaitUntilIdle()
+	d.SendCommand(SW_RESET)
+	d.WaitUntilIdle()
+}
+
+// Reset resets the device
+func (d *Device) Reset() {
+	d.rst.Low()
+	time.Sleep(10 * time.Millisecond)
+	d.rst.High()
+	time.Sleep(10 * time.Millisecond)
+}
+
+// DeepSleep puts the display into deepsleep
+func (d *Device) DeepSleep() {
+	d.SendCommand(DEEP_SLEEP_MODE)
+	d.SendData(0x01)
+}
+
+// SendCommand sends a command to the display
+func (d *Device) SendCommand(command uint8) {
+	d.sendDataCommand(true, command)
+}
+
+// SendData sends a data byte to the display
+func (d *Device) SendData(data uint8) {
+	d.sendDataCommand(false, data)
+}
+
+// sendDataCommand sends image data or a command to the screen
+func (d *Device) sendDataCommand(isCommand bool, data uint8) {
+	if isCommand {
+		d.dc.Low()
+	} else {
+		d.dc.High()
+	}
+	d.cs.Low()
+	d.bus.Transfer(data)
+	d.cs.High()
+}
+
+// SetPixel modifies the internal buffer in a single pixel.
+// The display have 2 colors: black and white
+// We use RGBA(0,0,0, 255) as white (transparent)
+// Anything else as black
+func (d *Device) SetPixel(x int16, y int16, c color.RGBA) {
+	x, y = d.xy(x, y)
+	if x < 0 || x >= d.width || y < 0 || y >= d.height {
+		return
+	}
+	byteIndex := (x + y*d.width) / 8
+	if c.R == 0 && c.G == 0 && c.B == 0 { // TRANSPARENT / WHITE
+		d.buffer[byteIndex] |= 0x80 >> uint8(x%8)
+	} else { // BLACK
+		d.buffer[byteIndex] &^= 0x80 >> uint8(x%8)
+	}
+}
+
+// Display sends the buffer to the screen, with the refresh selected by
+// SetRefreshMode.
+func (d *Device) Display() error {
+	if d.policy.Next(d.mode) == display.PartialRefresh {
+		d.displayWindow(0, d.width/8, 0, d.height, UPDATE_PARTIAL)
+	} else {
+		d.displayWindow(0, d.width/8, 0, d.height, UPDATE_FULL)
+	}
+	return nil
+}
+
+// DisplayRect sends only an area of the buffer to the screen, with a partial
+// refresh. The rectangle points need to be a multiple of 8 in the screen.
+// They might not work as expected if the screen is rotated.
+func (d *Device) DisplayRect(x int16, y int16, width int16, height int16) error {
+	x, y = d.xy(x, y)
+	if x < 0 || y < 0 || x >= d.width || y >= d.height || width < 0 || height < 0 {
+		return errors.New("wrong rectangle")
+	}
+	if d.rotation == ROTATION_90 {
+		width, height = height, width
+		x -= width
+	} else if d.rotation == ROTATION_180 {
+		x -= width - 1
+		y -= height - 1
+	} else if d.rotation == ROTATION_270 {
+		width, height = height, width
+		y -= height
+	}
+	x &= 0xF8
+	width &= 0xF8
+	width = x + width // reuse variables
+	if width >= d.width {
+		width = d.width
+	}
+	height = y + height
+	if height > d.height {
+		height = d.height
+	}
+	if d.policy.Next(display.PartialRefresh) == display.FullRefresh {
+		d.displayWindow(0, d.width/8, 0, d.height, UPDATE_FULL)
+		return nil
+	}
+	d.displayWindow(x/8, width/8, y, height, UPDATE_PARTIAL)
+	return nil
+}
+
+// DisplayFrom streams an image to the screen with a full refresh, without
+// using the buffer. The image is read from r as width/8*height bytes, a bit
+// per pixel (1 is white) from left to right and top to bottom, without
+// rotation.
+func (d *Device) DisplayFrom(r io.Reader) error {
+	d.setMemoryArea(0, 0, d.width-1, d.height-1)
+	d.setMemoryPointer(0, 0)
+	d.SendCommand(WRITE_RAM)
+	var chunk [32]uint8
+	for n := uint32(0); n < d.bufferLength; {
+		m := uint32(len(chunk))
+		if d.bufferLength-n < m {
+			m = d.bufferLength - n
+		}
+		if _, err := io.ReadFull(r, chunk[:m]); err != nil {
+			return err
+		}
+		for _, b := range chunk[:m] {
+			d.SendData(b)
+		}
+		n += m
+	}
+	d.update(UPDATE_FULL)
+
+	// The old data in the display memory is not known, partial refreshes
+	// need a full one first.
+	d.policy.ForceFull()
+	return nil
+}
+
+// displayWindow sends the bytes [x0, x1) of the lines [y0, y1) to the screen
+// and refreshes it
+func (d *Device) displayWindow(x0, x1, y0, y1 int16, sequence uint8) {
+	d.sendWindow(WRITE_RAM, x0, x1, y0, y1)
+	if sequence == UPDATE_FULL {
+		// The full refresh ignores the old data, but partial refreshes
+		// compare with it
+		d.sendWindow(WRITE_RAM_OLD, x0, x1, y0, y1)
+	}
+	d.update(sequence)
+	if sequence == UPDATE_PARTIAL {
+		// The new data is the old data of the next partial refresh
+		d.sendWindow(WRITE_RAM_OLD, x0, x1, y0, y1)
+	}
+}
+
+// sendWindow writes the bytes [x0, x1) of the lines [y0, y1) of the buffer to
+// a RAM of the display
+func (d *Device) sendWindow(ram uint8, x0, x1, y0, y1 int16) {
+	d.setMemoryArea(x0*8, y0, x1*8-1, y1-1)
+	d.setMemoryPointer(x0*8, y0)
+	d.SendCommand(ram)
+	for y := y0; y < y1; y++ {
+		for i := x0; i < x1; i++ {
+			d.SendData(d.buffer[i+y*(d.width/8)])
+		}
+	}
+}
+
+// update refreshes the screen with the given sequence
+func (d *Device) update(sequence uint8) {
+	d.SendCommand(DISPLAY_UPDATE_CONTROL_2)
+	d.SendData(sequence)
+	d.SendCommand(MASTER_ACTIVATION)
+	d.WaitUntilIdle()
+}
+
+// SetRefreshMode selects the refresh done by Display. The display has no
+// grayscale waveform, so GrayRefresh is not supported.
+func (d *Device) SetRefreshMode(mode display.RefreshMode) error {
+	if mode != display.FullRefresh && mode != display.PartialRefresh {
+		return display.ErrRefreshMode
+	}
+	d.mode = mode
+	return nil
+}
+
+// ForceFullRefresh makes the next Display do a full refresh.
+func (d *Device) ForceFullRefresh() {
+	d.policy.ForceFull()
+}
+
+// ClearDisplay erases the device SRAM and the buffer
+func (d *Device) ClearDisplay() {
+	d.ClearBuffer()
+	d.policy.ForceFull()
+	d.Display()
+}
+
+// setMemoryArea sets the area of the display that will be updated
+func (d *Device) setMemoryArea(x0 int16, y0 int16, x1 int16, y1 int16) {
+	d.SendCommand(SET_RAM_X_ADDRESS_START_END_POSITION)
+	d.SendData(uint8((x0 >> 3) & 0xFF))
+	d.SendData(uint8((x1 >> 3) & 0xFF))
+	d.SendCommand(SET_RAM_Y_ADDRESS_START_END_POSITION)
+	d.SendData(uint8(y0 & 0xFF))
+	d.SendData(uint8((y0 >> 8) & 0xFF))
+	d.SendData(uint8(y1 & 0xFF))
+	d.SendData(uint8((y1 >> 8) & 0xFF))
+}
+
+// setMemoryPointer moves the internal pointer to the speficied coordinates
+func (d *Device) setMemoryPointer(x int16, y int16) {
+	d.SendCommand(SET_RAM_X_ADDRESS_COUNTER)
+	d.SendData(uint8((x >> 3) & 0xFF))
+	d.SendCommand(SET_RAM_Y_ADDRESS_COUNTER)
+	d.SendData(uint8(y & 0xFF))
+	d.SendData(uint8((y >> 8) & 0xFF))
+}
+
+// WaitUntilIdle waits until the display is ready
+func (d *Device) WaitUntilIdle() {
+	for d.busy.Get() {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// IsBusy returns the busy status of the display
+func (d *Device) IsBusy() bool {
+	return d.busy.Get()
+}
+
+// ClearBuffer sets the buffer to 0xFF (white)
+func (d *Device) ClearBuffer() {
+	for i := uint32(0); i < d.bufferLength; i++ {
+		d.buffer[i] = 0xFF
+	}
+}
+
+// Size returns the current size of the display.
+func (d *Device) Size() (w, h int16) {
+	if d.rotation == ROTATION_90 || d.rotation == ROTATION_270 {
+		return d.height, d.width
+	}
+	return d.width, d.height
+}
+
+// SetRotation changes the rotation (clock-wise) of the device
+func (d *Device) SetRotation(rotation Rotation) {
+	d.rotation = rotation
+}
+
+// xy chages the coordinates according to the rotation
+func (d *Device) xy(x, y int16) (int16, int16) {
+	switch d.rotation {
+	case NO_ROTATION:
+		return x, y
+	case ROTATION_90:
+		return d.width - y - 1, x
+	case ROTATION_180:
+		return d.width - x - 1, d.height - y - 1
+	case ROTATION_270:
+		return y, d.height - x - 1
+	}
+	return x, y
+}
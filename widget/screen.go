@@ -0,0 +1,253 @@
+package widget
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/display"
+	"tinygo.org/x/drivers/font"
+	"tinygo.org/x/drivers/touch"
+)
+
+// Screen draws widgets on a display and dispatches the touches to them.
+type Screen struct {
+	Theme Theme
+
+	d       display.Drawer
+	widgets []Widget
+	full    bool // redraw the whole display
+
+	focus    Widget
+	pressed  Widget
+	touching bool
+	x, y     int16 // last touch position
+
+	clip clipper
+}
+
+// NewScreen returns a screen drawing on d with the given theme.
+func NewScreen(d drivers.Displayer, theme Theme) *Screen {
+	return &Screen{
+		Theme: theme,
+		d:     display.NewDrawer(d),
+		full:  true,
+	}
+}
+
+// Add adds widgets to the screen. Widgets added later are drawn over the
+// earlier ones.
+func (s *Screen) Add(widgets ...Widget) {
+	for _, w := range widgets {
+		b := w.base()
+		b.screen = s
+		b.dirty = true
+		s.widgets = append(s.widgets, w)
+	}
+}
+
+// Remove removes a widget from the screen, and redraws what was behind it.
+func (s *Screen) Remove(w Widget) {
+	for i, v := range s.widgets {
+		if v == w {
+			s.widgets = append(s.widgets[:i], s.widgets[i+1:]...)
+			break
+		}
+	}
+	if s.focus == w {
+		s.focus = nil
+	}
+	if s.pressed == w {
+		s.pressed = nil
+	}
+	w.base().screen = nil
+	s.full = true
+}
+
+// Invalidate redraws the whole screen on the next Update.
+func (s *Screen) Invalidate() {
+	s.full = true
+}
+
+// Update redraws the invalidated widgets, and the widgets they overlap, and
+// updates the display. It does nothing if no widget changed.
+func (s *Screen) Update() error {
+	if s.full {
+		w, h := s.d.Size()
+		s.Fill(Rect{0, 0, w, h}, s.Theme.Background)
+	}
+	changed := s.full
+	for i, w := range s.widgets {
+		b := w.base()
+		if !b.dirty && !s.full {
+			// Redraw the widgets over a redrawn one.
+			for _, v := range s.widgets[:i] {
+				if v.base().dirty && !b.Rect.Intersect(v.Bounds()).Empty() {
+					b.dirty = true
+					break
+				}
+			}
+		}
+		if b.dirty || s.full {
+			w.Draw(s)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	for _, w := range s.widgets {
+		w.base().dirty = false
+	}
+	s.full = false
+	return s.d.Display()
+}
+
+// Touch dispatches a touch point, in display coordinates. A touch starts
+// when Z becomes positive: the topmost widget under it is pressed and
+// focused if it is focusable. It then receives the moves of the touch until
+// it is released.
+func (s *Screen) Touch(p touch.Point) {
+	x, y := int16(p.X), int16(p.Y)
+	if p.Z <= 0 {
+		if s.touching && s.pressed != nil {
+			s.pressed.HandleEvent(Event{Release, s.x, s.y})
+		}
+		s.touching = false
+		s.pressed = nil
+		return
+	}
+	if !s.touching {
+		s.touching = true
+		s.x, s.y = x, y
+		s.pressed = s.WidgetAt(x, y)
+		if s.pressed != nil {
+			if s.pressed.base().focusable {
+				s.Focus(s.pressed)
+			}
+			s.pressed.HandleEvent(Event{Press, x, y})
+		}
+		return
+	}
+	if x == s.x && y == s.y {
+		return
+	}
+	s.x, s.y = x, y
+	if s.pressed != nil {
+		s.pressed.HandleEvent(Event{Move, x, y})
+	}
+}
+
+// Poll reads a touch point from p, dispatches it, and updates the screen.
+func (s *Screen) Poll(p touch.Pointer) error {
+	s.Touch(p.ReadTouchPoint())
+	return s.Update()
+}
+
+// WidgetAt returns the topmost widget at a point, or nil.
+func (s *Screen) WidgetAt(x, y int16) Widget {
+	for i := len(s.widgets) - 1; i >= 0; i-- {
+		if s.widgets[i].Bounds().Contains(x, y) {
+			return s.widgets[i]
+		}
+	}
+	return nil
+}
+
+// Focus gives the focus to w, or removes it if w is nil.
+func (s *Screen) Focus(w Widget) {
+	if s.focus == w {
+		return
+	}
+	if s.focus != nil {
+		s.focus.base().Invalidate()
+	}
+	s.focus = w
+	if w != nil {
+		w.base().Invalidate()
+	}
+}
+
+// Focused returns the focused widget, or nil.
+func (s *Screen) Focused() Widget {
+	return s.focus
+}
+
+// FocusNext moves the focus to the next focusable widget, in the order they
+// were added, to navigate the screen with buttons.
+func (s *Screen) FocusNext() {
+	start := -1
+	for i, w := range s.widgets {
+		if w == s.focus {
+			start = i
+		}
+	}
+	for i := 1; i <= len(s.widgets); i++ {
+		w := s.widgets[(start+i+len(s.widgets))%len(s.widgets)]
+		if w.base().focusable {
+			s.Focus(w)
+			return
+		}
+	}
+}
+
+// Activate sends an Activate event to the focused widget, like a click on
+// it.
+func (s *Screen) Activate() {
+	if s.focus != nil {
+		s.focus.HandleEvent(Event{Type: Activate})
+	}
+}
+
+// Fill fills the part of r inside the display with a color.
+func (s *Screen) Fill(r Rect, c color.RGBA) {
+	w, h := s.d.Size()
+	r = r.Intersect(Rect{0, 0, w, h})
+	if r.Empty() {
+		return
+	}
+	s.d.FillRectangle(r.X, r.Y, r.W, r.H, c)
+}
+
+// Border draws a one pixel border inside r.
+func (s *Screen) Border(r Rect, c color.RGBA) {
+	s.Fill(Rect{r.X, r.Y, r.W, 1}, c)
+	s.Fill(Rect{r.X, r.Y + r.H - 1, r.W, 1}, c)
+	s.Fill(Rect{r.X, r.Y, 1, r.H}, c)
+	s.Fill(Rect{r.X + r.W - 1, r.Y, 1, r.H}, c)
+}
+
+// Text draws a text with the theme font, centered vertically in r and
+// clipped to it. Anti-aliased fonts are blended with bg.
+func (s *Screen) Text(r Rect, text string, align Align, c, bg color.RGBA) {
+	s.clipText(r, r, text, align, c, bg)
+}
+
+// clipText draws a text in r, clipped to clip.
+func (s *Screen) clipText(clip, r Rect, text string, align Align, c, bg color.RGBA) {
+	f := s.Theme.Font
+	w, lines := font.Measure(f, text)
+	x := r.X + 2
+	switch align {
+	case AlignCenter:
+		x = r.X + (r.W-w)/2
+	case AlignRight:
+		x = r.X + r.W - w - 2
+	}
+	h := f.Ascent + f.Descent + (lines-1)*f.LineHeight
+	y := r.Y + (r.H-h)/2 + f.Ascent
+	dw, dh := s.d.Size()
+	s.clip = clipper{s.d, clip.Intersect(Rect{0, 0, dw, dh})}
+	font.DrawBlended(&s.clip, f, x, y, text, c, bg)
+}
+
+// clipper is a display drawing only inside a rectangle.
+type clipper struct {
+	drivers.Displayer
+	r Rect
+}
+
+func (c *clipper) SetPixel(x, y int16, col color.RGBA) {
+	if c.r.Contains(x, y) {
+		c.Displayer.SetPixel(x, y, col)
+	}
+}
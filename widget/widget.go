@@ -0,0 +1,167 @@
+// Package widget is a small retained-mode widget toolkit for touch screens.
+//
+// Widgets, such as labels, buttons, sliders and lists, are added to a Screen
+// which draws them on any drivers.Displayer and dispatches the touches read
+// from a touch.Pointer to them. When the state of a widget changes it is
+// invalidated, and only the invalidated widgets are redrawn by the next
+// Update. Widgets can also be focused and activated with buttons, for
+// displays without touch screen.
+package widget // import "tinygo.org/x/drivers/widget"
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers/font"
+	"tinygo.org/x/drivers/font/fonts"
+)
+
+// Rect is a rectangle of the display.
+type Rect struct {
+	X, Y, W, H int16
+}
+
+// Contains reports whether the point is inside r.
+func (r Rect) Contains(x, y int16) bool {
+	return x >= r.X && x < r.X+r.W && y >= r.Y && y < r.Y+r.H
+}
+
+// Intersect returns the largest rectangle inside both r and s, which is
+// empty if they do not overlap.
+func (r Rect) Intersect(s Rect) Rect {
+	x0, y0 := maxInt16(r.X, s.X), maxInt16(r.Y, s.Y)
+	x1, y1 := minInt16(r.X+r.W, s.X+s.W), minInt16(r.Y+r.H, s.Y+s.H)
+	if x1 <= x0 || y1 <= y0 {
+		return Rect{}
+	}
+	return Rect{x0, y0, x1 - x0, y1 - y0}
+}
+
+// Empty reports whether r has no pixels.
+func (r Rect) Empty() bool {
+	return r.W <= 0 || r.H <= 0
+}
+
+// EventType is the kind of an Event.
+type EventType uint8
+
+const (
+	// Press is sent to the widget touched first.
+	Press EventType = iota
+
+	// Move is sent to the pressed widget when the touch moves, even outside
+	// of it.
+	Move
+
+	// Release is sent to the pressed widget when the touch ends, with the
+	// last position of the touch.
+	Release
+
+	// Activate is sent to the focused widget by Screen.Activate.
+	Activate
+)
+
+// Event is an input sent to a widget.
+type Event struct {
+	Type EventType
+	X, Y int16
+}
+
+// Widget is an element of a Screen. Widgets embed Base, which implements the
+// methods they do not need.
+type Widget interface {
+	// Bounds returns the rectangle of the widget.
+	Bounds() Rect
+
+	// Draw draws the whole rectangle of the widget on s.
+	Draw(s *Screen)
+
+	// HandleEvent handles an input event.
+	HandleEvent(e Event)
+
+	base() *Base
+}
+
+// Base holds the state common to all widgets.
+type Base struct {
+	Rect
+
+	screen    *Screen
+	dirty     bool
+	focusable bool
+}
+
+// Bounds returns the rectangle of the widget.
+func (b *Base) Bounds() Rect {
+	return b.Rect
+}
+
+// Draw fills the widget with the background color.
+func (b *Base) Draw(s *Screen) {
+	s.Fill(b.Rect, s.Theme.Background)
+}
+
+// HandleEvent ignores the event.
+func (b *Base) HandleEvent(e Event) {}
+
+// Invalidate marks the widget to be redrawn by the next Screen.Update.
+func (b *Base) Invalidate() {
+	b.dirty = true
+}
+
+// SetFocusable sets whether the widget can be focused.
+func (b *Base) SetFocusable(focusable bool) {
+	b.focusable = focusable
+}
+
+// Focused reports whether the widget has the focus of its screen.
+func (b *Base) Focused() bool {
+	return b.screen != nil && b.screen.focus != nil && b.screen.focus.base() == b
+}
+
+func (b *Base) base() *Base {
+	return b
+}
+
+// Theme holds the font and the colors of the widgets.
+type Theme struct {
+	Font *font.Font
+
+	Background color.RGBA
+	Foreground color.RGBA
+
+	// Accent highlights the active parts of the widgets, such as the focus
+	// border and the selected list item.
+	Accent color.RGBA
+}
+
+// DefaultTheme is white on black, which is also readable on monochrome
+// displays.
+var DefaultTheme = Theme{
+	Font:       &fonts.GoRegular12,
+	Background: color.RGBA{0, 0, 0, 255},
+	Foreground: color.RGBA{255, 255, 255, 255},
+	Accent:     color.RGBA{0x40, 0xa0, 0xff, 255},
+}
+
+// Align is the horizontal alignment of a text.
+type Align uint8
+
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+func minInt16(a, b int16) int16 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt16(a, b int16) int16 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,194 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"tinygo.org/x/drivers/tester"
+	"tinygo.org/x/drivers/touch"
+)
+
+// drawn returns the area of v drawn since v.Drawn was reset.
+func drawn(v *tester.Screen) Rect {
+	r := v.Drawn
+	return Rect{int16(r.Min.X), int16(r.Min.Y), int16(r.Dx()), int16(r.Dy())}
+}
+
+// script is a touch.Pointer returning recorded points.
+type script []touch.Point
+
+func (s *script) ReadTouchPoint() touch.Point {
+	p := (*s)[0]
+	*s = (*s)[1:]
+	return p
+}
+
+// play polls all the points of a script.
+func play(c *qt.C, s *Screen, points ...touch.Point) {
+	p := script(points)
+	for len(p) > 0 {
+		c.Assert(s.Poll(&p), qt.IsNil)
+	}
+}
+
+func tap(x, y int) []touch.Point {
+	return []touch.Point{{X: x, Y: y, Z: 1}, {}}
+}
+
+func TestUpdate(t *testing.T) {
+	c := qt.New(t)
+	v := tester.NewScreen(64, 64)
+	s := NewScreen(v, DefaultTheme)
+	title := NewLabel(Rect{0, 0, 64, 16}, "Title", AlignCenter)
+	value := NewLabel(Rect{0, 20, 32, 16}, "0", AlignLeft)
+	s.Add(title, value)
+
+	c.Assert(s.Update(), qt.IsNil)
+	c.Assert(v.Displays, qt.Equals, 1)
+	c.Assert(drawn(v), qt.Equals, Rect{0, 0, 64, 64})
+
+	// Nothing changed.
+	c.Assert(s.Update(), qt.IsNil)
+	c.Assert(v.Displays, qt.Equals, 1)
+
+	// Only the invalidated widget is redrawn.
+	v.Drawn = image.Rectangle{}
+	value.SetText("42")
+	c.Assert(s.Update(), qt.IsNil)
+	c.Assert(v.Displays, qt.Equals, 2)
+	c.Assert(drawn(v), qt.Equals, value.Rect)
+}
+
+func TestUpdateOverlap(t *testing.T) {
+	c := qt.New(t)
+	v := tester.NewScreen(64, 64)
+	s := NewScreen(v, DefaultTheme)
+	back := NewLabel(Rect{0, 0, 40, 40}, "", AlignLeft)
+	front := NewLabel(Rect{30, 30, 20, 20}, "", AlignLeft)
+	other := NewLabel(Rect{0, 50, 10, 10}, "", AlignLeft)
+	s.Add(back, front, other)
+	c.Assert(s.Update(), qt.IsNil)
+
+	// The widget over the redrawn one is redrawn too.
+	v.Drawn = image.Rectangle{}
+	back.SetText("x")
+	c.Assert(s.Update(), qt.IsNil)
+	c.Assert(drawn(v), qt.Equals, Rect{0, 0, 50, 50})
+	c.Assert(s.WidgetAt(35, 35), qt.Equals, Widget(front))
+	c.Assert(s.WidgetAt(60, 60), qt.IsNil)
+}
+
+func TestButton(t *testing.T) {
+	c := qt.New(t)
+	v := tester.NewScreen(64, 64)
+	s := NewScreen(v, DefaultTheme)
+	clicks := 0
+	b := NewButton(Rect{10, 10, 40, 20}, "OK", func() { clicks++ })
+	s.Add(b)
+
+	play(c, s, tap(20, 20)...)
+	c.Assert(clicks, qt.Equals, 1)
+	c.Assert(b.Focused(), qt.IsTrue)
+
+	// The button is drawn inverted while pressed.
+	p := script{{X: 20, Y: 20, Z: 1}}
+	c.Assert(s.Poll(&p), qt.IsNil)
+	c.Assert(b.Pressed(), qt.IsTrue)
+	c.Assert(v.At(12, 12), qt.Equals, DefaultTheme.Foreground)
+
+	// Moving out of the button cancels the click.
+	play(c, s, touch.Point{X: 60, Y: 60, Z: 1}, touch.Point{})
+	c.Assert(b.Pressed(), qt.IsFalse)
+	c.Assert(v.At(12, 12), qt.Equals, DefaultTheme.Background)
+	c.Assert(clicks, qt.Equals, 1)
+
+	// Touches starting outside do not press it.
+	play(c, s, touch.Point{X: 60, Y: 60, Z: 1}, touch.Point{X: 20, Y: 20, Z: 1}, touch.Point{})
+	c.Assert(clicks, qt.Equals, 1)
+}
+
+func TestSlider(t *testing.T) {
+	c := qt.New(t)
+	v := tester.NewScreen(128, 32)
+	s := NewScreen(v, DefaultTheme)
+	var changes []int
+	sl := NewSlider(Rect{0, 0, 108, 20}, 0, 100, 50, func(v int) { changes = append(changes, v) })
+	s.Add(sl)
+
+	// The knob is 10 pixels wide, so its center goes from 5 to 103.
+	play(c, s, touch.Point{X: 5, Y: 10, Z: 1}, touch.Point{X: 54, Y: 10, Z: 1}, touch.Point{X: 120, Y: 10, Z: 1}, touch.Point{})
+	c.Assert(changes, qt.DeepEquals, []int{0, 50, 100})
+	c.Assert(sl.Value(), qt.Equals, 100)
+
+	sl.SetValue(-5)
+	c.Assert(sl.Value(), qt.Equals, 0)
+	c.Assert(changes, qt.HasLen, 3)
+
+	// Activate steps the value, and wraps around after Max.
+	s.FocusNext()
+	sl.Step = 40
+	s.Activate()
+	s.Activate()
+	s.Activate()
+	s.Activate()
+	c.Assert(changes[3:], qt.DeepEquals, []int{40, 80, 100, 0})
+}
+
+func TestList(t *testing.T) {
+	c := qt.New(t)
+	v := tester.NewScreen(64, 64)
+	s := NewScreen(v, DefaultTheme)
+	var selected []int
+	items := []string{"zero", "one", "two", "three", "four", "five"}
+	l := NewList(Rect{0, 0, 64, 36}, items, func(i int) { selected = append(selected, i) })
+	s.Add(l)
+	rh := int(l.rowHeight(s))
+
+	play(c, s, tap(10, rh+1)...)
+	c.Assert(selected, qt.DeepEquals, []int{1})
+	c.Assert(v.At(63, int16(rh+1)), qt.Equals, DefaultTheme.Accent)
+
+	// Dragging scrolls without selecting, up to the last item.
+	play(c, s, touch.Point{X: 10, Y: 30, Z: 1}, touch.Point{X: 10, Y: 20, Z: 1}, touch.Point{X: 10, Y: -100, Z: 1}, touch.Point{})
+	c.Assert(selected, qt.HasLen, 1)
+	c.Assert(l.scroll, qt.Equals, int16(6*rh-36))
+
+	play(c, s, tap(10, 35)...)
+	c.Assert(selected, qt.DeepEquals, []int{1, 5})
+
+	l.Select(0)
+	c.Assert(l.scroll, qt.Equals, int16(0))
+}
+
+func TestFocus(t *testing.T) {
+	c := qt.New(t)
+	v := tester.NewScreen(64, 64)
+	s := NewScreen(v, DefaultTheme)
+	clicks := 0
+	label := NewLabel(Rect{0, 0, 64, 16}, "Label", AlignLeft)
+	b := NewButton(Rect{0, 20, 64, 16}, "Button", func() { clicks++ })
+	l := NewList(Rect{0, 40, 64, 24}, []string{"a", "b"}, nil)
+	s.Add(label, b, l)
+
+	s.FocusNext()
+	c.Assert(s.Focused(), qt.Equals, Widget(b))
+	s.Activate()
+	c.Assert(clicks, qt.Equals, 1)
+
+	s.FocusNext()
+	c.Assert(s.Focused(), qt.Equals, Widget(l))
+	s.Activate()
+	c.Assert(l.Selected(), qt.Equals, 0)
+
+	// The label is skipped.
+	s.FocusNext()
+	c.Assert(s.Focused(), qt.Equals, Widget(b))
+	c.Assert(s.Update(), qt.IsNil)
+	c.Assert(v.At(0, 20), qt.Equals, DefaultTheme.Accent)
+
+	// Touching a label does not take the focus.
+	play(c, s, tap(5, 5)...)
+	c.Assert(s.Focused(), qt.Equals, Widget(b))
+}
@@ -0,0 +1,319 @@
+package widget
+
+// Label is a text.
+type Label struct {
+	Base
+	Text  string
+	Align Align
+}
+
+// NewLabel returns a label in r.
+func NewLabel(r Rect, text string, align Align) *Label {
+	return &Label{Base: Base{Rect: r}, Text: text, Align: align}
+}
+
+// SetText changes the text of the label.
+func (l *Label) SetText(text string) {
+	if text != l.Text {
+		l.Text = text
+		l.Invalidate()
+	}
+}
+
+// Draw draws the label.
+func (l *Label) Draw(s *Screen) {
+	t := &s.Theme
+	s.Fill(l.Rect, t.Background)
+	s.Text(l.Rect, l.Text, l.Align, t.Foreground, t.Background)
+}
+
+// Button is a push button, which calls OnClick when it is released over it.
+type Button struct {
+	Base
+	Text    string
+	OnClick func()
+
+	pressed bool
+}
+
+// NewButton returns a focusable button in r.
+func NewButton(r Rect, text string, onClick func()) *Button {
+	return &Button{Base: Base{Rect: r, focusable: true}, Text: text, OnClick: onClick}
+}
+
+// Pressed reports whether the button is held down.
+func (b *Button) Pressed() bool {
+	return b.pressed
+}
+
+// Draw draws the button, inverted while it is pressed.
+func (b *Button) Draw(s *Screen) {
+	t := &s.Theme
+	fg, bg := t.Foreground, t.Background
+	if b.pressed {
+		fg, bg = bg, fg
+	}
+	s.Fill(b.Rect, bg)
+	border := t.Foreground
+	if b.Focused() {
+		border = t.Accent
+	}
+	s.Border(b.Rect, border)
+	s.Text(b.Rect, b.Text, AlignCenter, fg, bg)
+}
+
+// HandleEvent presses the button, and clicks it when it is released over it.
+func (b *Button) HandleEvent(e Event) {
+	switch e.Type {
+	case Press, Move:
+		b.setPressed(b.Contains(e.X, e.Y))
+	case Release:
+		click := b.pressed
+		b.setPressed(false)
+		if click && b.OnClick != nil {
+			b.OnClick()
+		}
+	case Activate:
+		if b.OnClick != nil {
+			b.OnClick()
+		}
+	}
+}
+
+func (b *Button) setPressed(pressed bool) {
+	if pressed != b.pressed {
+		b.pressed = pressed
+		b.Invalidate()
+	}
+}
+
+// Slider is a horizontal slider selecting a value from Min to Max, which
+// calls OnChange when it is moved.
+type Slider struct {
+	Base
+	Min, Max int
+	OnChange func(value int)
+
+	// Step is the change of the value on Activate, a tenth of the range if
+	// zero.
+	Step int
+
+	value int
+}
+
+// NewSlider returns a focusable slider in r.
+func NewSlider(r Rect, min, max, value int, onChange func(int)) *Slider {
+	s := &Slider{Base: Base{Rect: r, focusable: true}, Min: min, Max: max, OnChange: onChange}
+	s.value = s.clamp(value)
+	return s
+}
+
+// Value returns the value of the slider.
+func (sl *Slider) Value() int {
+	return sl.value
+}
+
+// SetValue moves the slider, without calling OnChange.
+func (sl *Slider) SetValue(value int) {
+	value = sl.clamp(value)
+	if value != sl.value {
+		sl.value = value
+		sl.Invalidate()
+	}
+}
+
+func (sl *Slider) clamp(value int) int {
+	if value < sl.Min {
+		return sl.Min
+	}
+	if value > sl.Max {
+		return sl.Max
+	}
+	return value
+}
+
+// knob returns the width of the knob, and the x position of its left edge.
+func (sl *Slider) knob() (width, x int16) {
+	width = sl.H / 2
+	if width < 4 {
+		width = 4
+	}
+	x = sl.X
+	if sl.Max > sl.Min {
+		x += int16((sl.value - sl.Min) * int(sl.W-width) / (sl.Max - sl.Min))
+	}
+	return width, x
+}
+
+// Draw draws the slider: a track, filled up to the knob.
+func (sl *Slider) Draw(s *Screen) {
+	t := &s.Theme
+	s.Fill(sl.Rect, t.Background)
+	kw, kx := sl.knob()
+	track := Rect{sl.X, sl.Y + sl.H/2 - 2, sl.W, 4}
+	s.Fill(Rect{track.X, track.Y, kx - track.X, track.H}, t.Accent)
+	s.Border(Rect{kx, track.Y, track.X + track.W - kx, track.H}, t.Foreground)
+	s.Fill(Rect{kx, sl.Y, kw, sl.H}, t.Foreground)
+	if sl.Focused() {
+		s.Border(Rect{kx, sl.Y, kw, sl.H}, t.Accent)
+	}
+}
+
+// HandleEvent moves the knob to the touch position. Activate increases the
+// value by Step, and goes back to Min after Max.
+func (sl *Slider) HandleEvent(e Event) {
+	switch e.Type {
+	case Press, Move:
+		kw, _ := sl.knob()
+		value := sl.Min
+		if sl.W > kw {
+			value += int(e.X-sl.X-kw/2) * (sl.Max - sl.Min) / int(sl.W-kw)
+		}
+		sl.change(value)
+	case Activate:
+		if sl.value >= sl.Max {
+			sl.change(sl.Min)
+			return
+		}
+		step := sl.Step
+		if step <= 0 {
+			step = (sl.Max - sl.Min) / 10
+			if step < 1 {
+				step = 1
+			}
+		}
+		sl.change(sl.value + step)
+	}
+}
+
+// change moves the slider and calls OnChange if the value changed.
+func (sl *Slider) change(value int) {
+	value = sl.clamp(value)
+	if value != sl.value {
+		sl.value = value
+		sl.Invalidate()
+		if sl.OnChange != nil {
+			sl.OnChange(value)
+		}
+	}
+}
+
+// List is a vertical list of items, which can be scrolled by dragging it.
+// Touching an item selects it and calls OnSelect.
+type List struct {
+	Base
+	Items    []string
+	OnSelect func(index int)
+
+	selected int
+	scroll   int16 // pixels scrolled from the top
+	pressY   int16
+	start    int16 // scroll when pressed
+	dragging bool
+}
+
+// NewList returns a focusable list in r, without selected item.
+func NewList(r Rect, items []string, onSelect func(int)) *List {
+	return &List{Base: Base{Rect: r, focusable: true}, Items: items, OnSelect: onSelect, selected: -1}
+}
+
+// Selected returns the index of the selected item, or -1.
+func (l *List) Selected() int {
+	return l.selected
+}
+
+// Select selects an item, without calling OnSelect. It scrolls the list if
+// the item is not visible.
+func (l *List) Select(index int) {
+	if index < -1 || index >= len(l.Items) {
+		index = -1
+	}
+	l.selected = index
+	if index >= 0 && l.screen != nil {
+		rh := l.rowHeight(l.screen)
+		top := int16(index) * rh
+		if top < l.scroll {
+			l.scroll = top
+		} else if top+rh > l.scroll+l.H {
+			l.scroll = top + rh - l.H
+		}
+	}
+	l.Invalidate()
+}
+
+func (l *List) rowHeight(s *Screen) int16 {
+	return s.Theme.Font.LineHeight + 4
+}
+
+// maxScroll returns the scroll showing the last items.
+func (l *List) maxScroll(s *Screen) int16 {
+	h := int16(len(l.Items))*l.rowHeight(s) - l.H
+	if h < 0 {
+		return 0
+	}
+	return h
+}
+
+// Draw draws the visible items, the selected one with the accent color.
+func (l *List) Draw(s *Screen) {
+	t := &s.Theme
+	rh := l.rowHeight(s)
+	for y := l.Y - l.scroll%rh; y < l.Y+l.H; y += rh {
+		i := int((y - l.Y + l.scroll) / rh)
+		row := Rect{l.X, y, l.W, rh}.Intersect(l.Rect)
+		switch {
+		case i >= len(l.Items):
+			s.Fill(row, t.Background)
+		case i == l.selected:
+			s.Fill(row, t.Accent)
+			s.clipText(row, Rect{l.X, y, l.W, rh}, l.Items[i], AlignLeft, t.Background, t.Accent)
+		default:
+			s.Fill(row, t.Background)
+			s.clipText(row, Rect{l.X, y, l.W, rh}, l.Items[i], AlignLeft, t.Foreground, t.Background)
+		}
+	}
+	if l.Focused() {
+		s.Border(l.Rect, t.Accent)
+	}
+}
+
+// HandleEvent scrolls the list when it is dragged, and selects the touched
+// item otherwise. Activate selects the next item.
+func (l *List) HandleEvent(e Event) {
+	s := l.screen
+	switch e.Type {
+	case Press:
+		l.pressY, l.start, l.dragging = e.Y, l.scroll, false
+	case Move:
+		dy := e.Y - l.pressY
+		if dy > l.rowHeight(s)/2 || -dy > l.rowHeight(s)/2 {
+			l.dragging = true
+		}
+		if l.dragging {
+			scroll := maxInt16(0, minInt16(l.start-dy, l.maxScroll(s)))
+			if scroll != l.scroll {
+				l.scroll = scroll
+				l.Invalidate()
+			}
+		}
+	case Release:
+		if l.dragging || !l.Contains(e.X, e.Y) {
+			return
+		}
+		i := int((e.Y - l.Y + l.scroll) / l.rowHeight(s))
+		if i < len(l.Items) {
+			l.choose(i)
+		}
+	case Activate:
+		if len(l.Items) > 0 {
+			l.choose((l.selected + 1) % len(l.Items))
+		}
+	}
+}
+
+func (l *List) choose(i int) {
+	l.Select(i)
+	if l.OnSelect != nil {
+		l.OnSelect(i)
+	}
+}
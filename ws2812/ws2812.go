@@ -1,4 +1,7 @@
 // Package ws2812 implements a driver for WS2812 and SK6812 RGB LED strips.
+//
+// The signal is bit-banged on a pin with interrupts disabled. See the
+// ws2812spi package to drive strips with a SPI bus instead.
 package ws2812 // import "tinygo.org/x/drivers/ws2812"
 
 //go:generate go run gen-ws2812.go -arch=cortexm 16 48 64 120 125 168
@@ -0,0 +1,173 @@
+// Package ws2812spi drives WS2812 and SK6812 LED strips with a SPI bus, as
+// an alternative to the bit-banged ws2812 package.
+//
+// Each bit sent to the LEDs is encoded as a pattern of SPI bits: a few high
+// bits, for a short or a long pulse, followed by low bits. The data line of
+// the strip is connected to the SDO pin, and nothing else of the bus is used.
+// The frame is sent with a single call to Tx, so the bus can use DMA and
+// interrupts keep running.
+package ws2812spi // import "tinygo.org/x/drivers/ws2812spi"
+
+import (
+	"errors"
+	"image/color"
+	"time"
+
+	"tinygo.org/x/drivers"
+)
+
+// ErrFrequency is returned when the timing of the LEDs can not be met at the
+// frequency of the bus.
+var ErrFrequency = errors.New("ws2812spi: SPI frequency does not fit the LED timing")
+
+// Timing is the waveform of the bits expected by the LEDs.
+type Timing struct {
+	// T0H and T1H are how long the line is high for a 0 and a 1 bit.
+	T0H, T1H time.Duration
+
+	// Period is the duration of a bit.
+	Period time.Duration
+
+	// Tolerance is the error allowed on T0H, T1H and Period.
+	Tolerance time.Duration
+
+	// Reset is how long the line must be low to latch the colors.
+	Reset time.Duration
+}
+
+var (
+	// WS2812 is the timing of WS2812B LEDs. The long reset is needed by
+	// recent versions of the chips.
+	WS2812 = Timing{T0H: 400 * time.Nanosecond, T1H: 800 * time.Nanosecond, Period: 1250 * time.Nanosecond, Tolerance: 150 * time.Nanosecond, Reset: 280 * time.Microsecond}
+
+	// SK6812 is the timing of SK6812 LEDs.
+	SK6812 = Timing{T0H: 300 * time.Nanosecond, T1H: 600 * time.Nanosecond, Period: 1250 * time.Nanosecond, Tolerance: 150 * time.Nanosecond, Reset: 80 * time.Microsecond}
+)
+
+// Config is the configuration of the bus.
+type Config struct {
+	// Frequency of the SPI bus in Hz, as configured. The actual frequency of
+	// the bus may be lower than the requested one, depending on the
+	// microcontroller: use the actual one.
+	Frequency uint32
+
+	// Timing of the LEDs, WS2812 by default.
+	Timing Timing
+}
+
+// Device is a WS2812 or SK6812 strip on a SPI bus.
+type Device struct {
+	bus    drivers.SPI
+	bits   int // SPI bits per LED bit
+	high0  int // high SPI bits of a 0
+	high1  int
+	reset  int  // bytes of the reset
+	sent   bool // the line was brought low before the first frame
+	buf    []byte
+	colors []byte
+}
+
+// New returns a strip on bus, which must already be configured at
+// cfg.Frequency. It returns ErrFrequency if the frequency is too low to
+// encode the bits. From 2.4MHz for WS2812 and 3.2MHz for SK6812 are fine.
+func New(bus drivers.SPI, cfg Config) (*Device, error) {
+	timing := cfg.Timing
+	if timing.Period == 0 {
+		timing = WS2812
+	}
+	if cfg.Frequency == 0 {
+		return nil, ErrFrequency
+	}
+	bit := time.Second / time.Duration(cfg.Frequency) // of the SPI bus
+	round := func(d time.Duration) int {
+		return int((d + bit/2) / bit)
+	}
+	d := &Device{
+		bus:   bus,
+		high0: round(timing.T0H),
+		high1: round(timing.T1H),
+		bits:  round(timing.Period),
+	}
+	if d.high0 < 1 {
+		d.high0 = 1
+	}
+	if d.bits <= d.high1 {
+		d.bits = d.high1 + 1
+	}
+	within := func(n int, want time.Duration) bool {
+		got := time.Duration(n) * bit
+		return got >= want-timing.Tolerance && got <= want+timing.Tolerance
+	}
+	if d.high1 <= d.high0 || !within(d.high0, timing.T0H) || !within(d.high1, timing.T1H) || !within(d.bits, timing.Period) {
+		return nil, ErrFrequency
+	}
+	d.reset = int((timing.Reset + 8*bit - 1) / (8 * bit))
+	return d, nil
+}
+
+// Write sends raw bytes to the strip, in the order of the LED channels.
+func (d *Device) Write(buf []byte) (n int, err error) {
+	// Each byte takes 8*bits SPI bits, so bits SPI bytes, followed by the
+	// reset.
+	size := len(buf)*d.bits + d.reset
+	if cap(d.buf) < size {
+		d.buf = make([]byte, size)
+	}
+	out := d.buf[:size]
+	if !d.sent {
+		// Start with the line low, as it may have been high before the first
+		// frame. The reset is sent on its own, so the buffer does not hold a
+		// second one.
+		for i := 0; i < d.reset; i++ {
+			out[i] = 0
+		}
+		if err := d.bus.Tx(out[:d.reset], nil); err != nil {
+			return 0, err
+		}
+		d.sent = true
+	}
+	i, acc, nacc := 0, uint(0), 0
+	for _, b := range buf {
+		for mask := byte(0x80); mask != 0; mask >>= 1 {
+			high := d.high0
+			if b&mask != 0 {
+				high = d.high1
+			}
+			for j := 0; j < d.bits; j++ {
+				acc <<= 1
+				if j < high {
+					acc |= 1
+				}
+				nacc++
+				if nacc == 8 {
+					out[i] = byte(acc)
+					i++
+					acc, nacc = 0, 0
+				}
+			}
+		}
+	}
+	// Keep the line low to latch the colors.
+	for ; i < size; i++ {
+		out[i] = 0
+	}
+	if err := d.bus.Tx(out, nil); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+// WriteColors sends the colors to the strip, in the usual GRB order.
+func (d *Device) WriteColors(buf []color.RGBA) error {
+	if cap(d.colors) < 3*len(buf) {
+		d.colors = make([]byte, 3*len(buf))
+	}
+	colors := d.colors[:3*len(buf)]
+	for i, c := range buf {
+		colors[3*i] = c.G
+		colors[3*i+1] = c.R
+		colors[3*i+2] = c.B
+	}
+	_, err := d.Write(colors)
+	return err
+}
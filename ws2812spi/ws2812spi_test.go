@@ -0,0 +1,146 @@
+package ws2812spi
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+// bus records the bytes sent.
+type bus struct {
+	Txs [][]byte
+}
+
+func (b *bus) Tx(w, r []byte) error {
+	b.Txs = append(b.Txs, append([]byte(nil), w...))
+	return nil
+}
+
+func (b *bus) Transfer(w byte) (byte, error) {
+	b.Tx([]byte{w}, nil)
+	return 0, nil
+}
+
+// decode checks the waveform of data sent at frequency against the timing,
+// and returns the bits it encodes.
+func decode(c *qt.C, data []byte, frequency uint32, timing Timing) []byte {
+	bit := time.Second / time.Duration(frequency)
+	var levels []bool
+	for _, b := range data {
+		for mask := byte(0x80); mask != 0; mask >>= 1 {
+			levels = append(levels, b&mask != 0)
+		}
+	}
+	near := func(got, want time.Duration, what string) {
+		c.Assert(got >= want-timing.Tolerance && got <= want+timing.Tolerance, qt.IsTrue,
+			qt.Commentf("%s is %v, want %v", what, got, want))
+	}
+	// The line is low for a reset before the first bit.
+	first := 0
+	for first < len(levels) && !levels[first] {
+		first++
+	}
+	c.Assert(time.Duration(first)*bit >= timing.Reset, qt.IsTrue)
+
+	var out []byte
+	var value byte
+	n := 0
+	for i := first; i < len(levels); {
+		if !levels[i] {
+			// The line stays low until the end: the reset.
+			for _, l := range levels[i:] {
+				c.Assert(l, qt.IsFalse)
+			}
+			c.Assert(time.Duration(len(levels)-i)*bit >= timing.Reset, qt.IsTrue)
+			break
+		}
+		start := i
+		for i < len(levels) && levels[i] {
+			i++
+		}
+		high := time.Duration(i-start) * bit
+		for i < len(levels) && !levels[i] && time.Duration(i-start)*bit < timing.Period {
+			i++
+		}
+		if i < len(levels) && levels[i] {
+			near(time.Duration(i-start)*bit, timing.Period, "period")
+		}
+		value <<= 1
+		if high > (timing.T0H+timing.T1H)/2 {
+			near(high, timing.T1H, "T1H")
+			value |= 1
+		} else {
+			near(high, timing.T0H, "T0H")
+		}
+		if n++; n%8 == 0 {
+			out = append(out, value)
+		}
+	}
+	c.Assert(n%8, qt.Equals, 0)
+	return out
+}
+
+func TestWaveform(t *testing.T) {
+	c := qt.New(t)
+	for _, tc := range []struct {
+		timing    Timing
+		frequency uint32
+	}{
+		{WS2812, 2400000},
+		{WS2812, 3000000},
+		{WS2812, 3200000},
+		{WS2812, 4000000},
+		{WS2812, 6400000},
+		{WS2812, 8000000},
+		{SK6812, 3200000},
+		{SK6812, 4000000},
+		{SK6812, 8000000},
+	} {
+		c.Run("", func(c *qt.C) {
+			b := &bus{}
+			d, err := New(b, Config{Frequency: tc.frequency, Timing: tc.timing})
+			c.Assert(err, qt.IsNil, qt.Commentf("%d Hz", tc.frequency))
+			c.Assert(d.WriteColors([]color.RGBA{{0x12, 0x34, 0x56, 255}, {0xFF, 0x00, 0x81, 255}}), qt.IsNil)
+			c.Assert(b.Txs, qt.HasLen, 2)
+			got := decode(c, bytes.Join(b.Txs, nil), tc.frequency, tc.timing)
+			c.Assert(got, qt.DeepEquals, []byte{0x34, 0x12, 0x56, 0x00, 0xFF, 0x81})
+		})
+	}
+}
+
+func TestFrequency(t *testing.T) {
+	c := qt.New(t)
+	for _, f := range []uint32{0, 1000000, 2000000} {
+		_, err := New(&bus{}, Config{Frequency: f})
+		c.Assert(err, qt.Equals, ErrFrequency, qt.Commentf("%d Hz", f))
+	}
+	_, err := New(&bus{}, Config{Frequency: 2400000, Timing: SK6812})
+	c.Assert(err, qt.Equals, ErrFrequency)
+}
+
+func TestWrite(t *testing.T) {
+	c := qt.New(t)
+	b := &bus{}
+	d, err := New(b, Config{Frequency: 2400000})
+	c.Assert(err, qt.IsNil)
+	n, err := d.Write([]byte{0x80})
+	c.Assert(err, qt.IsNil)
+	c.Assert(n, qt.Equals, 1)
+	// At least 280µs of reset at 2.4MHz before the first frame, and after the
+	// data of every frame.
+	c.Assert(b.Txs, qt.HasLen, 2)
+	c.Assert(b.Txs[0], qt.DeepEquals, make([]byte, 85))
+	c.Assert(b.Txs[1], qt.HasLen, 3+85)
+	// 3 SPI bits per bit: 110 then 100 seven times.
+	c.Assert(b.Txs[1][:3], qt.DeepEquals, []byte{0xD2, 0x49, 0x24})
+	c.Assert(b.Txs[1][3:], qt.DeepEquals, make([]byte, 85))
+
+	// The line is already low before the next frames.
+	_, err = d.Write([]byte{0x80})
+	c.Assert(err, qt.IsNil)
+	c.Assert(b.Txs, qt.HasLen, 3)
+	c.Assert(b.Txs[2], qt.DeepEquals, b.Txs[1])
+}
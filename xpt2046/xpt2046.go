@@ -99,6 +99,8 @@ func (d *Device) readData() uint16 {
 	return data
 }
 
+// ReadTouchPoint reads the raw position and pressure of a touch. Use
+// touch.Filter and touch.Calibrated to get display coordinates.
 func (d *Device) ReadTouchPoint() touch.Point {
 
 	tx := uint32(0)